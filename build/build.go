@@ -2,6 +2,7 @@ package build
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"fmt"
 	"go/ast"
 	"go/build"
@@ -167,7 +168,7 @@ func importWithSrcDir(bctx build.Context, path string, srcDir string, mode build
 		if name == "js" {
 			gofiles = []string{"js.go"}
 		} else {
-			gofiles = []string{"map.go", "mutex.go", "once.go", "pool.go"}
+			gofiles = []string{"doc.go", "map.go", "mutex.go", "once.go", "pool.go"}
 		}
 		pkg := &build.Package{
 			Dir:        filepath.Join(bctx.GOROOT, "src", path),
@@ -299,9 +300,16 @@ func ImportDir(dir string, mode build.ImportMode, installSuffix string, buildTag
 // as an existing file from the standard library). For all identifiers that exist
 // in the original AND the overrides, the original identifier in the AST gets
 // replaced by `_`. New identifiers that don't exist in original package get added.
-func parseAndAugment(bctx *build.Context, pkg *build.Package, isTest bool, fileSet *token.FileSet) ([]*ast.File, error) {
+// A function override whose doc comment has a //gopherjs:keep-original line
+// keeps the original function around as _gopherjs_original_<name>, so that it
+// can be called by the override.
+func parseAndAugment(bctx *build.Context, pkg *build.Package, isTest bool, fileSet *token.FileSet, options *Options) ([]*ast.File, error) {
+	if options == nil {
+		options = &Options{}
+	}
 	var files []*ast.File
 	replacedDeclNames := make(map[string]bool)
+	keptOriginals := make(map[string]bool)
 	funcName := func(d *ast.FuncDecl) string {
 		if d.Recv == nil || len(d.Recv.List) == 0 {
 			return d.Name.Name
@@ -323,6 +331,7 @@ func parseAndAugment(bctx *build.Context, pkg *build.Package, isTest bool, fileS
 		GOOS:        build.Default.GOOS,
 		GOARCH:      "js",
 		Compiler:    "gc",
+		BuildTags:   bctx.BuildTags,
 		ReleaseTags: goversion.ReleaseTags(),
 		JoinPath:    path.Join,
 		SplitPathList: func(list string) []string {
@@ -383,6 +392,9 @@ func parseAndAugment(bctx *build.Context, pkg *build.Package, isTest bool, fileS
 				switch d := decl.(type) {
 				case *ast.FuncDecl:
 					replacedDeclNames[funcName(d)] = true
+					if hasDirective(d.Doc, keepOriginalDirective) {
+						keptOriginals[funcName(d)] = true
+					}
 				case *ast.GenDecl:
 					switch d.Tok {
 					case token.TYPE:
@@ -412,24 +424,26 @@ func parseAndAugment(bctx *build.Context, pkg *build.Package, isTest bool, fileS
 		if err != nil {
 			return nil, err
 		}
-		file, err := parser.ParseFile(fileSet, name, r, parser.ParseComments)
+		mode := parser.ParseComments
+		if options.AllErrors {
+			mode |= parser.AllErrors
+		}
+		file, err := parser.ParseFile(fileSet, name, r, mode)
 		r.Close()
 		if err != nil {
 			if list, isList := err.(scanner.ErrorList); isList {
-				if len(list) > 10 {
-					list = append(list[:10], &scanner.Error{Pos: list[9].Pos, Msg: "too many errors"})
-				}
+				var fileErrs compiler.ErrorList
 				for _, entry := range list {
-					errList = append(errList, entry)
+					fileErrs = append(fileErrs, compiler.NewError(pkg.ImportPath, entry))
 				}
+				errList = append(errList, fileErrs.Truncate(options.AllErrors)...)
 				continue
 			}
 			errList = append(errList, err)
 			continue
 		}
 
-		switch pkg.ImportPath {
-		case "crypto/rand", "encoding/gob", "encoding/json", "expvar", "go/token", "log", "math/big", "math/rand", "regexp", "testing", "time":
+		if options.noSync(pkg.ImportPath) {
 			for _, spec := range file.Imports {
 				path, _ := strconv.Unquote(spec.Path.Value)
 				if path == "sync" {
@@ -444,7 +458,10 @@ func parseAndAugment(bctx *build.Context, pkg *build.Package, isTest bool, fileS
 		for _, decl := range file.Decls {
 			switch d := decl.(type) {
 			case *ast.FuncDecl:
-				if replacedDeclNames[funcName(d)] {
+				switch name := funcName(d); {
+				case keptOriginals[name]:
+					d.Name = ast.NewIdent(originalPrefix + d.Name.Name)
+				case replacedDeclNames[name]:
 					d.Name = ast.NewIdent("_")
 				}
 			case *ast.GenDecl:
@@ -476,6 +493,35 @@ func parseAndAugment(bctx *build.Context, pkg *build.Package, isTest bool, fileS
 	return files, nil
 }
 
+const (
+	keepOriginalDirective = "//gopherjs:keep-original"
+	originalPrefix        = "_gopherjs_original_"
+)
+
+// hasDirective reports whether doc has a line that consists of directive.
+func hasDirective(doc *ast.CommentGroup, directive string) bool {
+	if doc == nil {
+		return false
+	}
+	for _, c := range doc.List {
+		if strings.TrimSpace(c.Text) == directive {
+			return true
+		}
+	}
+	return false
+}
+
+// NoSyncPackages are the packages of the standard library whose imports of
+// sync are redirected to github.com/gopherjs/gopherjs/nosync, a lighter
+// version of the parts of sync that they use. These packages are often used
+// by code that JavaScript calls; nosync locks only block when another
+// goroutine holds them. Options.NoSync changes the list for a build.
+var NoSyncPackages = []string{"crypto/rand", "encoding/gob", "encoding/json", "expvar", "go/token", "log", "math/big", "math/rand", "regexp", "testing", "time"}
+
+// DeterministicTag is the build tag that makes programs schedule goroutines
+// deterministically and run timers on a virtual clock (see "gopherjs test --deterministic").
+const DeterministicTag = "gopherjs_deterministic"
+
 type Options struct {
 	GOROOT         string
 	GOPATH         string
@@ -484,10 +530,68 @@ type Options struct {
 	Watch          bool
 	CreateMapFile  bool
 	MapToLocalDisk bool
+	MapContent     bool
 	Minify         bool
+	NoInline       bool
+	PrintEscapes   bool
 	Color          bool
 	BuildTags      []string
 	Rebuild        bool
+	AllErrors      bool // AllErrors reports all errors of a package instead of only the first 10.
+	JSONErrors     bool // JSONErrors prints errors as JSON objects (see compiler.Error).
+	CSPStrict      bool // CSPStrict fails builds of programs that evaluate strings as code (see compiler.CheckCSP).
+	DebugInfo      bool // DebugInfo adds the names of local variables to source maps, for gopherjs debug.
+	// NoSync adds packages to NoSyncPackages, or removes them if they are
+	// prefixed with "-". Builds that change the list keep their packages apart
+	// (see Session.InstallSuffix).
+	NoSync []string
+}
+
+// noSync reports whether the imports of sync of the package with the given
+// import path are redirected to nosync.
+func (o *Options) noSync(path string) bool {
+	redirect := false
+	for _, p := range NoSyncPackages {
+		if p == path {
+			redirect = true
+		}
+	}
+	for _, p := range o.NoSync {
+		switch p {
+		case path:
+			redirect = true
+		case "-" + path:
+			redirect = false
+		}
+	}
+	return redirect
+}
+
+// noSyncChanges returns the packages that o.NoSync adds to NoSyncPackages and
+// those it removes, prefixed with "-", sorted. Entries that don't change the
+// list are left out, so equal lists give equal results.
+func (o *Options) noSyncChanges() []string {
+	byDefault := make(map[string]bool)
+	for _, p := range NoSyncPackages {
+		byDefault[p] = true
+	}
+	seen := make(map[string]bool)
+	var changes []string
+	for _, p := range o.NoSync {
+		p = strings.TrimPrefix(p, "-")
+		if seen[p] {
+			continue
+		}
+		seen[p] = true
+		switch redirect := o.noSync(p); {
+		case redirect && !byDefault[p]:
+			changes = append(changes, p)
+		case !redirect && byDefault[p]:
+			changes = append(changes, "-"+p)
+		}
+	}
+	sort.Strings(changes)
+	return changes
 }
 
 func (o *Options) PrintError(format string, a ...interface{}) {
@@ -527,6 +631,7 @@ type Session struct {
 	Packages map[string]*PackageData
 	Types    map[string]*types.Package
 	Watcher  *fsnotify.Watcher
+	embedded map[string]bool // embedded files and their directories, which are watched
 }
 
 func (s *Session) checkMod(pkg *PackageData) (err error) {
@@ -577,10 +682,45 @@ func NewSession(options *Options) *Session {
 func (s *Session) BuildContext() *build.Context { return s.bctx }
 
 func (s *Session) InstallSuffix() string {
+	var suffix []string
 	if s.options.Minify {
-		return "min"
+		suffix = append(suffix, "min")
+	}
+	if s.options.NoInline {
+		suffix = append(suffix, "noinline")
+	}
+	if s.options.DebugInfo {
+		suffix = append(suffix, "debug")
+	}
+	for _, tag := range s.options.BuildTags {
+		if tag == DeterministicTag {
+			// The runtime and time packages are built differently.
+			suffix = append(suffix, "deterministic")
+			break
+		}
+	}
+	if changes := s.options.noSyncChanges(); len(changes) != 0 {
+		// Packages in and out of the list import different packages.
+		h := sha256.Sum256([]byte(strings.Join(changes, ",")))
+		suffix = append(suffix, fmt.Sprintf("nosync%x", h[:4]))
 	}
-	return ""
+	return strings.Join(suffix, "_")
+}
+
+// CompilerOptions returns the options that the session compiles packages with.
+func (s *Session) CompilerOptions() compiler.Options {
+	options := compiler.Options{
+		Minify:    s.options.Minify,
+		Inline:    !s.options.NoInline,
+		AllErrors: s.options.AllErrors,
+		DebugInfo: s.options.DebugInfo,
+	}
+	if s.options.PrintEscapes {
+		options.Diagnose = func(pos token.Position, msg string) {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", pos, msg)
+		}
+	}
+	return options
 }
 
 func (s *Session) BuildDir(packagePath string, importPath string, pkgObj string) error {
@@ -783,6 +923,23 @@ func (s *Session) buildPackage(pkg *PackageData) (*compiler.Archive, error) {
 		return archive, nil
 	}
 
+	// Embedded files are build inputs, too.
+	embedded, err := embedFiles(pkg)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range embedded {
+		dir := filepath.Dir(name)
+		if s.Watcher != nil && !s.embedded[dir] {
+			s.Watcher.Add(dir)
+		}
+		if s.embedded == nil {
+			s.embedded = make(map[string]bool)
+		}
+		s.embedded[name] = true
+		s.embedded[dir] = true
+	}
+
 	if pkg.PkgObj != "" {
 		var fileInfo os.FileInfo
 		gopherjsBinary, err := os.Executable()
@@ -827,8 +984,16 @@ func (s *Session) buildPackage(pkg *PackageData) (*compiler.Archive, error) {
 			}
 		}
 
+		var inputs []string
 		for _, name := range append(pkg.GoFiles, pkg.JSFiles...) {
-			fileInfo, err := statFile(filepath.Join(pkg.Dir, name))
+			inputs = append(inputs, filepath.Join(pkg.Dir, name))
+		}
+		// A directory changes when an embedded file is added to or removed from it.
+		for _, name := range embedded {
+			inputs = append(inputs, name, filepath.Dir(name))
+		}
+		for _, name := range inputs {
+			fileInfo, err := statFile(name)
 			if err != nil {
 				return nil, err
 			}
@@ -837,8 +1002,10 @@ func (s *Session) buildPackage(pkg *PackageData) (*compiler.Archive, error) {
 			}
 		}
 
+		// Escape analysis decisions are only printed when a package is
+		// compiled, so --gcflags=-m doesn't use package objects.
 		pkgObjFileInfo, err := os.Stat(pkg.PkgObj)
-		if !s.options.Rebuild && err == nil && !pkg.SrcModTime.After(pkgObjFileInfo.ModTime()) {
+		if !s.options.Rebuild && !s.options.PrintEscapes && err == nil && !pkg.SrcModTime.After(pkgObjFileInfo.ModTime()) {
 			// package object is up to date, load from disk if library
 			pkg.UpToDate = true
 			if pkg.IsCommand() {
@@ -861,7 +1028,7 @@ func (s *Session) buildPackage(pkg *PackageData) (*compiler.Archive, error) {
 	}
 
 	fileSet := token.NewFileSet()
-	files, err := parseAndAugment(s.bctx, pkg.Package, pkg.IsTest, fileSet)
+	files, err := parseAndAugment(s.bctx, pkg.Package, pkg.IsTest, fileSet, s.options)
 	if err != nil {
 		return nil, err
 	}
@@ -896,21 +1063,38 @@ func (s *Session) buildPackage(pkg *PackageData) (*compiler.Archive, error) {
 		files = append(files, linkfile)
 	}
 
-	archive, err := compiler.Compile(pkg.ImportPath, files, fileSet, importContext, linknames, s.options.Minify)
-	if err != nil {
-		return nil, err
-	}
-
+	var incJSCode []byte
 	for _, jsFile := range pkg.JSFiles {
-		code, err := ioutil.ReadFile(filepath.Join(pkg.Dir, jsFile))
+		filename := filepath.Join(pkg.Dir, jsFile)
+		code, err := ioutil.ReadFile(filename)
 		if err != nil {
 			return nil, err
 		}
-		archive.IncJSCode = append(archive.IncJSCode, []byte("\t(function() {\n")...)
-		archive.IncJSCode = append(archive.IncJSCode, code...)
-		archive.IncJSCode = append(archive.IncJSCode, []byte("\n\t}).call($global);\n")...)
+		// The file is added to the file set before it is stored in the
+		// archive, so that the source map can point into it.
+		file := fileSet.AddFile(filename, -1, len(code))
+		file.SetLinesForContent(code)
+		if s.options.Minify {
+			if code, err = compiler.MinifyJS(code, file); err != nil {
+				return nil, err
+			}
+			incJSCode = append(incJSCode, "(function(){"...)
+			incJSCode = append(incJSCode, code...)
+			incJSCode = append(incJSCode, "\n}).call($global);\n"...)
+			continue
+		}
+		incJSCode = append(incJSCode, []byte("\t(function() {\n")...)
+		incJSCode = append(incJSCode, compiler.MarkJS(code, file)...)
+		incJSCode = append(incJSCode, []byte("\n\t}).call($global);\n")...)
+	}
+
+	archive, err := compiler.Compile(pkg.ImportPath, files, fileSet, importContext, linknames, s.CompilerOptions())
+	if err != nil {
+		return nil, err
 	}
 
+	archive.IncJSCode = incJSCode
+
 	if s.options.Verbose {
 		fmt.Println(pkg.Dir)
 	}
@@ -963,20 +1147,22 @@ func (s *Session) WriteCommandPackage(archive *compiler.Archive, pkgObj string)
 	defer codeFile.Close()
 
 	sourceMapFilter := &compiler.SourceMapFilter{Writer: codeFile}
+	var m *SourceMap
 	if s.options.CreateMapFile {
-		m := &sourcemap.Map{File: filepath.Base(pkgObj)}
+		m = NewSourceMap(filepath.Base(pkgObj), s.options)
 		mapFile, err := os.Create(pkgObj + ".map")
 		if err != nil {
 			return err
 		}
 
 		defer func() {
-			m.WriteTo(mapFile)
+			m.Encode(mapFile)
 			mapFile.Close()
 			fmt.Fprintf(codeFile, "//# sourceMappingURL=%s.map\n", filepath.Base(pkgObj))
 		}()
 
-		sourceMapFilter.MappingCallback = NewMappingCallback(m, s.options.GOROOT, s.options.GOPATH, s.options.MapToLocalDisk)
+		sourceMapFilter.MappingCallback = m.MappingCallback
+		sourceMapFilter.FunctionCallback = m.FunctionCallback
 	}
 
 	deps, err := compiler.ImportDependencies(archive, func(path string) (*compiler.Archive, error) {
@@ -989,31 +1175,63 @@ func (s *Session) WriteCommandPackage(archive *compiler.Archive, pkgObj string)
 	if err != nil {
 		return err
 	}
+	if s.options.CSPStrict {
+		if err := compiler.CheckCSP(deps); err != nil {
+			return err
+		}
+	}
 	return compiler.WriteProgramCode(deps, sourceMapFilter)
 }
 
-func NewMappingCallback(m *sourcemap.Map, goroot, gopath string, localMap bool) func(generatedLine, generatedColumn int, originalPos token.Position) {
-	return func(generatedLine, generatedColumn int, originalPos token.Position) {
-		if !originalPos.IsValid() {
-			m.AddMapping(&sourcemap.Mapping{GeneratedLine: generatedLine, GeneratedColumn: generatedColumn})
-			return
+// Bundle builds the packages with the given import paths and the packages
+// they import, and returns them as a bundle for compiling other packages
+// against in memory, e.g. in the browser. The runtime, which every program
+// needs, is always included.
+func (s *Session) Bundle(paths ...string) (*compiler.Bundle, error) {
+	var archives []*compiler.Archive
+	for _, path := range append([]string{"runtime"}, paths...) {
+		pkg, archive, err := s.BuildImportPathWithPackage(path, nil)
+		if err != nil {
+			return nil, err
 		}
-
-		file := originalPos.Filename
-
-		switch hasGopathPrefix, prefixLen := hasGopathPrefix(file, gopath); {
-		case localMap:
-			// no-op:  keep file as-is
-		case hasGopathPrefix:
-			file = filepath.ToSlash(file[prefixLen+4:])
-		case strings.HasPrefix(file, goroot):
-			file = filepath.ToSlash(file[len(goroot)+4:])
-		default:
-			file = filepath.Base(file)
+		if pkg.IsCommand() {
+			return nil, fmt.Errorf("cannot bundle command %s", pkg.ImportPath)
 		}
+		archives = append(archives, archive)
+	}
 
-		m.AddMapping(&sourcemap.Mapping{GeneratedLine: generatedLine, GeneratedColumn: generatedColumn, OriginalFile: file, OriginalLine: originalPos.Line, OriginalColumn: originalPos.Column})
+	// Packages are added after all are built, since building a package may
+	// change the packages it links to with go:linkname.
+	b := compiler.NewBundle()
+	added := make(map[string]bool)
+	var add func(archive *compiler.Archive) error
+	add = func(archive *compiler.Archive) error {
+		if added[archive.ImportPath] {
+			return nil
+		}
+		added[archive.ImportPath] = true
+		for _, imp := range archive.Imports {
+			if err := add(s.Archives[imp]); err != nil {
+				return err
+			}
+		}
+		return b.Add(archive)
 	}
+	for _, archive := range archives {
+		if err := add(archive); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// NewMappingCallback returns a compiler.SourceMapFilter.MappingCallback
+// adding mappings to m. Source files are named relative to GOROOT or GOPATH,
+// unless localMap is set.
+func NewMappingCallback(m *sourcemap.Map, goroot, gopath string, localMap bool) func(generatedLine, generatedColumn int, originalPos token.Position) {
+	sm := NewSourceMap(m.File, &Options{GOROOT: goroot, GOPATH: gopath, MapToLocalDisk: localMap})
+	sm.Map = m
+	return sm.MappingCallback
 }
 
 func jsFilesFromDir(bctx *build.Context, dir string) ([]string, error) {
@@ -1051,7 +1269,7 @@ func (s *Session) WaitForChange() {
 			if ev.Op&(fsnotify.Create|fsnotify.Write|fsnotify.Remove|fsnotify.Rename) == 0 || filepath.Base(ev.Name)[0] == '.' {
 				continue
 			}
-			if !strings.HasSuffix(ev.Name, ".go") && !strings.HasSuffix(ev.Name, ".inc.js") {
+			if !strings.HasSuffix(ev.Name, ".go") && !strings.HasSuffix(ev.Name, ".inc.js") && !s.embedded[ev.Name] && !s.embedded[filepath.Dir(ev.Name)] {
 				continue
 			}
 			s.options.PrintSuccess("change detected: %s\n", ev.Name)
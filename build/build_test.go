@@ -1,14 +1,24 @@
 package build
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"go/ast"
 	gobuild "go/build"
 	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
 
+	"github.com/goplusjs/gopherjs/compiler"
+	"github.com/goplusjs/gopherjs/compiler/natives"
+	"github.com/goplusjs/gopherjs/compiler/prelude"
 	"github.com/kisielk/gotool"
+	"github.com/neelance/sourcemap"
 	"github.com/shurcooL/go/importgraphutil"
 )
 
@@ -77,7 +87,7 @@ func TestNativesDontImportExtraPackages(t *testing.T) {
 
 			// Use parseAndAugment to get a list of augmented AST files.
 			fset := token.NewFileSet()
-			files, err := parseAndAugment(NewBuildContext("", nil), bpkg, false, fset)
+			files, err := parseAndAugment(NewBuildContext("", nil), bpkg, false, fset, nil)
 			if err != nil {
 				t.Fatalf("github.com/gopherjs/gopherjs/build.parseAndAugment: %v", err)
 			}
@@ -116,7 +126,7 @@ func TestNativesDontImportExtraPackages(t *testing.T) {
 
 			// Use parseAndAugment to get a list of augmented AST files.
 			fset := token.NewFileSet()
-			files, err := parseAndAugment(NewBuildContext("", nil), bpkg, true, fset)
+			files, err := parseAndAugment(NewBuildContext("", nil), bpkg, true, fset, nil)
 			if err != nil {
 				t.Fatalf("github.com/gopherjs/gopherjs/build.parseAndAugment: %v", err)
 			}
@@ -158,7 +168,7 @@ func TestNativesDontImportExtraPackages(t *testing.T) {
 
 			// Use parseAndAugment to get a list of augmented AST files, then check only the external test files.
 			fset := token.NewFileSet()
-			files, err := parseAndAugment(NewBuildContext("", nil), bpkg, true, fset)
+			files, err := parseAndAugment(NewBuildContext("", nil), bpkg, true, fset, nil)
 			if err != nil {
 				t.Fatalf("github.com/gopherjs/gopherjs/build.parseAndAugment: %v", err)
 			}
@@ -187,6 +197,201 @@ func TestNativesDontImportExtraPackages(t *testing.T) {
 	}
 }
 
+// A function override marked with //gopherjs:keep-original replaces the
+// original function, which stays around under another name.
+func TestKeepOriginal(t *testing.T) {
+	bpkg, err := gobuild.Import("syscall", "", gobuild.ImportComment)
+	if err != nil {
+		t.Fatalf("gobuild.Import: %v", err)
+	}
+	fset := token.NewFileSet()
+	files, err := parseAndAugment(NewBuildContext("", nil), bpkg, false, fset, nil)
+	if err != nil {
+		t.Fatalf("github.com/gopherjs/gopherjs/build.parseAndAugment: %v", err)
+	}
+	funcs := make(map[string]int)
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			if d, ok := decl.(*ast.FuncDecl); ok && d.Recv == nil {
+				funcs[d.Name.Name]++
+			}
+		}
+	}
+	if funcs["Open"] != 1 {
+		t.Errorf("got %d functions named Open, want 1", funcs["Open"])
+	}
+	if funcs[originalPrefix+"Open"] != 1 {
+		t.Errorf("got %d functions named %sOpen, want 1", funcs[originalPrefix+"Open"], originalPrefix)
+	}
+}
+
+func TestInstallSuffixNoSync(t *testing.T) {
+	suffix := func(noSync ...string) string {
+		return (&Session{options: &Options{NoSync: noSync}}).InstallSuffix()
+	}
+	for _, noSync := range [][]string{nil, {"log"}, {"example.com/a", "-example.com/a"}} {
+		if got := suffix(noSync...); got != "" {
+			t.Errorf("got suffix %q for %q, which doesn't change NoSyncPackages", got, noSync)
+		}
+	}
+	ab := suffix("example.com/a", "example.com/b")
+	if !strings.HasPrefix(ab, "nosync") {
+		t.Errorf("got suffix %q, want one starting with nosync", ab)
+	}
+	if got := suffix("example.com/b", "example.com/a", "example.com/a", "log"); got != ab {
+		t.Errorf("got suffix %q for the same list in another order, want %q", got, ab)
+	}
+	if got := suffix("example.com/a"); got == ab || got == suffix("-log") {
+		t.Errorf("got suffix %q for different lists", got)
+	}
+}
+
+// sourceMapJSON encodes a source map of mappings to a Go file in GOPATH, a
+// standard library file, a native override and the prelude.
+func sourceMapJSON(t *testing.T, mapContent bool) map[string]interface{} {
+	gopath, err := ioutil.TempDir("", "gopath")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(gopath)
+	gopathFile := filepath.Join(gopath, "src", "example.com", "a", "a.go")
+	if err := os.MkdirAll(filepath.Dir(gopathFile), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(gopathFile, []byte("package a\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	goroot := filepath.FromSlash("/goroot")
+	sm := NewSourceMap("main.js", &Options{GOROOT: goroot, GOPATH: gopath, MapContent: mapContent})
+	for i, file := range []string{gopathFile, filepath.Join(goroot, "src", "fmt", "print.go"), "/src/sync/cond.go", compiler.PreludeFile} {
+		sm.MappingCallback(i+1, 0, token.Position{Filename: file, Line: 1, Column: 1})
+	}
+	sm.MappingCallback(5, 0, token.Position{})
+
+	b, err := json.Marshal(sm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestSourceMapMarshalJSON(t *testing.T) {
+	m := sourceMapJSON(t, false)
+	if m["version"] != 3.0 || m["file"] != "main.js" || m["mappings"] == "" {
+		t.Errorf("got version %v, file %v and mappings %v", m["version"], m["file"], m["mappings"])
+	}
+	want := []string{"/example.com/a/a.go", "/fmt/print.go", nativesSourcePrefix + "/src/sync/cond.go", "prelude.js"}
+	if got := fmt.Sprint(m["sources"]); got != fmt.Sprint(want) {
+		t.Errorf("got sources %v, want %v", got, want)
+	}
+	for _, field := range []string{"sourcesContent", "x_gopherjs_functions"} {
+		if _, ok := m[field]; ok {
+			t.Errorf("got field %s without --mapcontent and functions", field)
+		}
+	}
+}
+
+func TestSourceMapContent(t *testing.T) {
+	m := sourceMapJSON(t, true)
+	f, err := natives.FS.Open("/src/sync/cond.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	cond, err := ioutil.ReadAll(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The file in GOROOT doesn't exist and so has no content.
+	want := []interface{}{"package a\n", nil, string(cond), prelude.Prelude}
+	got, _ := m["sourcesContent"].([]interface{})
+	if len(got) != len(want) {
+		t.Fatalf("got %d sourcesContent, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("got content %.40q for %v, want %.40q", got[i], m["sources"].([]interface{})[i], want[i])
+		}
+	}
+}
+
+// A JavaScript file with a source map of its own is mapped by it, in a
+// section of an index map.
+func TestSourceMapSections(t *testing.T) {
+	gopath, err := ioutil.TempDir("", "gopath")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(gopath)
+	dir := filepath.Join(gopath, "src", "example.com", "a")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	jsFile := filepath.Join(dir, "x.inc.js")
+	jsMap := &sourcemap.Map{Version: 3, File: "x.inc.js", SourceRoot: "ts"}
+	jsMap.AddMapping(&sourcemap.Mapping{GeneratedLine: 2, GeneratedColumn: 4, OriginalFile: "x.ts", OriginalLine: 7})
+	jsMap.EncodeMappings()
+	var buf bytes.Buffer
+	if err := jsMap.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(jsFile+".map", buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, minify := range []bool{false, true} {
+		sm := NewSourceMap("main.js", &Options{GOPATH: gopath, Minify: minify})
+		goFile := filepath.Join(dir, "a.go")
+		sm.MappingCallback(1, 0, token.Position{Filename: goFile, Line: 1, Column: 1})
+		sm.MappingCallback(3, 0, token.Position{Filename: jsFile, Line: 1, Column: 1})
+		sm.MappingCallback(4, 0, token.Position{Filename: jsFile, Line: 2, Column: 1})
+		sm.MappingCallback(6, 2, token.Position{Filename: goFile, Line: 3, Column: 1})
+		sm.MappingCallback(7, 0, token.Position{Filename: goFile, Line: 4, Column: 1})
+		b, err := json.Marshal(sm)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var m struct {
+			Mappings string `json:"mappings"`
+			Sections []struct {
+				Offset IndexOffset `json:"offset"`
+				Map    struct {
+					Sources  []string `json:"sources"`
+					Mappings string   `json:"mappings"`
+				} `json:"map"`
+			} `json:"sections"`
+		}
+		if err := json.Unmarshal(b, &m); err != nil {
+			t.Fatal(err)
+		}
+		if minify {
+			// Minification changes the code of the JavaScript file.
+			if len(m.Sections) != 0 || m.Mappings == "" {
+				t.Errorf("minified: got sections %v and mappings %q", m.Sections, m.Mappings)
+			}
+			continue
+		}
+		var got []string
+		for _, s := range m.Sections {
+			got = append(got, fmt.Sprintf("%d:%d %v %s", s.Offset.Line, s.Offset.Column, s.Map.Sources, s.Map.Mappings))
+		}
+		want := []string{
+			"0:0 [/example.com/a/a.go] AAAC",
+			"2:0 [/example.com/a/ts/x.ts] ;IAMA",
+			"5:2 [/example.com/a/a.go] AAEC;AACA",
+		}
+		if strings.Join(got, "\n") != strings.Join(want, "\n") {
+			t.Errorf("got sections\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+		}
+	}
+}
+
 // stringSet is used to print a set of strings in a more readable way.
 type stringSet map[string]struct{}
 
@@ -4,13 +4,18 @@ package build
 
 import (
 	"bytes"
+	"encoding/base64"
 	"fmt"
 	"go/ast"
 	"go/parser"
 	"go/token"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/visualfc/goembed"
+	"github.com/visualfc/goembed/resolve"
 )
 
 func buildIdent(name string) string {
@@ -22,7 +27,7 @@ var embed_head = `package $pkg
 import (
 	"embed"
 	_ "unsafe"
-)
+$imports)
 
 //go:linkname gopherjs_embed_buildFS embed.buildFS
 func gopherjs_embed_buildFS(list []struct {
@@ -32,6 +37,58 @@ func gopherjs_embed_buildFS(list []struct {
 }) (f embed.FS)
 `
 
+// embed_decode turns the base64 of an embedded file back into its contents.
+var embed_decode = `
+func __js_embed_decode__(s string) string {
+	array := __js_embed_js__.Global.Call("$decodeBase64", s)
+	b := make([]byte, array.Length())
+	__js_embed_js__.InternalObject(b).Set("$array", array)
+	return string(b)
+}
+`
+
+// embedLiteral returns a Go expression for the contents of an embedded file:
+// a string literal if the file is mostly text, which the compiler emits
+// about as is, or else a call that decodes base64, since the compiler
+// escapes every other byte with four characters.
+func embedLiteral(data []byte) (expr string, decode bool) {
+	size := 0 // as emitted by the compiler
+	for _, b := range data {
+		switch {
+		case b == '"' || b == '\\' || b >= '\b' && b <= '\r':
+			size += 2
+		case b < 0x20 || b > 0x7E:
+			size += 4
+		default:
+			size++
+		}
+	}
+	if size <= base64.StdEncoding.EncodedLen(len(data)) {
+		return strconv.Quote(string(data)), false
+	}
+	return fmt.Sprintf("__js_embed_decode__(%q)", base64.StdEncoding.EncodeToString(data)), true
+}
+
+// embedFiles returns the paths of the files that pkg embeds.
+func embedFiles(pkg *PackageData) ([]string, error) {
+	if len(pkg.EmbedPatternPos) == 0 {
+		return nil, nil
+	}
+	patterns := make([]string, 0, len(pkg.EmbedPatternPos))
+	for pattern := range pkg.EmbedPatternPos {
+		patterns = append(patterns, pattern)
+	}
+	sort.Strings(patterns)
+	files, err := resolve.ResolveEmbed(pkg.Dir, patterns)
+	if err != nil {
+		return nil, err
+	}
+	for i, name := range files {
+		files[i] = filepath.Join(pkg.Dir, name)
+	}
+	return files, nil
+}
+
 func (s *Session) checkEmbed(pkg *PackageData, fset *token.FileSet, files []*ast.File) (*ast.File, error) {
 	if len(pkg.EmbedPatternPos) == 0 {
 		return nil, nil
@@ -42,11 +99,13 @@ func (s *Session) checkEmbed(pkg *PackageData, fset *token.FileSet, files []*ast
 	}
 	r := goembed.NewResolve()
 	var buf bytes.Buffer
-	buf.WriteString(strings.Replace(embed_head, "$pkg", pkg.Name, 1))
 	buf.WriteString("\nvar (\n")
 	for _, v := range ems {
 		v.Spec.Names[0].Name = "_"
-		fs, _ := r.Load(pkg.Dir, v)
+		fs, err := r.Load(pkg.Dir, v)
+		if err != nil {
+			return nil, err
+		}
 		switch v.Kind {
 		case goembed.EmbedBytes:
 			buf.WriteString(fmt.Sprintf("\t%v = []byte(%v)\n", v.Name, buildIdent(fs[0].Name)))
@@ -76,17 +135,30 @@ func (s *Session) checkEmbed(pkg *PackageData, fset *token.FileSet, files []*ast
 	}
 	buf.WriteString("\n)\n")
 	buf.WriteString("\nvar (\n")
+	var decode bool
 	for _, f := range r.Files() {
+		if f.Err != nil {
+			return nil, f.Err
+		}
 		if len(f.Data) == 0 {
 			buf.WriteString(fmt.Sprintf("\t%v string\n",
 				buildIdent(f.Name)))
 		} else {
-			buf.WriteString(fmt.Sprintf("\t%v = string(\"%v\")\n",
-				buildIdent(f.Name), goembed.BytesToHex(f.Data)))
+			expr, d := embedLiteral(f.Data)
+			decode = decode || d
+			buf.WriteString(fmt.Sprintf("\t%v = %v\n", buildIdent(f.Name), expr))
 		}
 	}
 	buf.WriteString(")\n\n")
-	f, err := parser.ParseFile(fset, "js_embed.go", buf.String(), parser.ParseComments)
+
+	imports := ""
+	if decode {
+		imports = "\t__js_embed_js__ \"github.com/gopherjs/gopherjs/js\"\n"
+		buf.WriteString(embed_decode)
+	}
+	head := strings.Replace(embed_head, "$pkg", pkg.Name, 1)
+	head = strings.Replace(head, "$imports", imports, 1)
+	f, err := parser.ParseFile(fset, "js_embed.go", head+buf.String(), parser.ParseComments)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,97 @@
+// +build go1.16
+
+package build
+
+import (
+	"encoding/base64"
+	"go/ast"
+	gobuild "go/build"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+// Text files are embedded as string literals, other files as base64.
+func TestCheckEmbed(t *testing.T) {
+	dir := t.TempDir()
+	binary := make([]byte, 256)
+	for i := range binary {
+		binary[i] = byte(i)
+	}
+	for name, data := range map[string][]byte{
+		"text.txt":   []byte("hello, embed\n"),
+		"binary.bin": binary,
+		"embed.go": []byte(`package p
+
+import "embed"
+
+//go:embed text.txt
+var text string
+
+//go:embed binary.bin
+var data []byte
+
+//go:embed *.txt *.bin
+var files embed.FS
+`),
+	} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), data, 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+	bpkg, err := gobuild.ImportDir(dir, 0)
+	if err != nil {
+		t.Fatalf("gobuild.ImportDir: %v", err)
+	}
+	pkg := &PackageData{Package: bpkg}
+
+	inputs, err := embedFiles(pkg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(inputs) != 2 || inputs[0] != filepath.Join(dir, "binary.bin") || inputs[1] != filepath.Join(dir, "text.txt") {
+		t.Errorf("got embedded files %q, want binary.bin and text.txt", inputs)
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filepath.Join(dir, "embed.go"), nil, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Session{}
+	embedFile, err := s.checkEmbed(pkg, fset, []*ast.File{f})
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := make(map[string]ast.Expr)
+	for _, decl := range embedFile.Decls {
+		if d, ok := decl.(*ast.GenDecl); ok && d.Tok == token.VAR {
+			for _, spec := range d.Specs {
+				spec := spec.(*ast.ValueSpec)
+				if len(spec.Values) == 1 {
+					values[spec.Names[0].Name] = spec.Values[0]
+				}
+			}
+		}
+	}
+
+	lit, ok := values[buildIdent("text.txt")].(*ast.BasicLit)
+	if !ok {
+		t.Fatalf("text.txt isn't embedded as a literal: %#v", values[buildIdent("text.txt")])
+	}
+	if s, _ := strconv.Unquote(lit.Value); s != "hello, embed\n" {
+		t.Errorf("got text.txt = %s, want %q", lit.Value, "hello, embed\n")
+	}
+
+	call, ok := values[buildIdent("binary.bin")].(*ast.CallExpr)
+	if !ok || call.Fun.(*ast.Ident).Name != "__js_embed_decode__" {
+		t.Fatalf("binary.bin isn't embedded as base64: %#v", values[buildIdent("binary.bin")])
+	}
+	encoded, _ := strconv.Unquote(call.Args[0].(*ast.BasicLit).Value)
+	if decoded, err := base64.StdEncoding.DecodeString(encoded); err != nil || string(decoded) != string(binary) {
+		t.Errorf("got binary.bin = %q, want the 256 byte values", encoded)
+	}
+}
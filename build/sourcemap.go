@@ -0,0 +1,289 @@
+package build
+
+import (
+	"encoding/json"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/goplusjs/gopherjs/compiler"
+	"github.com/goplusjs/gopherjs/compiler/natives"
+	"github.com/goplusjs/gopherjs/compiler/prelude"
+	"github.com/neelance/sourcemap"
+)
+
+// nativesSourcePrefix is prepended to the paths of natives in source maps.
+const nativesSourcePrefix = "github.com/gopherjs/gopherjs/compiler/natives"
+
+// SourceMap is the source map of a generated file. If contents is set, it
+// embeds the contents of the source files, so that they can be shown without
+// serving them.
+type SourceMap struct {
+	Map            *sourcemap.Map
+	goroot, gopath string
+	localMap       bool
+	contents       bool
+	// names caches the names of source files in the map, and files maps
+	// these names back to the files.
+	names map[string]string
+	files map[string]string
+	// functions holds the names of local variables in the Go source of the
+	// functions in the generated file, for debuggers.
+	functions []compiler.FuncNames
+
+	// A generated file that includes JavaScript files with source maps of
+	// their own is split into sections, see MappingCallback. index holds the
+	// finished sections, offset is where the current one starts and jsFile
+	// is the JavaScript file that it belongs to, or "" if Map maps it.
+	index  *IndexMap
+	offset IndexOffset
+	jsFile string
+	// jsMaps caches the source maps of JavaScript files, nil for those
+	// without one. The code of minified programs doesn't match them.
+	jsMaps map[string]*SourceMap
+	minify bool
+}
+
+// NewSourceMap returns an empty source map for the generated file named file.
+func NewSourceMap(file string, options *Options) *SourceMap {
+	return &SourceMap{
+		Map:      &sourcemap.Map{File: file},
+		goroot:   options.GOROOT,
+		gopath:   options.GOPATH,
+		localMap: options.MapToLocalDisk,
+		contents: options.MapContent,
+		names:    make(map[string]string),
+		files:    make(map[string]string),
+		jsMaps:   make(map[string]*SourceMap),
+		minify:   options.Minify,
+	}
+}
+
+// MappingCallback adds a mapping to m. It is meant to be used as
+// compiler.SourceMapFilter.MappingCallback.
+//
+// The code of a JavaScript file x.js with a source map x.js.map next to it,
+// e.g. because it was compiled from TypeScript, is mapped by that source map
+// instead. m then becomes an index map: a section for each such file and one
+// for the code between them.
+func (m *SourceMap) MappingCallback(generatedLine, generatedColumn int, originalPos token.Position) {
+	offset := IndexOffset{Line: generatedLine - 1, Column: generatedColumn}
+	if js := m.jsSourceMap(originalPos.Filename); js != nil {
+		if m.jsFile != originalPos.Filename {
+			m.endSection()
+			m.index.AddSection(offset.Line, offset.Column, js)
+			m.jsFile = originalPos.Filename
+		}
+		return
+	}
+	if m.jsFile != "" {
+		m.offset = offset
+		m.jsFile = ""
+	}
+	generatedLine -= m.offset.Line
+	if generatedLine == 1 {
+		generatedColumn -= m.offset.Column
+	}
+	if !originalPos.IsValid() {
+		m.Map.AddMapping(&sourcemap.Mapping{GeneratedLine: generatedLine, GeneratedColumn: generatedColumn})
+		return
+	}
+	m.Map.AddMapping(&sourcemap.Mapping{GeneratedLine: generatedLine, GeneratedColumn: generatedColumn, OriginalFile: m.sourceName(originalPos.Filename), OriginalLine: originalPos.Line, OriginalColumn: originalPos.Column})
+}
+
+// section returns the source map of the current section of m.
+func (m *SourceMap) section() *SourceMap {
+	section := *m
+	section.functions = nil
+	section.index = nil
+	return &section
+}
+
+// endSection adds the current section to the index of m, unless it belongs
+// to a JavaScript file and is already there.
+func (m *SourceMap) endSection() {
+	if m.index == nil {
+		m.index = NewIndexMap(m.Map.File)
+	}
+	if m.jsFile == "" && len(m.Map.DecodedMappings()) != 0 {
+		m.index.AddSection(m.offset.Line, m.offset.Column, m.section())
+	}
+	m.Map = &sourcemap.Map{File: m.Map.File}
+}
+
+// jsSourceMap returns the source map of the JavaScript file file, with the
+// original files named like those of m, or nil if it has none.
+func (m *SourceMap) jsSourceMap(file string) *SourceMap {
+	if m.minify || !strings.HasSuffix(file, ".js") || file == compiler.PreludeFile {
+		return nil
+	}
+	if js, ok := m.jsMaps[file]; ok {
+		return js
+	}
+	m.jsMaps[file] = nil
+	f, err := os.Open(file + ".map")
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+	jsMap, err := sourcemap.ReadFrom(f)
+	if err != nil {
+		return nil
+	}
+	js := m.section()
+	js.Map = &sourcemap.Map{File: m.Map.File}
+	for _, mapping := range jsMap.DecodedMappings() {
+		if mapping.OriginalFile != "" {
+			// Sources are relative to the source map.
+			original := filepath.FromSlash(path.Join(jsMap.SourceRoot, mapping.OriginalFile))
+			if !filepath.IsAbs(original) {
+				original = filepath.Join(filepath.Dir(file), original)
+			}
+			mapping.OriginalFile = js.sourceName(original)
+		}
+		js.Map.AddMapping(mapping)
+	}
+	m.jsMaps[file] = js
+	return js
+}
+
+func (m *SourceMap) sourceName(file string) string {
+	if name, ok := m.names[file]; ok {
+		return name
+	}
+	name := file
+	switch hasGopathPrefix, prefixLen := hasGopathPrefix(file, m.gopath); {
+	case m.localMap:
+		// no-op:  keep file as-is
+	case hasGopathPrefix:
+		name = filepath.ToSlash(file[prefixLen+4:])
+	case strings.HasPrefix(file, m.goroot):
+		name = filepath.ToSlash(file[len(m.goroot)+4:])
+	case isNativesFile(file):
+		name = path.Join(nativesSourcePrefix, file)
+	default:
+		name = filepath.Base(file)
+	}
+	m.names[file] = name
+	if _, ok := m.files[name]; !ok {
+		m.files[name] = file
+	}
+	return name
+}
+
+// FunctionCallback adds the variable names of a function to m. They are
+// encoded as the x_gopherjs_functions field, which is read by gopherjs debug.
+// It is meant to be used as compiler.SourceMapFilter.FunctionCallback.
+func (m *SourceMap) FunctionCallback(f compiler.FuncNames) {
+	f.File = m.sourceName(f.File)
+	m.functions = append(m.functions, f)
+}
+
+func isNativesFile(file string) bool {
+	if !strings.HasPrefix(file, "/src/") {
+		return false
+	}
+	f, err := natives.FS.Open(file)
+	if err != nil {
+		return false
+	}
+	f.Close()
+	return true
+}
+
+// readSource returns the content of a source file, which may be the prelude
+// or one of the natives.
+func readSource(file string) ([]byte, error) {
+	if file == compiler.PreludeFile {
+		return []byte(prelude.Prelude), nil
+	}
+	if isNativesFile(file) {
+		f, err := natives.FS.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return ioutil.ReadAll(f)
+	}
+	return ioutil.ReadFile(file)
+}
+
+// MarshalJSON encodes m, including the contents of its sources if requested.
+// Sources that can't be read, like generated files, have no content.
+func (m *SourceMap) MarshalJSON() ([]byte, error) {
+	if m.index != nil {
+		index := *m.index
+		if m.jsFile == "" {
+			index.Sections = append(index.Sections[:len(index.Sections):len(index.Sections)], IndexSection{Offset: m.offset, Map: m.section()})
+		}
+		return json.Marshal(struct {
+			*IndexMap
+			Functions []compiler.FuncNames `json:"x_gopherjs_functions,omitempty"`
+		}{&index, m.functions})
+	}
+	if m.Map.Version == 0 {
+		m.Map.Version = 3
+	}
+	m.Map.EncodeMappings()
+	var contents []*string
+	if m.contents {
+		contents = make([]*string, len(m.Map.Sources))
+		for i, name := range m.Map.Sources {
+			if content, err := readSource(m.files[name]); err == nil {
+				s := string(content)
+				contents[i] = &s
+			}
+		}
+	}
+	return json.Marshal(struct {
+		*sourcemap.Map
+		SourcesContent []*string            `json:"sourcesContent,omitempty"`
+		Functions      []compiler.FuncNames `json:"x_gopherjs_functions,omitempty"`
+	}{m.Map, contents, m.functions})
+}
+
+// Encode writes m as JSON to w.
+func (m *SourceMap) Encode(w io.Writer) error {
+	return json.NewEncoder(w).Encode(m)
+}
+
+// IndexMap is the source map of a generated file that is put together from
+// parts with source maps of their own.
+type IndexMap struct {
+	Version  int            `json:"version"`
+	File     string         `json:"file,omitempty"`
+	Sections []IndexSection `json:"sections"`
+}
+
+// IndexSection is the source map of a part of the file of an IndexMap.
+type IndexSection struct {
+	Offset IndexOffset `json:"offset"`
+	Map    *SourceMap  `json:"map"`
+}
+
+// IndexOffset is where a section starts. Both values are zero-based.
+type IndexOffset struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// NewIndexMap returns an index map without sections for the generated file
+// named file.
+func NewIndexMap(file string) *IndexMap {
+	return &IndexMap{Version: 3, File: file}
+}
+
+// AddSection adds the source map of the part that starts at the given line
+// and column of the generated file. Sections have to be added in order.
+func (m *IndexMap) AddSection(line, column int, section *SourceMap) {
+	m.Sections = append(m.Sections, IndexSection{Offset: IndexOffset{Line: line, Column: column}, Map: section})
+}
+
+// Encode writes m as JSON to w.
+func (m *IndexMap) Encode(w io.Writer) error {
+	return json.NewEncoder(w).Encode(m)
+}
@@ -4,11 +4,308 @@ import (
 	"go/ast"
 	"go/token"
 	"go/types"
+	"sort"
+
+	"github.com/goplusjs/gopherjs/compiler/astutil"
 )
 
-func EscapingObjects(n ast.Node, info *types.Info) []*types.Var {
+// EscapeInfo holds the results of AnalyzeEscapes.
+type EscapeInfo struct {
+	// NonEscapingParams lists for each function the pointer parameters that
+	// don't outlive a call, by index. For methods, the receiver has index 0.
+	NonEscapingParams map[*types.Func][]int
+	// params tells for each tracked parameter whether it escapes.
+	params map[*types.Var]bool
+	// nonEscapingAddrs are address operations on local variables whose result
+	// doesn't outlive the statement it is used in.
+	nonEscapingAddrs map[*ast.UnaryExpr]bool
+}
+
+// tracksPointer reports whether the escape analysis cares where a pointer of
+// type t goes. Pointers to structs and arrays are the JavaScript object
+// holding the value itself, so they never force a variable to be boxed.
+func tracksPointer(t types.Type) bool {
+	ptr, ok := t.Underlying().(*types.Pointer)
+	return ok && !isValueObject(ptr.Elem())
+}
+
+func isValueObject(t types.Type) bool {
+	switch t.Underlying().(type) {
+	case *types.Struct, *types.Array:
+		return true
+	}
+	return false
+}
+
+type pointerUse struct {
+	param *types.Var
+	stack []ast.Node
+}
+
+type escapeVisitor struct {
+	info         *types.Info
+	pkg          *types.Package
+	paramEscapes func(f *types.Func, i int) bool
+	stack        []ast.Node
+	fun          *types.Func
+	params       map[*types.Var]*types.Func // tracked parameters of functions in the package
+	indices      map[*types.Var]int
+	uses         []pointerUse
+	addrs        []pointerUse // param is the variable whose address is taken
+	escaping     map[*types.Var]bool
+}
+
+// AnalyzeEscapes finds pointers to local variables and parameters that don't
+// outlive the call or statement they are used in. paramEscapes reports the
+// same for parameters of imported functions.
+func AnalyzeEscapes(files []*ast.File, info *types.Info, pkg *types.Package, paramEscapes func(f *types.Func, i int) bool) *EscapeInfo {
+	v := &escapeVisitor{
+		info:         info,
+		pkg:          pkg,
+		paramEscapes: paramEscapes,
+		params:       make(map[*types.Var]*types.Func),
+		indices:      make(map[*types.Var]int),
+		escaping:     make(map[*types.Var]bool),
+	}
+	var funcs []*types.Func
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			fun, ok := decl.(*ast.FuncDecl)
+			if !ok || fun.Body == nil {
+				continue
+			}
+			o, ok := info.Defs[fun.Name].(*types.Func)
+			if !ok {
+				continue
+			}
+			sig := o.Type().(*types.Signature)
+			var vars []*types.Var
+			if sig.Recv() != nil {
+				vars = append(vars, sig.Recv())
+			}
+			for i := 0; i < sig.Params().Len(); i++ {
+				vars = append(vars, sig.Params().At(i))
+			}
+			for i, p := range vars {
+				if tracksPointer(p.Type()) {
+					v.params[p] = o
+					v.indices[p] = i
+				}
+			}
+			funcs = append(funcs, o)
+		}
+	}
+	for _, file := range files {
+		ast.Walk(v, file)
+	}
+
+	for {
+		done := true
+		for _, use := range v.uses {
+			if !v.escaping[use.param] && v.escapes(use.stack) {
+				v.escaping[use.param] = true
+				done = false
+			}
+		}
+		if done {
+			break
+		}
+	}
+
+	result := &EscapeInfo{
+		NonEscapingParams: make(map[*types.Func][]int),
+		params:            make(map[*types.Var]bool),
+		nonEscapingAddrs:  make(map[*ast.UnaryExpr]bool),
+	}
+	for p, f := range v.params {
+		result.params[p] = v.escaping[p]
+		if !v.escaping[p] {
+			result.NonEscapingParams[f] = append(result.NonEscapingParams[f], v.indices[p])
+		}
+	}
+	for _, f := range funcs {
+		sort.Ints(result.NonEscapingParams[f])
+	}
+	for _, addr := range v.addrs {
+		if !v.escapes(addr.stack) {
+			result.nonEscapingAddrs[addr.stack[len(addr.stack)-1].(*ast.UnaryExpr)] = true
+		}
+	}
+	return result
+}
+
+// ParamEscapes reports whether a pointer passed as parameter p may outlive the
+// call. tracked is false if p is not a pointer parameter of a function in the
+// analyzed package, or points to a struct or array.
+func (e *EscapeInfo) ParamEscapes(p *types.Var) (escapes, tracked bool) {
+	escapes, tracked = e.params[p]
+	return
+}
+
+func (v *escapeVisitor) Visit(node ast.Node) ast.Visitor {
+	if node == nil {
+		v.stack = v.stack[:len(v.stack)-1]
+		return nil
+	}
+	v.stack = append(v.stack, node)
+	switch n := node.(type) {
+	case *ast.FuncDecl:
+		v.fun, _ = v.info.Defs[n.Name].(*types.Func)
+	case *ast.Ident:
+		if p, ok := v.info.Uses[n].(*types.Var); ok && v.params[p] != nil {
+			if v.params[p] != v.fun || v.inFuncLit() {
+				v.escaping[p] = true
+				break
+			}
+			v.uses = append(v.uses, pointerUse{p, v.copyStack()})
+		}
+	case *ast.UnaryExpr:
+		if n.Op != token.AND {
+			break
+		}
+		id, ok := astutil.RemoveParens(n.X).(*ast.Ident)
+		if !ok {
+			break
+		}
+		if o, ok := v.info.Uses[id].(*types.Var); ok && o.Parent() != v.pkg.Scope() && !isValueObject(o.Type()) && !v.inFuncLit() {
+			v.addrs = append(v.addrs, pointerUse{o, v.copyStack()})
+		}
+	}
+	return v
+}
+
+func (v *escapeVisitor) inFuncLit() bool {
+	for _, n := range v.stack {
+		if _, ok := n.(*ast.FuncLit); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *escapeVisitor) copyStack() []ast.Node {
+	stack := make([]ast.Node, len(v.stack))
+	copy(stack, v.stack)
+	return stack
+}
+
+// escapes reports whether the pointer at the top of stack may outlive the
+// statement it is used in.
+func (v *escapeVisitor) escapes(stack []ast.Node) bool {
+	e := stack[len(stack)-1]
+	for i := len(stack) - 2; i >= 0; i-- {
+		switch p := stack[i].(type) {
+		case *ast.ParenExpr:
+			e = p
+			continue
+		case *ast.StarExpr:
+			return false
+		case *ast.BinaryExpr:
+			return p.Op != token.EQL && p.Op != token.NEQ
+		case *ast.SelectorExpr:
+			sel := v.info.Selections[p]
+			if sel == nil || sel.Kind() != types.MethodVal || p.X != e {
+				return true
+			}
+			method := sel.Obj().(*types.Func)
+			if _, isPtr := method.Type().(*types.Signature).Recv().Type().(*types.Pointer); !isPtr {
+				return false // dereferenced
+			}
+			if i == 0 {
+				return true
+			}
+			call, ok := stack[i-1].(*ast.CallExpr)
+			if !ok || astutil.RemoveParens(call.Fun) != p || isGoOrDefer(stack, i-1) {
+				return true
+			}
+			return v.paramEscapesIn(method, 0)
+		case *ast.CallExpr:
+			if isGoOrDefer(stack, i) || p.Ellipsis.IsValid() {
+				return true
+			}
+			for j, arg := range p.Args {
+				if arg == e {
+					return v.argEscapes(p, j)
+				}
+			}
+			return true
+		default:
+			return true
+		}
+	}
+	return true
+}
+
+func isGoOrDefer(stack []ast.Node, i int) bool {
+	if i == 0 {
+		return false
+	}
+	switch stack[i-1].(type) {
+	case *ast.GoStmt, *ast.DeferStmt:
+		return true
+	}
+	return false
+}
+
+// argEscapes reports whether the j-th argument of call escapes.
+func (v *escapeVisitor) argEscapes(call *ast.CallExpr, j int) bool {
+	var f *types.Func
+	switch fun := astutil.RemoveParens(call.Fun).(type) {
+	case *ast.Ident:
+		f, _ = v.info.Uses[fun].(*types.Func)
+	case *ast.SelectorExpr:
+		f, _ = v.info.Uses[fun.Sel].(*types.Func)
+		if sel := v.info.Selections[fun]; sel != nil {
+			switch sel.Kind() {
+			case types.MethodVal:
+				if _, isInterface := sel.Recv().Underlying().(*types.Interface); isInterface {
+					return true
+				}
+				j++
+			case types.MethodExpr:
+				if _, isInterface := sel.Recv().Underlying().(*types.Interface); isInterface {
+					return true
+				}
+			default:
+				return true
+			}
+		}
+	}
+	if f == nil {
+		return true
+	}
+	sig := f.Type().(*types.Signature)
+	n := sig.Params().Len()
+	if sig.Recv() != nil {
+		n++
+	}
+	if sig.Variadic() && j >= n-1 {
+		return true
+	}
+	return v.paramEscapesIn(f, j)
+}
+
+func (v *escapeVisitor) paramEscapesIn(f *types.Func, i int) bool {
+	if f.Pkg() != v.pkg {
+		return v.paramEscapes == nil || f.Pkg() == nil || v.paramEscapes(f, i)
+	}
+	for p, g := range v.params {
+		if g == f && v.indices[p] == i {
+			return v.escaping[p]
+		}
+	}
+	return true
+}
+
+// EscapingObjects returns the variables declared in n that have to be boxed,
+// because a function literal captures them or a pointer to them outlives n.
+// If escapes is nil, every pointer to a variable is assumed to outlive n, as
+// is necessary in functions that block. The address operations found not to
+// outlive n are returned as well.
+func EscapingObjects(n ast.Node, info *types.Info, escapes *EscapeInfo) ([]*types.Var, []*ast.UnaryExpr) {
 	v := escapeAnalysis{
 		info:         info,
+		escapes:      escapes,
 		escaping:     make(map[*types.Var]bool),
 		topScope:     info.Scopes[n],
 		bottomScopes: make(map[*types.Scope]bool),
@@ -18,12 +315,14 @@ func EscapingObjects(n ast.Node, info *types.Info) []*types.Var {
 	for obj := range v.escaping {
 		list = append(list, obj)
 	}
-	return list
+	return list, v.nonEscaping
 }
 
 type escapeAnalysis struct {
 	info         *types.Info
+	escapes      *EscapeInfo
 	escaping     map[*types.Var]bool
+	nonEscaping  []*ast.UnaryExpr
 	topScope     *types.Scope
 	bottomScopes map[*types.Scope]bool
 }
@@ -33,7 +332,14 @@ func (v *escapeAnalysis) Visit(node ast.Node) (w ast.Visitor) {
 	switch n := node.(type) {
 	case *ast.UnaryExpr:
 		if n.Op == token.AND {
-			if _, ok := n.X.(*ast.Ident); ok {
+			if id, ok := n.X.(*ast.Ident); ok {
+				if isValueObject(v.info.TypeOf(id)) {
+					return nil
+				}
+				if v.escapes != nil && v.escapes.nonEscapingAddrs[n] {
+					v.nonEscaping = append(v.nonEscaping, n)
+					return nil
+				}
 				return &escapingObjectCollector{v}
 			}
 		}
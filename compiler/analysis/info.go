@@ -16,8 +16,12 @@ type continueStmt struct {
 
 type Info struct {
 	*types.Info
-	Pkg           *types.Package
-	IsBlocking    func(*types.Func) bool
+	Pkg        *types.Package
+	IsBlocking func(*types.Func) bool
+	// MayBlock is only set by AnalyzePkgOptimistic. It reports whether an
+	// imported function for which IsBlocking is false blocks if calls through
+	// interfaces and function values do.
+	MayBlock      func(*types.Func) bool
 	HasPointer    map[*types.Var]bool
 	FuncDeclInfos map[*types.Func]*FuncInfo
 	FuncLitInfos  map[*ast.FuncLit]*FuncInfo
@@ -45,9 +49,19 @@ type FuncInfo struct {
 	// switch/case statement. This is distinct from labeled loop statements, which
 	// have native JS syntax and don't require special handling.
 	GotoLabel map[*types.Label]bool
+	// BlockingDeps is only set by AnalyzePkgOptimistic. It lists the calls that
+	// were assumed not to block, but block if their target does: FuncKey and
+	// MethodKey of calls through function values and interfaces, full names
+	// of functions that block only because of such calls and NoSyncPark.
+	//
+	// Whether those targets block can only be decided for the whole program,
+	// see compiler.BlockingNode.
+	BlockingDeps map[string]bool
 
 	// All callsite AST paths for all functions called by this function.
 	localCalls map[*types.Func][][]ast.Node
+	// Function literals which are called right where they are defined.
+	litCalls []*FuncInfo
 	// All "continue" operators in the function body.
 	//
 	// "continue" operator may trigger blocking calls in for loop condition or
@@ -65,16 +79,33 @@ func (info *Info) newFuncInfo() *FuncInfo {
 		GotoLabel:   make(map[*types.Label]bool),
 		localCalls:  make(map[*types.Func][][]ast.Node),
 	}
+	if info.MayBlock != nil {
+		funcInfo.BlockingDeps = make(map[string]bool)
+	}
 	info.allInfos = append(info.allInfos, funcInfo)
 	return funcInfo
 }
 
 func AnalyzePkg(files []*ast.File, fileSet *token.FileSet, typesInfo *types.Info, typesPkg *types.Package, isBlocking func(*types.Func) bool) *Info {
+	return analyzePkg(files, typesInfo, typesPkg, isBlocking, nil)
+}
+
+// AnalyzePkgOptimistic is like AnalyzePkg, but assumes that calls through
+// interfaces and function values, and the calls with which nosync locks
+// wait, don't block. Such calls are recorded in FuncInfo.BlockingDeps instead.
+// isBlocking reports whether an imported function blocks under the same
+// assumption, mayBlock whether it blocks without it.
+func AnalyzePkgOptimistic(files []*ast.File, fileSet *token.FileSet, typesInfo *types.Info, typesPkg *types.Package, isBlocking, mayBlock func(*types.Func) bool) *Info {
+	return analyzePkg(files, typesInfo, typesPkg, isBlocking, mayBlock)
+}
+
+func analyzePkg(files []*ast.File, typesInfo *types.Info, typesPkg *types.Package, isBlocking, mayBlock func(*types.Func) bool) *Info {
 	info := &Info{
 		Info:          typesInfo,
 		Pkg:           typesPkg,
 		HasPointer:    make(map[*types.Var]bool),
 		IsBlocking:    isBlocking,
+		MayBlock:      mayBlock,
 		FuncDeclInfos: make(map[*types.Func]*FuncInfo),
 		FuncLitInfos:  make(map[*ast.FuncLit]*FuncInfo),
 	}
@@ -105,6 +136,33 @@ func AnalyzePkg(files []*ast.File, fileSet *token.FileSet, typesInfo *types.Info
 		}
 	}
 
+	// Calls to local functions and function literals which don't block by
+	// themselves still depend on whatever those depend on.
+	if info.MayBlock != nil {
+		for {
+			done := true
+			for _, funcInfo := range info.allInfos {
+				for obj := range funcInfo.localCalls {
+					if len(info.FuncDeclInfos[obj].BlockingDeps) != 0 && !funcInfo.BlockingDeps[obj.FullName()] {
+						funcInfo.BlockingDeps[obj.FullName()] = true
+						done = false
+					}
+				}
+				for _, lit := range funcInfo.litCalls {
+					for dep := range lit.BlockingDeps {
+						if !funcInfo.BlockingDeps[dep] {
+							funcInfo.BlockingDeps[dep] = true
+							done = false
+						}
+					}
+				}
+			}
+			if done {
+				break
+			}
+		}
+	}
+
 	// Detect all "continue" statements that lead to blocking calls.
 	for _, funcInfo := range info.allInfos {
 		for _, continueStmt := range funcInfo.continueStmts {
@@ -177,15 +235,21 @@ func (c *FuncInfo) Visit(node ast.Node) ast.Visitor {
 		callTo := func(obj types.Object) {
 			switch o := obj.(type) {
 			case *types.Func:
+				if c.packageInfo.MayBlock != nil && isNoSyncPark(o) {
+					c.BlockingDeps[NoSyncPark] = true
+					return
+				}
 				if recv := o.Type().(*types.Signature).Recv(); recv != nil {
 					if _, ok := recv.Type().Underlying().(*types.Interface); ok {
-						c.markBlocking(c.analyzeStack)
+						c.dynamicCall(MethodKey(o))
 						return
 					}
 				}
 				if o.Pkg() != c.packageInfo.Pkg {
 					if c.packageInfo.IsBlocking(o) {
 						c.markBlocking(c.analyzeStack)
+					} else if c.packageInfo.MayBlock != nil && c.packageInfo.MayBlock(o) {
+						c.BlockingDeps[o.FullName()] = true
 					}
 					return
 				}
@@ -193,7 +257,7 @@ func (c *FuncInfo) Visit(node ast.Node) ast.Visitor {
 				copy(stack, c.analyzeStack)
 				c.localCalls[o] = append(c.localCalls[o], stack)
 			case *types.Var:
-				c.markBlocking(c.analyzeStack)
+				c.funcValueCall(o.Type())
 			}
 		}
 		switch f := astutil.RemoveParens(n.Fun).(type) {
@@ -209,13 +273,15 @@ func (c *FuncInfo) Visit(node ast.Node) ast.Visitor {
 			for _, arg := range n.Args {
 				ast.Walk(c, arg)
 			}
-			if len(c.packageInfo.FuncLitInfos[f].Blocking) != 0 {
+			litInfo := c.packageInfo.FuncLitInfos[f]
+			if len(litInfo.Blocking) != 0 {
 				c.markBlocking(c.analyzeStack)
 			}
+			c.litCalls = append(c.litCalls, litInfo)
 			return nil
 		default:
 			if !astutil.IsTypeExpr(f, c.packageInfo.Info) {
-				c.markBlocking(c.analyzeStack)
+				c.funcValueCall(c.packageInfo.TypeOf(f))
 			}
 		}
 	case *ast.SendStmt:
@@ -269,6 +335,24 @@ func (c *FuncInfo) Visit(node ast.Node) ast.Visitor {
 	return c
 }
 
+// dynamicCall handles a call that blocks if any function matching key does.
+func (c *FuncInfo) dynamicCall(key string) {
+	if c.packageInfo.MayBlock == nil {
+		c.markBlocking(c.analyzeStack)
+		return
+	}
+	c.BlockingDeps[key] = true
+}
+
+func (c *FuncInfo) funcValueCall(t types.Type) {
+	sig, ok := t.Underlying().(*types.Signature)
+	if !ok {
+		c.markBlocking(c.analyzeStack)
+		return
+	}
+	c.dynamicCall(FuncKey(sig, nil))
+}
+
 func (c *FuncInfo) markBlocking(stack []ast.Node) {
 	for _, n := range stack {
 		c.Blocking[n] = true
@@ -0,0 +1,164 @@
+package analysis
+
+import (
+	"bytes"
+	"go/types"
+	"strconv"
+	"strings"
+)
+
+// NoSyncPark is the BlockingDeps key of the calls of park, with which the
+// locks of package nosync wait for the goroutine that holds them. No function
+// has this key. Another goroutine can only hold a lock while it is suspended,
+// so whether the calls block is decided for the whole program: they do if a
+// function that takes a nosync lock blocks (see compiler.BlockingNode).
+const NoSyncPark = "nosync.park"
+
+const noSyncPath = "github.com/gopherjs/gopherjs/nosync"
+
+// TakesNoSyncLock reports whether the BlockingDeps key dep is the full name of
+// a method of package nosync, which only blocks if NoSyncPark does.
+func TakesNoSyncLock(dep string) bool {
+	return strings.HasPrefix(dep, "(*"+noSyncPath+".")
+}
+
+func isNoSyncPark(f *types.Func) bool {
+	return f.Pkg() != nil && f.Pkg().Path() == noSyncPath && f.Name() == "park"
+}
+
+// FuncKey identifies the functions that a call through a function value of
+// type sig may reach. If recv is not nil, it is added as the first parameter,
+// as for method expressions.
+//
+// Identical types always have the same key. Different types may share one,
+// which only makes the blocking analysis more conservative.
+func FuncKey(sig *types.Signature, recv types.Type) string {
+	var buf bytes.Buffer
+	buf.WriteString("func:")
+	writeSignatureKey(&buf, sig, recv)
+	return buf.String()
+}
+
+// MethodKey identifies the methods that a call through an interface method m
+// may reach.
+func MethodKey(m *types.Func) string {
+	var buf bytes.Buffer
+	buf.WriteString("method:")
+	if !m.Exported() {
+		buf.WriteString(m.Pkg().Path())
+		buf.WriteByte('.')
+	}
+	buf.WriteString(m.Name())
+	writeSignatureKey(&buf, m.Type().(*types.Signature), nil)
+	return buf.String()
+}
+
+func writeSignatureKey(buf *bytes.Buffer, sig *types.Signature, recv types.Type) {
+	buf.WriteByte('(')
+	if recv != nil {
+		writeTypeKey(buf, recv)
+		if sig.Params().Len() != 0 {
+			buf.WriteString(", ")
+		}
+	}
+	writeTupleKey(buf, sig.Params(), sig.Variadic())
+	buf.WriteByte(')')
+	if sig.Results().Len() != 0 {
+		buf.WriteString(" (")
+		writeTupleKey(buf, sig.Results(), false)
+		buf.WriteByte(')')
+	}
+}
+
+func writeTupleKey(buf *bytes.Buffer, t *types.Tuple, variadic bool) {
+	for i := 0; i < t.Len(); i++ {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		typ := t.At(i).Type()
+		if variadic && i == t.Len()-1 {
+			buf.WriteString("...")
+			typ = typ.(*types.Slice).Elem()
+		}
+		writeTypeKey(buf, typ)
+	}
+}
+
+// writeTypeKey is like types.WriteType, but leaves out parameter names, which
+// don't matter for type identity.
+func writeTypeKey(buf *bytes.Buffer, t types.Type) {
+	switch t := t.(type) {
+	case *types.Named:
+		if pkg := t.Obj().Pkg(); pkg != nil {
+			buf.WriteString(pkg.Path())
+			buf.WriteByte('.')
+		}
+		buf.WriteString(t.Obj().Name())
+	case *types.Pointer:
+		buf.WriteByte('*')
+		writeTypeKey(buf, t.Elem())
+	case *types.Slice:
+		buf.WriteString("[]")
+		writeTypeKey(buf, t.Elem())
+	case *types.Array:
+		buf.WriteByte('[')
+		buf.WriteString(strconv.FormatInt(t.Len(), 10))
+		buf.WriteByte(']')
+		writeTypeKey(buf, t.Elem())
+	case *types.Map:
+		buf.WriteString("map[")
+		writeTypeKey(buf, t.Key())
+		buf.WriteByte(']')
+		writeTypeKey(buf, t.Elem())
+	case *types.Chan:
+		switch t.Dir() {
+		case types.SendOnly:
+			buf.WriteString("chan<- ")
+		case types.RecvOnly:
+			buf.WriteString("<-chan ")
+		default:
+			buf.WriteString("chan ")
+		}
+		writeTypeKey(buf, t.Elem())
+	case *types.Signature:
+		buf.WriteString("func")
+		writeSignatureKey(buf, t, nil)
+	case *types.Struct:
+		buf.WriteString("struct{")
+		for i := 0; i < t.NumFields(); i++ {
+			if i != 0 {
+				buf.WriteString("; ")
+			}
+			f := t.Field(i)
+			if !f.Exported() {
+				buf.WriteString(f.Pkg().Path())
+				buf.WriteByte('.')
+			}
+			buf.WriteString(f.Name())
+			buf.WriteByte(' ')
+			writeTypeKey(buf, f.Type())
+			if tag := t.Tag(i); tag != "" {
+				buf.WriteByte(' ')
+				buf.WriteString(strconv.Quote(tag))
+			}
+		}
+		buf.WriteByte('}')
+	case *types.Interface:
+		buf.WriteString("interface{")
+		for i := 0; i < t.NumMethods(); i++ {
+			if i != 0 {
+				buf.WriteString("; ")
+			}
+			m := t.Method(i)
+			if !m.Exported() {
+				buf.WriteString(m.Pkg().Path())
+				buf.WriteByte('.')
+			}
+			buf.WriteString(m.Name())
+			writeSignatureKey(buf, m.Type().(*types.Signature), nil)
+		}
+		buf.WriteByte('}')
+	default:
+		buf.WriteString(t.String())
+	}
+}
@@ -0,0 +1,115 @@
+package compiler
+
+import (
+	"go/ast"
+	"go/types"
+	"sort"
+
+	"github.com/goplusjs/gopherjs/compiler/analysis"
+	"github.com/goplusjs/gopherjs/compiler/astutil"
+	"github.com/goplusjs/gopherjs/compiler/typesutil"
+)
+
+// translateOptimistic runs translate with the results of
+// analysis.AnalyzePkgOptimistic. Names of local objects are not shared with
+// the regular translation of the same code.
+func (c *funcContext) translateOptimistic(info *analysis.Info, translate func() []byte) []byte {
+	prevInfo, prevObjectNames, prevVarPtrNames := c.p.Info, c.p.objectNames, c.p.varPtrNames
+	c.p.Info = info
+	c.p.objectNames = make(map[types.Object]string)
+	for o, name := range prevObjectNames {
+		if isPkgLevel(o) {
+			c.p.objectNames[o] = name
+		}
+	}
+	c.p.varPtrNames = make(map[*types.Var]string)
+	for o, name := range prevVarPtrNames {
+		if isPkgLevel(o) {
+			c.p.varPtrNames[o] = name
+		}
+	}
+
+	code := translate()
+
+	for o, name := range c.p.objectNames {
+		if _, ok := prevObjectNames[o]; !ok && isPkgLevel(o) {
+			prevObjectNames[o] = name
+		}
+	}
+	for o, name := range c.p.varPtrNames {
+		if _, ok := prevVarPtrNames[o]; !ok && isPkgLevel(o) {
+			prevVarPtrNames[o] = name
+		}
+	}
+	c.p.Info, c.p.objectNames, c.p.varPtrNames = prevInfo, prevObjectNames, prevVarPtrNames
+	return code
+}
+
+// blockingNodes returns the BlockingNodes of a declaration: one for fun, if
+// not nil, and one for each function literal in n. Method values and method
+// expressions in n that aren't called right away get a node as well, since
+// calling them through a function value calls the method.
+func (c *funcContext) blockingNodes(info *analysis.Info, fun *types.Func, n ast.Node) []BlockingNode {
+	var nodes []BlockingNode
+	if fun != nil {
+		funcInfo := info.FuncDeclInfos[fun]
+		node := BlockingNode{
+			Keys:     []string{fun.FullName()},
+			Blocking: len(funcInfo.Blocking) != 0,
+			Deps:     sortedKeys(funcInfo.BlockingDeps),
+		}
+		sig := fun.Type().(*types.Signature)
+		if sig.Recv() == nil {
+			node.Keys = append(node.Keys, analysis.FuncKey(sig, nil))
+		} else {
+			node.Keys = append(node.Keys, analysis.MethodKey(fun))
+		}
+		nodes = append(nodes, node)
+	}
+	if n == nil {
+		return nodes
+	}
+
+	called := make(map[ast.Expr]bool)
+	ast.Inspect(n, func(n ast.Node) bool {
+		switch e := n.(type) {
+		case *ast.CallExpr:
+			called[astutil.RemoveParens(e.Fun)] = true
+		case *ast.FuncLit:
+			funcInfo := info.FuncLitInfos[e]
+			nodes = append(nodes, BlockingNode{
+				Keys:     []string{analysis.FuncKey(c.p.TypeOf(e).(*types.Signature), nil)},
+				Blocking: len(funcInfo.Blocking) != 0,
+				Deps:     sortedKeys(funcInfo.BlockingDeps),
+			})
+		case *ast.SelectorExpr:
+			sel := c.p.Selections[e]
+			if called[e] || sel == nil || sel.Kind() == types.FieldVal || typesutil.IsJsObject(sel.Recv()) {
+				break
+			}
+			method := sel.Obj().(*types.Func)
+			dep := method.FullName()
+			if _, ok := method.Type().(*types.Signature).Recv().Type().Underlying().(*types.Interface); ok {
+				dep = analysis.MethodKey(method)
+			}
+			nodes = append(nodes, BlockingNode{
+				Keys: []string{analysis.FuncKey(c.p.TypeOf(e).(*types.Signature), nil)},
+				Deps: []string{dep},
+			})
+		}
+		return true
+	})
+	return nodes
+}
+
+func sortedKeys(m map[string]bool) []string {
+	if len(m) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
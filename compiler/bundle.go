@@ -0,0 +1,198 @@
+package compiler
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"go/types"
+	"io"
+	"sort"
+
+	"golang.org/x/tools/go/gcexportdata"
+)
+
+// A Bundle is a set of compiled packages, like the standard library, that
+// other packages are compiled against in memory, without a Go installation or
+// a file system. This is how Go is compiled in the browser: the package
+// compiler can itself be compiled with GopherJS, and a bundle written by
+// "gopherjs bundle" is loaded with ReadBundle.
+//
+// The packages of a bundle are compiled and augmented by GopherJS already, so
+// the packages compiled against it need no natives.
+type Bundle struct {
+	archives map[string]*Archive
+	packages map[string]*types.Package
+}
+
+// importAliases are the import paths that user code may import packages of
+// GopherJS by, mapped to the paths of the packages.
+var importAliases = map[string]string{
+	"github.com/goplusjs/gopherjs/js":     "github.com/gopherjs/gopherjs/js",
+	"github.com/goplusjs/gopherjs/nosync": "github.com/gopherjs/gopherjs/nosync",
+}
+
+// NewBundle returns an empty bundle.
+func NewBundle() *Bundle {
+	return &Bundle{
+		archives: make(map[string]*Archive),
+		packages: make(map[string]*types.Package),
+	}
+}
+
+// ReadBundle reads a bundle written by Bundle.Write.
+func ReadBundle(r io.Reader) (*Bundle, error) {
+	b := NewBundle()
+	dec := gob.NewDecoder(r)
+	for {
+		a := new(Archive)
+		if err := dec.Decode(a); err == io.EOF {
+			return b, nil
+		} else if err != nil {
+			return nil, err
+		}
+		if err := b.Add(a); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Write writes the packages of b to w, to be read by ReadBundle.
+func (b *Bundle) Write(w io.Writer) error {
+	paths := make([]string, 0, len(b.archives))
+	for path := range b.archives {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	// The packages are written dependencies first, since ReadBundle loads the
+	// types of each from those of its imports.
+	enc := gob.NewEncoder(w)
+	written := make(map[string]bool)
+	var write func(path string) error
+	write = func(path string) error {
+		if written[path] {
+			return nil
+		}
+		written[path] = true
+		a := b.archives[path]
+		for _, imp := range a.Imports {
+			if err := write(imp); err != nil {
+				return err
+			}
+		}
+		return enc.Encode(a)
+	}
+	for _, path := range paths {
+		if err := write(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Add adds the compiled package a to b, replacing the package with the same
+// import path, if any. The packages that a imports must be in b already.
+func (b *Bundle) Add(a *Archive) error {
+	for _, imp := range a.Imports {
+		if _, ok := b.archives[imp]; !ok {
+			return fmt.Errorf("package %s imports %s, which is not in the bundle", a.ImportPath, imp)
+		}
+	}
+	delete(b.packages, a.ImportPath)
+	pkg, err := gcexportdata.Read(bytes.NewReader(a.ExportData), token.NewFileSet(), b.packages, a.ImportPath)
+	if err != nil {
+		return err
+	}
+	b.packages[a.ImportPath] = pkg
+	b.archives[a.ImportPath] = a
+	return nil
+}
+
+// Archive returns the package of b with the given import path.
+func (b *Bundle) Archive(path string) (*Archive, error) {
+	if alias, ok := importAliases[path]; ok {
+		path = alias
+	}
+	a, ok := b.archives[path]
+	if !ok {
+		return nil, fmt.Errorf("cannot find package %q in the bundle", path)
+	}
+	return a, nil
+}
+
+// Packages returns the import paths of the packages of b, sorted.
+func (b *Bundle) Packages() []string {
+	paths := make([]string, 0, len(b.archives))
+	for path := range b.archives {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// Compile parses and compiles the Go source files of the package with the
+// given import path, which are named by the keys of files, and adds the
+// package to b, so that packages compiled later can import it. Its imports
+// must be in b. All the files are compiled, in the order of their names: build
+// constraints are not evaluated. Errors in the source are returned as an
+// ErrorList.
+func (b *Bundle) Compile(importPath string, files map[string][]byte, options Options) (*Archive, error) {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fileSet := token.NewFileSet()
+	mode := parser.ParseComments
+	if options.AllErrors {
+		mode |= parser.AllErrors
+	}
+	var parsed []*ast.File
+	var errList ErrorList
+	for _, name := range names {
+		file, err := parser.ParseFile(fileSet, name, files[name], mode)
+		if err != nil {
+			if list, isList := err.(scanner.ErrorList); isList {
+				var fileErrs ErrorList
+				for _, entry := range list {
+					fileErrs = append(fileErrs, NewError(importPath, entry))
+				}
+				errList = append(errList, fileErrs.Truncate(options.AllErrors)...)
+				continue
+			}
+			errList = append(errList, err)
+			continue
+		}
+		parsed = append(parsed, file)
+	}
+	if errList != nil {
+		return nil, errList
+	}
+
+	importContext := &ImportContext{
+		Packages: b.packages,
+		Import:   b.Archive,
+	}
+	a, err := Compile(importPath, parsed, fileSet, importContext, nil, options)
+	if err != nil {
+		return nil, err
+	}
+	// Compile has put the types of the package in b.packages.
+	b.archives[importPath] = a
+	return a, nil
+}
+
+// WriteProgram writes the JavaScript program of the main package pkg, with
+// the packages of b that it needs, to w.
+func (b *Bundle) WriteProgram(pkg *Archive, w *SourceMapFilter) error {
+	deps, err := ImportDependencies(pkg, b.Archive)
+	if err != nil {
+		return err
+	}
+	return WriteProgramCode(deps, w)
+}
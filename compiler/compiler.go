@@ -6,11 +6,14 @@ import (
 	"encoding/gob"
 	"encoding/json"
 	"fmt"
+	"go/ast"
 	"go/token"
 	"go/types"
 	"io"
 	"strings"
 
+	"github.com/goplusjs/gopherjs/compiler/analysis"
+	"github.com/goplusjs/gopherjs/compiler/jsmin"
 	"github.com/goplusjs/gopherjs/compiler/prelude"
 	"golang.org/x/tools/go/gcexportdata"
 )
@@ -24,12 +27,6 @@ func init() {
 	}
 }
 
-type ErrorList []error
-
-func (err ErrorList) Error() string {
-	return err[0].Error()
-}
-
 type Archive struct {
 	ImportPath   string
 	Name         string
@@ -52,6 +49,39 @@ type Decl struct {
 	DceMethodFilter string
 	DceDeps         []string
 	Blocking        bool
+	// FastDeclCode is set for blocking functions that only block if a call
+	// through an interface or function value does. It is DeclCode compiled
+	// assuming that those calls don't block and replaces DeclCode if that holds
+	// for the whole program.
+	FastDeclCode []byte
+	// BlockingNodes describe the functions in the declaration for the
+	// whole-program blocking analysis: the declared function, if any, followed
+	// by all function literals.
+	BlockingNodes []BlockingNode
+	// Inline is the source of a function literal equivalent to the declared
+	// function if calls to it can be inlined in other packages.
+	Inline string
+	// NonEscapingParams are the indices of the pointer parameters of the
+	// declared function that don't outlive a call. For methods, the receiver
+	// has index 0.
+	NonEscapingParams []int
+	// Functions relates the names of variables in the functions of the
+	// declaration to their Go names, if compiled with DebugInfo.
+	Functions []FuncNames
+}
+
+// BlockingNode describes one function for the whole-program blocking analysis.
+// A function blocks if Blocking is set or any of Deps blocks. A key blocks if
+// a selected function listing it in Keys blocks.
+type BlockingNode struct {
+	// Keys are the full name of the function and the analysis.FuncKey and
+	// analysis.MethodKey of the calls that may reach it.
+	Keys []string
+	// Blocking reports whether the function blocks even if no call through an
+	// interface or function value does.
+	Blocking bool
+	// Deps are the keys of the calls which were assumed not to block.
+	Deps []string
 }
 
 type Dependency struct {
@@ -95,6 +125,10 @@ func ImportDependencies(archive *Archive, importPkg func(string) (*Archive, erro
 	return deps, nil
 }
 
+// dceAllMethods is the DceDeps entry of declarations that may call any
+// exported method by name, e.g. using reflect.Value.Method.
+const dceAllMethods = "*~"
+
 type dceInfo struct {
 	decl         *Decl
 	objectFilter string
@@ -120,11 +154,55 @@ func WriteProgramCode(pkgs []*Archive, w *SourceMapFilter) error {
 			}
 			if d.DceMethodFilter != "" {
 				info.methodFilter = pkg.ImportPath + "." + d.DceMethodFilter
+				if ast.IsExported(d.DceMethodFilter) {
+					// Exported methods may be called through interfaces
+					// declared in any package.
+					info.methodFilter = d.DceMethodFilter
+				}
 				byFilter[info.methodFilter] = append(byFilter[info.methodFilter], info)
 			}
 		}
 	}
 
+	release := func(dep string) {
+		infos, ok := byFilter[dep]
+		if !ok {
+			return
+		}
+		delete(byFilter, dep)
+		for _, info := range infos {
+			if info.objectFilter == dep {
+				info.objectFilter = ""
+			}
+			if info.methodFilter == dep {
+				info.methodFilter = ""
+			}
+			if info.objectFilter == "" && info.methodFilter == "" {
+				pendingDecls = append(pendingDecls, info.decl)
+			}
+		}
+	}
+	releaseAllMethods := func() {
+		for dep := range byFilter {
+			if strings.HasSuffix(dep, "~") && !strings.Contains(dep, ".") {
+				release(dep)
+			}
+		}
+	}
+
+	// The prelude calls these methods of panic values, and UnixNano of the
+	// time.Time values it externalizes.
+	release("Error~")
+	release("String~")
+	release("UnixNano~")
+	for _, pkg := range pkgs {
+		if len(pkg.IncJSCode) != 0 {
+			// JavaScript code may call any exported method.
+			releaseAllMethods()
+			break
+		}
+	}
+
 	dceSelection := make(map[*Decl]struct{})
 	for len(pendingDecls) != 0 {
 		d := pendingDecls[len(pendingDecls)-1]
@@ -133,52 +211,146 @@ func WriteProgramCode(pkgs []*Archive, w *SourceMapFilter) error {
 		dceSelection[d] = struct{}{}
 
 		for _, dep := range d.DceDeps {
-			if infos, ok := byFilter[dep]; ok {
-				delete(byFilter, dep)
-				for _, info := range infos {
-					if info.objectFilter == dep {
-						info.objectFilter = ""
-					}
-					if info.methodFilter == dep {
-						info.methodFilter = ""
-					}
-					if info.objectFilter == "" && info.methodFilter == "" {
-						pendingDecls = append(pendingDecls, info.decl)
-					}
-				}
+			if dep == dceAllMethods {
+				releaseAllMethods()
+				continue
 			}
+			release(dep)
 		}
 	}
 
 	if _, err := w.Write([]byte("\"use strict\";\n(function() {\n\n")); err != nil {
 		return err
 	}
-	preludeJS := prelude.Prelude
-	if minify {
-		preludeJS = prelude.Minified
-	}
-	if _, err := io.WriteString(w, preludeJS); err != nil {
+	if _, err := w.Write(preludeCode(minify, w)); err != nil {
 		return err
 	}
 	if _, err := w.Write([]byte("\n")); err != nil {
 		return err
 	}
 
+	fastDecls := selectFastDecls(dceSelection)
+	var m *mangler
+	if minify {
+		m = newMangler(pkgs)
+	}
+
 	// write packages
 	for _, pkg := range pkgs {
-		if err := WritePkgCode(pkg, dceSelection, minify, w); err != nil {
+		if err := writePkgCode(pkg, dceSelection, fastDecls, m, minify, w); err != nil {
 			return err
 		}
 	}
 
-	if _, err := w.Write([]byte("$synthesizeMethods();\nvar $mainPkg = $packages[\"" + string(mainPkg.ImportPath) + "\"];\n$packages[\"runtime\"].$init();\n$go($mainPkg.$init, []);\n$flushConsole();\n\n}).call(this);\n")); err != nil {
+	if _, err := w.Write(m.rewrite([]byte("$synthesizeMethods();\nvar $mainPkg = $packages[\"" + string(mainPkg.ImportPath) + "\"];\n$packages[\"runtime\"].$init();\n$go($mainPkg.$init, []);\n$flushConsole();\n\n}).call(this);\n"))); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// PreludeFile is the name the prelude has in source maps.
+const PreludeFile = "gopherjs/prelude.js"
+
+// preludeCode returns the prelude, marked with the positions of its lines if
+// w creates a source map.
+func preludeCode(minify bool, w *SourceMapFilter) []byte {
+	code := []byte(prelude.Prelude)
+	if minify {
+		code = []byte(prelude.Minified())
+	}
+	if w.MappingCallback == nil {
+		return code
+	}
+	w.fileSet = token.NewFileSet()
+	file := w.fileSet.AddFile(PreludeFile, -1, len(prelude.Prelude))
+	file.SetLinesForContent([]byte(prelude.Prelude))
+	if minify {
+		return markPositions(code, prelude.MinifiedMappings(), file)
+	}
+	return MarkJS(code, file)
+}
+
+// selectFastDecls returns the selected declarations whose FastDeclCode can be
+// used because none of the calls it assumes not to block do in this program.
+func selectFastDecls(dceSelection map[*Decl]struct{}) map[*Decl]bool {
+	dependents := make(map[string][]*BlockingNode)
+	blockingNodes := make(map[*BlockingNode]bool)
+	var queue []*BlockingNode
+	for d := range dceSelection {
+		for i := range d.BlockingNodes {
+			n := &d.BlockingNodes[i]
+			for _, dep := range n.Deps {
+				dependents[dep] = append(dependents[dep], n)
+			}
+			if n.Blocking {
+				blockingNodes[n] = true
+				queue = append(queue, n)
+			}
+		}
+	}
+
+	blockingKeys := make(map[string]bool)
+	block := func(key string) {
+		if blockingKeys[key] {
+			return
+		}
+		blockingKeys[key] = true
+		for _, m := range dependents[key] {
+			if !blockingNodes[m] {
+				blockingNodes[m] = true
+				queue = append(queue, m)
+			}
+		}
+	}
+	propagate := func() {
+		for len(queue) != 0 {
+			n := queue[len(queue)-1]
+			queue = queue[:len(queue)-1]
+			for _, key := range n.Keys {
+				block(key)
+			}
+		}
+	}
+	propagate()
+
+	// Goroutines only contend for a nosync lock if one of them is suspended
+	// while it holds the lock, which needs a function that takes the lock to
+	// block. Otherwise, nosync locks are always free when taken.
+	contended := false
+	for n := range blockingNodes {
+		for _, dep := range n.Deps {
+			contended = contended || analysis.TakesNoSyncLock(dep)
+		}
+	}
+	if contended {
+		block(analysis.NoSyncPark)
+		propagate()
+	}
+
+	fastDecls := make(map[*Decl]bool)
+	for d := range dceSelection {
+		if d.FastDeclCode == nil {
+			continue
+		}
+		fast := true
+		for _, n := range d.BlockingNodes {
+			for _, dep := range n.Deps {
+				if blockingKeys[dep] {
+					fast = false
+				}
+			}
+		}
+		fastDecls[d] = fast
+	}
+	return fastDecls
+}
+
 func WritePkgCode(pkg *Archive, dceSelection map[*Decl]struct{}, minify bool, w *SourceMapFilter) error {
+	return writePkgCode(pkg, dceSelection, nil, nil, minify, w)
+}
+
+func writePkgCode(pkg *Archive, dceSelection map[*Decl]struct{}, fastDecls map[*Decl]bool, m *mangler, minify bool, w *SourceMapFilter) error {
 	if w.MappingCallback != nil && pkg.FileSet != nil {
 		w.fileSet = token.NewFileSet()
 		if err := w.fileSet.Read(json.NewDecoder(bytes.NewReader(pkg.FileSet)).Decode); err != nil {
@@ -188,7 +360,7 @@ func WritePkgCode(pkg *Archive, dceSelection map[*Decl]struct{}, minify bool, w
 	if _, err := w.Write(pkg.IncJSCode); err != nil {
 		return err
 	}
-	if _, err := w.Write(removeWhitespace([]byte(fmt.Sprintf("$packages[\"%s\"] = (function() {\n", pkg.ImportPath)), minify)); err != nil {
+	if _, err := w.Write(m.rewrite(removeWhitespace([]byte(fmt.Sprintf("$packages[\"%s\"] = (function() {\n", pkg.ImportPath)), minify))); err != nil {
 		return err
 	}
 	vars := []string{"$pkg = {}", "$init"}
@@ -197,23 +369,32 @@ func WritePkgCode(pkg *Archive, dceSelection map[*Decl]struct{}, minify bool, w
 		if _, ok := dceSelection[d]; ok {
 			vars = append(vars, d.Vars...)
 			filteredDecls = append(filteredDecls, d)
+			if w.FunctionCallback != nil {
+				for _, f := range d.Functions {
+					w.FunctionCallback(f)
+				}
+			}
 		}
 	}
 	if _, err := w.Write(removeWhitespace([]byte(fmt.Sprintf("\tvar %s;\n", strings.Join(vars, ", "))), minify)); err != nil {
 		return err
 	}
 	for _, d := range filteredDecls {
-		if _, err := w.Write(d.DeclCode); err != nil {
+		code := d.DeclCode
+		if fastDecls[d] {
+			code = d.FastDeclCode
+		}
+		if _, err := w.Write(m.rewrite(code)); err != nil {
 			return err
 		}
 	}
 	for _, d := range filteredDecls {
-		if _, err := w.Write(d.MethodListCode); err != nil {
+		if _, err := w.Write(m.rewrite(d.MethodListCode)); err != nil {
 			return err
 		}
 	}
 	for _, d := range filteredDecls {
-		if _, err := w.Write(d.TypeInitCode); err != nil {
+		if _, err := w.Write(m.rewrite(d.TypeInitCode)); err != nil {
 			return err
 		}
 	}
@@ -222,7 +403,7 @@ func WritePkgCode(pkg *Archive, dceSelection map[*Decl]struct{}, minify bool, w
 		return err
 	}
 	for _, d := range filteredDecls {
-		if _, err := w.Write(d.InitCode); err != nil {
+		if _, err := w.Write(m.rewrite(d.InitCode)); err != nil {
 			return err
 		}
 	}
@@ -254,12 +435,61 @@ func WriteArchive(a *Archive, w io.Writer) error {
 	return gob.NewEncoder(w).Encode(a)
 }
 
+// MinifyJS minifies JavaScript code read from file, e.g. a .inc.js file. The
+// result is marked with the positions of the lines of file for SourceMapFilter.
+func MinifyJS(code []byte, file *token.File) ([]byte, error) {
+	minified, mappings, err := jsmin.MinifyWithMappings(code)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", file.Name(), err)
+	}
+	return markPositions(minified, mappings, file), nil
+}
+
+// MarkJS returns JavaScript code read from file marked with the positions of
+// its lines for SourceMapFilter.
+func MarkJS(code []byte, file *token.File) []byte {
+	var mappings []jsmin.Mapping
+	for i := 0; i < len(code); {
+		mappings = append(mappings, jsmin.Mapping{Generated: i, Original: i})
+		n := bytes.IndexByte(code[i:], '\n')
+		if n == -1 {
+			break
+		}
+		i += n + 1
+	}
+	return markPositions(code, mappings, file)
+}
+
+// markPositions inserts the original positions in file of the mapped
+// offsets into code.
+func markPositions(code []byte, mappings []jsmin.Mapping, file *token.File) []byte {
+	var out []byte
+	write := func(b []byte) {
+		// A backspace in a string literal means the same as its escape
+		// sequence, which can't be mistaken for a position mark.
+		out = append(out, bytes.Replace(b, []byte{'\b'}, []byte(`\b`), -1)...)
+	}
+	last := 0
+	for _, m := range mappings {
+		write(code[last:m.Generated])
+		out = append(out, '\b')
+		out = append(out, 0, 0, 0, 0)
+		binary.BigEndian.PutUint32(out[len(out)-4:], uint32(file.Pos(m.Original)))
+		last = m.Generated
+	}
+	write(code[last:])
+	return out
+}
+
 type SourceMapFilter struct {
 	Writer          io.Writer
 	MappingCallback func(generatedLine, generatedColumn int, originalPos token.Position)
-	line            int
-	column          int
-	fileSet         *token.FileSet
+	// FunctionCallback, if not nil, is called with the variable names of each
+	// function in the written code.
+	FunctionCallback func(f FuncNames)
+	line             int
+	column           int
+	fileSet          *token.FileSet
 }
 
 func (f *SourceMapFilter) Write(p []byte) (n int, err error) {
@@ -0,0 +1,65 @@
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"go/token"
+	"sort"
+
+	"github.com/goplusjs/gopherjs/compiler/jsmin"
+)
+
+// CSPError is the category of Errors about code that a strict Content Security
+// Policy doesn't allow to run.
+const CSPError = "csp"
+
+// CheckCSP returns an ErrorList with the places where the program made of
+// pkgs evaluates strings as code, like with eval or the Function constructor,
+// which a Content Security Policy without 'unsafe-eval' doesn't allow. This
+// includes .inc.js files and the prelude. The errors point to the Go or
+// JavaScript line the code comes from.
+func CheckCSP(pkgs []*Archive) error {
+	type mapping struct {
+		line, column int
+		pos          token.Position
+	}
+	var mappings []mapping
+	var code bytes.Buffer
+	w := &SourceMapFilter{Writer: &code, MappingCallback: func(line, column int, pos token.Position) {
+		mappings = append(mappings, mapping{line, column, pos})
+	}}
+	if err := WriteProgramCode(pkgs, w); err != nil {
+		return err
+	}
+	found, err := jsmin.FindDynamicCode(code.Bytes())
+	if err != nil {
+		return fmt.Errorf("checking generated code: %v", err)
+	}
+
+	var errList ErrorList
+	src := code.Bytes()
+	line, lineStart := 1, 0
+	for _, f := range found {
+		for i := bytes.IndexByte(src[lineStart:], '\n'); i != -1 && lineStart+i < f.Offset; i = bytes.IndexByte(src[lineStart:], '\n') {
+			line++
+			lineStart += i + 1
+		}
+		column := f.Offset - lineStart
+		e := &Error{Category: CSPError, Msg: f.What + " is not allowed under a strict Content Security Policy"}
+		// The code comes from the last mapped position before it.
+		i := sort.Search(len(mappings), func(i int) bool {
+			m := mappings[i]
+			return m.line > line || m.line == line && m.column > column
+		})
+		if i > 0 && mappings[i-1].pos.IsValid() {
+			e.setPos(mappings[i-1].pos, token.Position{})
+		} else {
+			e.Msg += fmt.Sprintf(" (line %d of the generated code)", line)
+		}
+		errList = append(errList, e)
+	}
+	if errList != nil {
+		return errList
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package compiler
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/token"
+	"testing"
+)
+
+// addIncJS adds code as the .inc.js file name of a, the way gopherjs build
+// does.
+func addIncJS(t *testing.T, a *Archive, name string, code string, minify bool) {
+	fileSet := token.NewFileSet()
+	if err := fileSet.Read(json.NewDecoder(bytes.NewReader(a.FileSet)).Decode); err != nil {
+		t.Fatal(err)
+	}
+	file := fileSet.AddFile(name, -1, len(code))
+	file.SetLinesForContent([]byte(code))
+	if minify {
+		minified, err := MinifyJS([]byte(code), file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		a.IncJSCode = append([]byte("(function(){"), minified...)
+	} else {
+		a.IncJSCode = append([]byte("\t(function() {\n"), MarkJS([]byte(code), file)...)
+	}
+	a.IncJSCode = append(a.IncJSCode, "\n}).call($global);\n"...)
+	var buf bytes.Buffer
+	if err := fileSet.Write(json.NewEncoder(&buf).Encode); err != nil {
+		t.Fatal(err)
+	}
+	a.FileSet = buf.Bytes()
+}
+
+// checkCSP checks the program of the main package pkg of b.
+func checkCSP(t *testing.T, b *Bundle, pkg *Archive) error {
+	deps, err := ImportDependencies(pkg, b.Archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return CheckCSP(deps)
+}
+
+func TestCheckCSP(t *testing.T) {
+	for _, minify := range []bool{false, true} {
+		b := testBundle(t)
+		clean, err := b.Compile("main", map[string][]byte{
+			"main.go": []byte("package main\n\nimport \"github.com/gopherjs/gopherjs/js\"\n\nfunc main() {\n\tjs.Global.Call(\"setTimeout\", func() {}, 1)\n}\n"),
+		}, Options{Minify: minify})
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The prelude doesn't evaluate strings.
+		if err := checkCSP(t, b, clean); err != nil {
+			t.Errorf("minify %v: got error %v for a program without dynamic code", minify, err)
+		}
+
+		main, err := b.Compile("main", map[string][]byte{
+			"main.go": []byte("package main\n\nimport \"github.com/gopherjs/gopherjs/js\"\n\nfunc main() {\n\tjs.Global.Call(\"eval\", \"1\")\n}\n"),
+		}, Options{Minify: minify})
+		if err != nil {
+			t.Fatal(err)
+		}
+		addIncJS(t, main, "lib.inc.js", "var f = function() {};\nvar g = new Function(\"return 1\");\n", minify)
+		err = checkCSP(t, b, main)
+		list, ok := err.(ErrorList)
+		if !ok || len(list) != 2 {
+			t.Errorf("minify %v: got error %v, want two errors", minify, err)
+			continue
+		}
+		for i, want := range []struct {
+			file string
+			line int
+			msg  string
+		}{
+			{"lib.inc.js", 2, "the Function constructor is not allowed under a strict Content Security Policy"},
+			{"main.go", 6, "eval is not allowed under a strict Content Security Policy"},
+		} {
+			e := list.Errors()[i]
+			if e.File != want.file || e.Line != want.line || e.Msg != want.msg || e.Category != CSPError {
+				t.Errorf("minify %v: got error %+v, want %q at %s:%d", minify, e, want.msg, want.file, want.line)
+			}
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package compiler
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"sort"
+)
+
+// FuncNames relates the local variables of a function in the generated code
+// to their names in the Go source, so that debuggers can show them as they
+// are written. Function literals have entries of their own.
+type FuncNames struct {
+	Name    string    `json:"name"`
+	File    string    `json:"file"`
+	Line    int       `json:"line"`
+	EndLine int       `json:"endLine"`
+	Vars    []VarName `json:"vars"`
+}
+
+// VarName is a parameter, result or local variable of a function.
+type VarName struct {
+	JS string `json:"js"`
+	Go string `json:"go"`
+	// Line and EndLine are the lines from the declaration of the variable to
+	// the end of its scope.
+	Line    int `json:"line"`
+	EndLine int `json:"endLine"`
+	// Heap is set for variables whose address is taken. They are stored as
+	// element 0 of an array.
+	Heap bool `json:"heap,omitempty"`
+}
+
+// recordNames adds the names of the parameters, results and local variables
+// of the function being translated to c.p.funcNames.
+func (c *funcContext) recordNames(typ *ast.FuncType, recv *ast.Ident, body *ast.BlockStmt) {
+	if !c.p.debugInfo || body == nil {
+		return
+	}
+	start, end := c.p.fileSet.Position(typ.Pos()), c.p.fileSet.Position(body.End())
+	if !start.IsValid() || !end.IsValid() {
+		return
+	}
+	names, ok := c.p.funcNames[typ.Pos()]
+	if !ok {
+		names = FuncNames{Name: c.funcName, File: start.Filename, Line: start.Line, EndLine: end.Line}
+	}
+	// Code may be translated more than once, e.g. optimistically, and the
+	// first name wins.
+	seen := make(map[string]bool)
+	for _, v := range names.Vars {
+		seen[v.JS] = true
+	}
+
+	record := func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.Ident:
+			v, ok := c.p.Defs[n].(*types.Var)
+			if !ok || v.IsField() || v.Pkg() == nil {
+				return true
+			}
+			name, ok := c.p.objectNames[v]
+			if !ok || seen[name] {
+				return true
+			}
+			seen[name] = true
+			scopeEnd := end.Line
+			if s := v.Parent(); s != nil && s.End().IsValid() {
+				scopeEnd = c.p.fileSet.Position(s.End()).Line
+			}
+			names.Vars = append(names.Vars, VarName{
+				JS:      name,
+				Go:      v.Name(),
+				Line:    c.p.fileSet.Position(v.Pos()).Line,
+				EndLine: scopeEnd,
+				Heap:    c.p.heapVars[v],
+			})
+		}
+		return true
+	}
+	ast.Inspect(typ, record)
+	if recv != nil {
+		ast.Inspect(recv, record)
+	}
+	ast.Inspect(body, record)
+
+	if c.p.funcNames == nil {
+		c.p.funcNames = make(map[token.Pos]FuncNames)
+	}
+	c.p.funcNames[typ.Pos()] = names
+}
+
+// takeFuncNames returns the names recorded since the last call, sorted by
+// position.
+func (p *pkgContext) takeFuncNames() []FuncNames {
+	var names []FuncNames
+	for _, n := range p.funcNames {
+		names = append(names, n)
+	}
+	p.funcNames = nil
+	sort.Slice(names, func(i, j int) bool {
+		if names[i].File != names[j].File {
+			return names[i].File < names[j].File
+		}
+		return names[i].Line < names[j].Line
+	})
+	return names
+}
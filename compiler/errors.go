@@ -0,0 +1,163 @@
+package compiler
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/scanner"
+	"go/token"
+	"go/types"
+	"io"
+)
+
+// Categories of Errors.
+const (
+	ParseError    = "parse"
+	TypeError     = "type-check"
+	GopherJSError = "gopherjs" // Go code that GopherJS can't compile, e.g. js.Object map keys
+)
+
+// Error is an error in the source of a package, in a form that tools like
+// editors can consume. Positions are one-based; EndLine and EndColumn are zero
+// if the end isn't known.
+type Error struct {
+	Package   string `json:"package,omitempty"`
+	File      string `json:"file,omitempty"`
+	Line      int    `json:"line,omitempty"`
+	Column    int    `json:"column,omitempty"`
+	EndLine   int    `json:"endLine,omitempty"`
+	EndColumn int    `json:"endColumn,omitempty"`
+	Category  string `json:"category,omitempty"`
+	Msg       string `json:"message"`
+	// Soft is set for errors that don't keep the package from being
+	// compiled, like the soft errors of go/types.
+	Soft bool `json:"soft,omitempty"`
+	// Err is the error this one was made from, like a types.Error, if any.
+	Err error `json:"-"`
+}
+
+// Error formats the error like go/types and go/scanner do.
+func (e *Error) Error() string {
+	if e.File == "" && e.Line == 0 {
+		return e.Msg
+	}
+	pos := token.Position{Filename: e.File, Line: e.Line, Column: e.Column}
+	return fmt.Sprintf("%s: %s", pos, e.Msg)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func (e *Error) setPos(pos, end token.Position) {
+	e.File, e.Line, e.Column = pos.Filename, pos.Line, pos.Column
+	if end.IsValid() {
+		e.EndLine, e.EndColumn = end.Line, end.Column
+	}
+}
+
+// NewError returns err as an Error of package pkg. Errors of go/types and
+// go/scanner keep their positions and get a category; other errors just
+// keep their message.
+func NewError(pkg string, err error) *Error {
+	switch err := err.(type) {
+	case *Error:
+		e := *err
+		if e.Package == "" {
+			e.Package = pkg
+		}
+		return &e
+	case types.Error:
+		e := &Error{Package: pkg, Category: TypeError, Msg: err.Msg, Soft: err.Soft, Err: err}
+		e.setPos(err.Fset.Position(err.Pos), token.Position{})
+		return e
+	case *scanner.Error:
+		e := &Error{Package: pkg, Category: ParseError, Msg: err.Msg, Err: err}
+		e.setPos(err.Pos, token.Position{})
+		return e
+	default:
+		return &Error{Package: pkg, Msg: err.Error(), Err: err}
+	}
+}
+
+// ErrorList is the list of errors that keep a package from being compiled.
+// Its entries are usually Errors.
+type ErrorList []error
+
+func (err ErrorList) Error() string {
+	return err[0].Error()
+}
+
+// Errors returns the entries of err as Errors.
+func (err ErrorList) Errors() []*Error {
+	errs := make([]*Error, len(err))
+	for i, e := range err {
+		errs[i] = NewError("", e)
+	}
+	return errs
+}
+
+// WriteJSON writes the entries of err to w as JSON objects, one per line.
+func (err ErrorList) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	for _, e := range err.Errors() {
+		if err := enc.Encode(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Truncate cuts err down to the first 10 errors, followed by one saying that
+// there are more, unless all is set.
+func (err ErrorList) Truncate(all bool) ErrorList {
+	if all || len(err) <= 10 {
+		return err
+	}
+	last := NewError("", err[9])
+	return append(err[:10:10], &Error{Package: last.Package, File: last.File, Line: last.Line, Column: last.Column, Msg: "too many errors"})
+}
+
+// typeErrors turns the type-checking errors of package pkg into Errors that
+// span the expression they are about.
+func typeErrors(pkg string, list ErrorList, files []*ast.File) ErrorList {
+	errs := make(ErrorList, len(list))
+	for i, err := range list {
+		e := NewError(pkg, err)
+		if terr, ok := err.(types.Error); ok {
+			if end := nodeEnd(files, terr.Pos); end.IsValid() {
+				e.setPos(terr.Fset.Position(terr.Pos), terr.Fset.Position(end))
+			}
+		}
+		errs[i] = e
+	}
+	return errs
+}
+
+// nodeEnd returns the end of the smallest node of files that starts at pos.
+func nodeEnd(files []*ast.File, pos token.Pos) token.Pos {
+	end := token.NoPos
+	for _, f := range files {
+		if pos < f.Pos() || pos > f.End() {
+			continue
+		}
+		ast.Inspect(f, func(n ast.Node) bool {
+			if n == nil || pos < n.Pos() || pos >= n.End() {
+				return false
+			}
+			if n.Pos() == pos && (end == token.NoPos || n.End() < end) {
+				end = n.End()
+			}
+			return true
+		})
+	}
+	return end
+}
+
+// gopherJSError adds an error about node, which is valid Go that GopherJS
+// can't compile, to the errors of the package.
+func (p *pkgContext) gopherJSError(node ast.Node, msg string, soft bool) {
+	e := &Error{Package: p.Pkg.Path(), Category: GopherJSError, Msg: msg, Soft: soft}
+	e.setPos(p.fileSet.Position(node.Pos()), p.fileSet.Position(node.End()))
+	p.errList = append(p.errList, e)
+}
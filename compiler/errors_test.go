@@ -0,0 +1,166 @@
+package compiler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// testBundle returns a bundle with a minimal runtime and js package.
+func testBundle(t *testing.T) *Bundle {
+	b := NewBundle()
+	for path, src := range map[string]string{
+		"runtime": "package runtime\n",
+		"github.com/gopherjs/gopherjs/js": `package js
+
+type Object struct{ object *Object }
+
+func (o *Object) Call(name string, args ...interface{}) *Object { return o.object.Call(name, args...) }
+
+var Global *Object
+`,
+	} {
+		if _, err := b.Compile(path, map[string][]byte{"a.go": []byte(src)}, Options{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return b
+}
+
+// compileErrors compiles src as package main against testBundle and returns
+// the errors.
+func compileErrors(t *testing.T, src string, options Options) []*Error {
+	_, err := testBundle(t).Compile("main", map[string][]byte{"main.go": []byte(src)}, options)
+	list, ok := err.(ErrorList)
+	if !ok {
+		t.Fatalf("got error %v, want an ErrorList", err)
+	}
+	return list.Errors()
+}
+
+func TestErrorListTruncate(t *testing.T) {
+	var list ErrorList
+	for i := 1; i <= 12; i++ {
+		list = append(list, &Error{Package: "main", File: "main.go", Line: i, Column: 2, Msg: fmt.Sprint("error ", i)})
+	}
+	if got := list[:10].Truncate(false); len(got) != 10 {
+		t.Errorf("got %d of 10 errors", len(got))
+	}
+	if got := list.Truncate(true); len(got) != 12 {
+		t.Errorf("got %d of 12 errors with all set", len(got))
+	}
+	got := list.Truncate(false)
+	if len(got) != 11 {
+		t.Fatalf("got %d errors, want 11", len(got))
+	}
+	if want := "main.go:10:2: too many errors"; got[10].Error() != want {
+		t.Errorf("got last error %q, want %q", got[10], want)
+	}
+	if len(list) != 12 || list[10].Error() != "main.go:11:2: error 11" {
+		t.Error("Truncate changed the list")
+	}
+}
+
+func TestCompileErrorLimit(t *testing.T) {
+	var src strings.Builder
+	src.WriteString("package main\n\nfunc main() {\n")
+	for i := 0; i < 12; i++ {
+		fmt.Fprintf(&src, "\tundefined%d()\n", i)
+	}
+	src.WriteString("}\n")
+	for _, all := range []bool{false, true} {
+		errs := compileErrors(t, src.String(), Options{AllErrors: all})
+		want := 11
+		if all {
+			want = 12
+		}
+		if len(errs) != want {
+			t.Errorf("AllErrors %v: got %d errors, want %d", all, len(errs), want)
+			continue
+		}
+		last := errs[len(errs)-1]
+		if got := last.Msg == "too many errors"; got == all {
+			t.Errorf("AllErrors %v: got last error %v", all, last)
+		}
+	}
+
+	// Syntax errors are limited in the same way. The parser stops after 10
+	// errors unless all are requested.
+	var syntax strings.Builder
+	syntax.WriteString("package main\n\nfunc main() {\n")
+	for i := 0; i < 12; i++ {
+		syntax.WriteString("\tvar 1\n")
+	}
+	syntax.WriteString("}\n")
+	if errs := compileErrors(t, syntax.String(), Options{}); len(errs) != 11 || errs[10].Msg != "too many errors" {
+		t.Errorf("got %d syntax errors, want 10 and too many errors", len(errs))
+	}
+	if errs := compileErrors(t, syntax.String(), Options{AllErrors: true}); len(errs) <= 12 || errs[len(errs)-1].Msg == "too many errors" {
+		t.Errorf("got %d syntax errors with AllErrors, want all of them", len(errs))
+	}
+}
+
+func TestErrorCategories(t *testing.T) {
+	for _, test := range []struct {
+		src      string
+		category string
+		want     Error
+	}{
+		{
+			"package main\n\nfunc main() {\n\tx := \n}\n",
+			ParseError,
+			Error{File: "main.go", Line: 5, Column: 1},
+		},
+		{
+			"package main\n\nfunc main() {\n\tprintln(undefinedName + 1)\n}\n",
+			TypeError,
+			// The error is about the identifier, the smallest node that
+			// starts at its position, not the sum.
+			Error{File: "main.go", Line: 4, Column: 10, EndLine: 4, EndColumn: 23},
+		},
+		{
+			"package main\n\nimport \"github.com/gopherjs/gopherjs/js\"\n\nfunc main() {\n\tm := map[*js.Object]int{}\n\tm[new(js.Object)] = 1\n}\n",
+			GopherJSError,
+			Error{File: "main.go", Line: 7, Column: 4, EndLine: 7, EndColumn: 18},
+		},
+	} {
+		errs := compileErrors(t, test.src, Options{})
+		if len(errs) != 1 {
+			t.Errorf("got errors %v for\n%s", errs, test.src)
+			continue
+		}
+		e := errs[0]
+		if e.Package != "main" || e.Category != test.category || e.File != test.want.File || e.Line != test.want.Line || e.Column != test.want.Column || e.EndLine != test.want.EndLine || e.EndColumn != test.want.EndColumn {
+			t.Errorf("got error %+v, want category %s at %+v", e, test.category, test.want)
+		}
+
+		var buf bytes.Buffer
+		if err := (ErrorList{e}).WriteJSON(&buf); err != nil {
+			t.Fatal(err)
+		}
+		var decoded map[string]interface{}
+		if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if decoded["category"] != test.category || decoded["message"] != e.Msg || decoded["line"] != float64(e.Line) {
+			t.Errorf("got JSON %s for error %+v", buf.Bytes(), e)
+		}
+	}
+}
+
+func TestNewError(t *testing.T) {
+	e := NewError("main", errors.New("missing main function"))
+	if e.Package != "main" || e.Category != "" || e.Error() != "missing main function" {
+		t.Errorf("got error %+v", e)
+	}
+	var buf bytes.Buffer
+	if err := (ErrorList{e}).WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), "{\"package\":\"main\",\"message\":\"missing main function\"}\n"; got != want {
+		t.Errorf("got JSON %q, want %q", got, want)
+	}
+}
@@ -177,7 +177,7 @@ func (c *funcContext) translateExpr(expr ast.Expr) *expression {
 		}
 
 	case *ast.FuncLit:
-		_, fun := translateFunction(e.Type, nil, e.Body, c, exprType.(*types.Signature), c.p.FuncLitInfos[e], "")
+		_, fun := translateFunction(e.Type, nil, e.Body, c, exprType.(*types.Signature), c.p.FuncLitInfos[e], "", "")
 		if len(c.p.escapingVars) != 0 {
 			names := make([]string, 0, len(c.p.escapingVars))
 			for obj := range c.p.escapingVars {
@@ -460,7 +460,7 @@ func (c *funcContext) translateExpr(expr ast.Expr) *expression {
 			return c.formatExpr(rangeCheck("%1e.$array[%1e.$offset + %2f]", c.p.Types[e.Index].Value != nil, false), e.X, e.Index)
 		case *types.Map:
 			if typesutil.IsJsObject(c.p.TypeOf(e.Index)) {
-				c.p.errList = append(c.p.errList, types.Error{Fset: c.p.fileSet, Pos: e.Index.Pos(), Msg: "cannot use js.Object as map key"})
+				c.p.gopherJSError(e.Index, "cannot use js.Object as map key", false)
 			}
 			key := fmt.Sprintf("%s.keyFor(%s)", c.typeName(t.Key()), c.translateImplicitConversion(e.Index, t.Key()))
 			if _, isTuple := exprType.(*types.Tuple); isTuple {
@@ -513,7 +513,7 @@ func (c *funcContext) translateExpr(expr ast.Expr) *expression {
 
 		switch sel.Kind() {
 		case types.FieldVal:
-			fields, jsTag := c.translateSelection(sel, e.Pos())
+			fields, jsTag := c.translateSelection(sel, e)
 			if jsTag != "" {
 				if _, ok := sel.Type().(*types.Signature); ok {
 					return c.formatExpr("$internalize(%1e.%2s%3s, %4s, %1e.%2s)", e.X, strings.Join(fields, "."), formatJSStructTagVal(jsTag), c.typeName(sel.Type()))
@@ -522,11 +522,11 @@ func (c *funcContext) translateExpr(expr ast.Expr) *expression {
 			}
 			return c.formatExpr("%e.%s", e.X, strings.Join(fields, "."))
 		case types.MethodVal:
+			c.trackMethodLookup(sel.Obj(), nil)
 			return c.formatExpr(`$methodVal(%s, "%s")`, c.makeReceiver(e), sel.Obj().(*types.Func).Name())
 		case types.MethodExpr:
-			if !sel.Obj().Exported() {
-				c.p.dependencies[sel.Obj()] = true
-			}
+			c.trackMethodLookup(sel.Obj(), nil)
+			c.p.dependencies[sel.Obj()] = true
 			if _, ok := sel.Recv().Underlying().(*types.Interface); ok {
 				return c.formatExpr(`$ifaceMethodExpr("%s")`, sel.Obj().(*types.Func).Name())
 			}
@@ -588,6 +588,7 @@ func (c *funcContext) translateExpr(expr ast.Expr) *expression {
 
 			switch sel.Kind() {
 			case types.MethodVal:
+				c.trackMethodLookup(sel.Obj(), e.Args)
 				recv := c.makeReceiver(f)
 				declaredFuncRecv := sel.Obj().(*types.Func).Type().(*types.Signature).Recv().Type()
 				if typesutil.IsJsObject(declaredFuncRecv) {
@@ -667,7 +668,7 @@ func (c *funcContext) translateExpr(expr ast.Expr) *expression {
 				return c.translateCall(e, sig, c.formatExpr("%s.%s", recv, methodName))
 
 			case types.FieldVal:
-				fields, jsTag := c.translateSelection(sel, f.Pos())
+				fields, jsTag := c.translateSelection(sel, f)
 				if jsTag != "" {
 					call := c.formatExpr("%e.%s%s(%s)", f.X, strings.Join(fields, "."), formatJSStructTagVal(jsTag), externalizeArgs(e.Args))
 					switch sig.Results().Len() {
@@ -676,7 +677,7 @@ func (c *funcContext) translateExpr(expr ast.Expr) *expression {
 					case 1:
 						return c.internalize(call, sig.Results().At(0).Type())
 					default:
-						c.p.errList = append(c.p.errList, types.Error{Fset: c.p.fileSet, Pos: f.Pos(), Msg: "field with js tag can not have func type with multiple results"})
+						c.p.gopherJSError(f, "field with js tag can not have func type with multiple results", false)
 					}
 				}
 				return c.translateCall(e, sig, c.formatExpr("%e.%s", f.X, strings.Join(fields, ".")))
@@ -774,7 +775,13 @@ func (c *funcContext) translateCall(e *ast.CallExpr, sig *types.Signature, fun *
 		if sig.Results().Len() != 0 {
 			returnVar = c.newVariable("_r")
 		}
-		c.Printf("%[1]s = %[2]s(%[3]s); /* */ $s = %[4]d; case %[4]d: if($c) { $c = false; %[1]s = %[1]s.$blk(); } if (%[1]s && %[1]s.$blk !== undefined) { break s; }", returnVar, fun, strings.Join(args, ", "), resumeCase)
+		// Unwinding functions record the call for goroutine dumps, except in
+		// minified code, where it would only add size.
+		unwinding := ""
+		if !c.p.minify {
+			unwinding = fmt.Sprintf("$unwinding(%s, %s); ", encodeString(c.funcName), encodeString(c.dumpPos(e)))
+		}
+		c.Printf("%[1]s = %[2]s(%[3]s); /* */ $s = %[4]d; case %[4]d: if($c) { $c = false; %[1]s = %[1]s.$blk(); } if (%[1]s && %[1]s.$blk !== undefined) { %[5]sbreak s; }", returnVar, fun, strings.Join(args, ", "), resumeCase, unwinding)
 		if sig.Results().Len() != 0 {
 			return c.formatExpr("%s", returnVar)
 		}
@@ -785,9 +792,7 @@ func (c *funcContext) translateCall(e *ast.CallExpr, sig *types.Signature, fun *
 
 func (c *funcContext) makeReceiver(e *ast.SelectorExpr) *expression {
 	sel, _ := c.p.SelectionOf(e)
-	if !sel.Obj().Exported() {
-		c.p.dependencies[sel.Obj()] = true
-	}
+	c.p.dependencies[sel.Obj()] = true
 
 	x := e.X
 	recvType := sel.Recv()
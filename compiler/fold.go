@@ -0,0 +1,147 @@
+package compiler
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+)
+
+// removeDeadBranches drops the branches of if statements whose condition is
+// constant, so that nothing referenced only by code that can't run is
+// translated or kept alive by dead code elimination. It works on simplified
+// files, where switch statements have become chains of if statements
+// comparing a temporary holding the tag to each case.
+func removeDeadBranches(file *ast.File, info *types.Info) {
+	f := &brancher{info: info, temps: make(map[types.Object]constant.Value), assigned: make(map[types.Object]int)}
+	ast.Inspect(file, func(n ast.Node) bool {
+		if assign, ok := n.(*ast.AssignStmt); ok {
+			for _, lhs := range assign.Lhs {
+				if id, ok := lhs.(*ast.Ident); ok {
+					if o := info.Uses[id]; o != nil && o.Pkg() == nil {
+						f.assigned[o]++
+					}
+				}
+			}
+		}
+		return true
+	})
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.BlockStmt:
+			n.List = f.foldStmtList(n.List)
+		case *ast.CaseClause:
+			n.Body = f.foldStmtList(n.Body)
+		case *ast.CommClause:
+			n.Body = f.foldStmtList(n.Body)
+		}
+		return true
+	})
+}
+
+type brancher struct {
+	info *types.Info
+	// temps are the temporaries introduced by astrewrite.Simplify that hold a
+	// constant, like the tag of a switch statement.
+	temps map[types.Object]constant.Value
+	// assigned counts the assignments to each temporary. Some, like the one
+	// holding the result of a && or || operator, are assigned more than once.
+	assigned map[types.Object]int
+}
+
+func (f *brancher) foldStmtList(stmts []ast.Stmt) []ast.Stmt {
+	folded := make([]ast.Stmt, 0, len(stmts))
+	for _, s := range stmts {
+		if assign, ok := s.(*ast.AssignStmt); ok && assign.Tok == token.DEFINE && len(assign.Lhs) == 1 && len(assign.Rhs) == 1 {
+			if id, ok := assign.Lhs[0].(*ast.Ident); ok {
+				// Temporaries have no definition and belong to no package.
+				if o := f.info.Uses[id]; o != nil && o.Pkg() == nil && f.assigned[o] == 1 {
+					if value := f.constValue(assign.Rhs[0]); value != nil {
+						f.temps[o] = value
+					}
+				}
+			}
+		}
+		if s = f.foldStmt(s); s != nil {
+			folded = append(folded, s)
+		}
+	}
+	return folded
+}
+
+// foldStmt returns the branch of an if statement that is taken if its
+// condition is constant, or nil if none is.
+func (f *brancher) foldStmt(s ast.Stmt) ast.Stmt {
+	ifStmt, ok := s.(*ast.IfStmt)
+	if !ok {
+		return s
+	}
+	if value := f.constValue(ifStmt.Cond); value != nil && value.Kind() == constant.Bool {
+		if constant.BoolVal(value) {
+			return ifStmt.Body
+		}
+		if ifStmt.Else == nil {
+			return nil
+		}
+		return f.foldStmt(ifStmt.Else)
+	}
+	if ifStmt.Else != nil {
+		if elseStmt := f.foldStmt(ifStmt.Else); elseStmt != nil {
+			ifStmt.Else = elseStmt
+		} else {
+			ifStmt.Else = nil
+		}
+	}
+	return ifStmt
+}
+
+// constValue returns the value of e if it is constant, or nil. Unlike
+// types.Info, it knows about the expressions created by astrewrite.Simplify.
+func (f *brancher) constValue(e ast.Expr) constant.Value {
+	if value := f.info.Types[e].Value; value != nil {
+		return value
+	}
+	switch e := e.(type) {
+	case *ast.ParenExpr:
+		return f.constValue(e.X)
+	case *ast.Ident:
+		return f.temps[f.info.Uses[e]]
+	case *ast.UnaryExpr:
+		if e.Op == token.NOT {
+			if x := f.constValue(e.X); x != nil && x.Kind() == constant.Bool {
+				return constant.MakeBool(!constant.BoolVal(x))
+			}
+		}
+	case *ast.BinaryExpr:
+		x := f.constValue(e.X)
+		switch e.Op {
+		case token.LAND, token.LOR:
+			if x == nil || x.Kind() != constant.Bool {
+				return nil
+			}
+			if constant.BoolVal(x) == (e.Op == token.LOR) {
+				return x // the right operand isn't evaluated
+			}
+			if y := f.constValue(e.Y); y != nil && y.Kind() == constant.Bool {
+				return y
+			}
+		case token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ:
+			y := f.constValue(e.Y)
+			if x != nil && y != nil && comparable(x, y) {
+				return constant.MakeBool(constant.Compare(x, e.Op, y))
+			}
+		}
+	}
+	return nil
+}
+
+func comparable(x, y constant.Value) bool {
+	isNumeric := func(v constant.Value) bool {
+		switch v.Kind() {
+		case constant.Int, constant.Float, constant.Complex:
+			return true
+		}
+		return false
+	}
+	return x.Kind() == y.Kind() && x.Kind() != constant.Unknown || isNumeric(x) && isNumeric(y)
+}
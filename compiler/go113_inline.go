@@ -0,0 +1,13 @@
+// +build go1.13
+
+package compiler
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+func checkExpr(fset *token.FileSet, pkg *types.Package, expr ast.Expr, info *types.Info) error {
+	return types.CheckExpr(fset, pkg, token.NoPos, expr, info)
+}
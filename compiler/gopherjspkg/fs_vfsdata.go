@@ -29,15 +29,22 @@ var FS = func() http.FileSystem {
 		},
 		"/js/js.go": &vfsgen۰CompressedFileInfo{
 			name:             "js.go",
-			modTime:          time.Date(2020, 10, 13, 23, 35, 11, 809199214, time.UTC),
-			uncompressedSize: 8002,
+			modTime:          time.Date(2026, 10, 18, 19, 40, 22, 52828245, time.UTC),
+			uncompressedSize: 9702,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x59\x5f\x6f\xdc\x36\x12\x7f\x5e\x7d\x8a\x39\xa1\x40\x56\xcd\x56\xbe\xb6\x86\x51\x38\xe7\x87\xa4\xb9\xfa\xdc\x4b\xdc\x00\x6e\xd0\x07\x23\x30\xb8\xd2\x68\x97\xb1\x44\xea\x48\x6a\x37\x7b\xb6\xbf\xfb\x61\xf8\x47\x2b\xad\xa4\xc4\xbe\x24\x2f\x75\xc5\xe1\x6f\x7e\x9c\x19\xce\x1f\xee\xd1\x11\xbc\x63\xd9\x2d\x5b\x21\x7c\xd4\x50\x2b\xb9\xe1\x39\x6a\x28\x1a\x91\x19\x2e\x85\x86\x42\x2a\xe0\xc2\xa0\x62\x99\xe1\x62\x05\x5b\x6e\xd6\x20\x98\xe1\x1b\x84\xdf\xd9\x86\x5d\x65\x8a\xd7\x06\x5e\xbe\xbb\xd0\x29\xfc\xca\xca\x52\x83\x91\x60\xd6\xa8\xb1\x83\xc2\x14\x82\x51\xc8\x0c\xe6\xa0\x6b\xcc\x38\x2b\xcb\x1d\x2c\x77\x70\x2e\xeb\x35\xaa\xdf\xaf\x80\x89\x1c\x8c\x62\x42\x97\x56\x28\xe7\x0a\x33\x53\xee\x3c\x18\x57\x90\x49\xa5\x50\xd7\x52\xe4\x44\xa3\xa3\x5a\xef\x84\x61\x9f\xd2\xe8\xe8\x28\x3a\x3a\x82\xf7\x1a\xe1\x2d\xbb\xc5\xbf\x14\xab\x6b\x54\xb4\x1f\x3f\xd5\x52\x23\x54\x68\xd6\x32\xb7\xf4\xf6\xbb\x53\xf8\x6b\x8d\x02\x6a\xa6\x35\xc1\x6e\x58\xd9\xa0\x6e\xb5\x2f\x48\x37\x14\xb2\x2c\xe5\x96\x96\xcd\xae\x46\xc8\xa4\xd8\xa0\xd2\xed\xb9\x6a\x54\x85\x54\x15\xe6\xa7\x9e\x02\xdc\xc3\xb9\x74\xb2\xfd\x7f\xf7\x5d\xda\x9d\xf5\x7b\xf8\xb5\x83\xb9\x64\xd9\x2d\x91\xb4\x56\x2f\x58\x86\x77\x0f\x70\xef\x71\x7f\x18\xfb\xf7\xd4\xef\x5d\x09\x8f\xbb\x94\xb2\x84\xc1\xbf\x7b\x78\x25\x65\x89\x4c\x0c\xbe\x8f\xcb\x77\x24\x3c\x2e\x9d\x61\x85\x4a\x5b\xf7\x16\xa5\x64\x46\xdb\xfd\x97\x4d\xb5\x44\x35\xd4\x67\x45\x4e\x8e\xbf\x88\xab\x8d\x22\x7f\x0c\xf6\x5f\x4d\x7c\x1f\x97\x1f\xe2\x5e\x7f\xe0\xc2\xfc\x32\xdc\x7f\x21\xcc\x2f\x2f\x95\x62\xbb\x83\xef\xe3\xf2\x13\xb8\x3f\x9e\x8c\xe1\xfe\x78\x32\x00\x9e\x92\x9f\xc0\xfd\xf9\xa7\x85\xfb\xa3\x87\xfb\xf3\x4f\x53\xb8\xd3\x74\x3b\xb8\xcd\xc8\xc1\xee\xe1\x3d\x1f\x33\xc4\x94\xfc\x14\xee\xe1\xc1\x1c\xee\xd0\x10\x53\xf2\x53\xb8\xce\x10\x4d\x7b\x44\x87\x3b\x34\xc4\x7d\x4f\xea\xf3\xb8\x36\x22\x7f\xfe\xe9\x80\xef\x6f\xee\xeb\x01\xf0\x94\xfc\x24\xee\x41\xa4\x7b\xdc\x93\xe3\x29\xdc\xc9\x9b\x11\x70\x59\x59\x82\x34\x6b\x54\xa0\x4b\x9e\xa1\x0e\xfb\x87\xb1\x0b\xfb\x78\x68\xb3\xcc\x67\x70\x69\xbf\x1e\xee\xd7\x88\x4e\x53\x2f\xdd\x4d\x7d\x1f\xe2\xee\x2b\xc4\x81\x1d\xfc\xf7\x43\x7d\x24\x3f\x4f\xd3\xb4\xc3\x3a\x81\xef\x3f\xea\xf4\x8f\xe5\x47\xcc\x4c\x8b\x6b\x78\x85\xe9\x9f\xbc\xc2\x83\xfd\xaf\x99\x19\x63\x33\x21\x3f\xe4\xfb\xc3\xf8\x2a\x70\xa1\x0d\x13\x19\xca\x02\x2e\x65\xbe\xcf\xeb\x1d\x6a\x9f\xc5\xad\x58\xad\x17\x94\xa5\x9a\xcc\xe8\x71\xdc\x0e\x8c\x95\xbf\x76\x39\x6d\xdc\x81\xf7\xbe\x14\xbd\xcc\x73\x4e\x76\xa4\x72\xbb\xb0\xb5\x9c\x79\x2d\x54\xc6\x0c\xe3\x82\xd2\x22\xeb\xf2\x2c\x38\x96\xf9\x02\xa4\xa0\xe2\xbb\xb6\xe5\xce\xa0\x30\x20\x0b\x57\x0c\x69\x19\xb6\xbc\x2c\x61\x89\xb6\x6e\x62\xde\x2f\xa9\x36\xd7\x6f\xc8\xf7\x54\xd2\x58\x1a\xd5\x6d\x83\x11\x11\x27\xaf\x87\x6b\x60\x81\x04\x2a\xcf\x6d\xd8\x58\x48\x2b\xdd\x69\x2d\xb8\xd1\x6d\x29\xff\x06\x6d\xc5\xb0\x91\x80\x97\x20\x78\x09\xb5\xb4\x96\x25\xc9\x3d\x63\xfc\x4f\xc3\xca\xfe\x71\x9f\x69\x88\x45\x53\x96\x71\x1a\xe4\x32\x26\x40\x48\x43\xf6\x69\xc8\x3a\x8c\x4e\x5a\xb1\x1a\x6e\x71\x97\x46\xf6\x42\x78\x49\xe7\x8a\x3b\x7f\x48\xf8\xde\x7f\x7e\xb0\x76\x3a\x47\x03\x0a\x4d\xa3\x84\xb6\x96\x77\x42\xcf\x6c\x97\x56\xa3\x32\x3b\xd7\x8b\xd1\xd2\x8a\x6f\x50\x38\x78\xba\x21\x30\x97\x01\x2b\x21\x98\xf9\x2d\xee\x7c\x09\x4c\x5a\x25\x77\x1e\x1c\x64\xea\x6d\xec\x25\x13\xaf\xff\x0a\x0d\x50\x5b\xb4\xf2\xfa\x6d\x6f\xe4\x0d\xf7\xff\x92\xb9\xea\x91\x59\x78\xcc\xde\x6d\xbe\xdb\x13\xf2\xd2\x5e\x2c\xf0\x7a\x8d\x25\x1a\x04\x85\x95\xdc\xe0\x57\x99\xc6\x21\xf5\xac\xd3\xd1\xbe\x5f\x0d\x9a\xdf\xa0\x58\x99\xf5\xb8\x53\xe2\xd2\x2e\xc6\x2d\x85\x85\x6f\x14\x8d\xbb\x1f\x5c\x98\x11\x06\x0e\x71\x9e\xd0\xf2\x88\x47\xda\x65\xa7\xff\x42\xe4\xf8\xa9\xa7\x9e\x3f\x33\x6b\xc0\x12\x2b\x7f\x43\x99\x70\xa9\x7a\x44\x95\xdd\x3c\xe7\xa4\xe9\x73\x41\xe0\xc5\x3a\x41\xe0\xb4\x6a\x34\x4f\x56\x19\x36\x3b\xad\x8f\xf0\xb6\x97\x3e\x70\x38\x5d\x7d\xc8\xdc\xfd\xef\x9a\xdc\x65\x81\x43\x57\x0b\x56\xe1\x08\x17\x02\x99\xd3\x5a\x1b\x7b\x4c\xad\x34\x0c\x6a\xc9\xa4\x61\x5a\x00\xb7\x33\x4d\xd3\xbd\x5b\x36\xf2\x16\x07\x0c\x29\x53\x61\x59\xa4\xf0\xe7\x9a\x6b\x97\x31\x0b\xc6\x4b\xe0\x05\x70\x9b\x4c\x28\x47\xb0\xb6\x04\x8e\xba\x8c\x80\xe7\x4f\x24\xda\xd9\xd5\x21\x79\x89\x5b\xc8\x6c\xaa\xa4\x6c\x24\x70\xdb\xd6\x16\x97\xd9\xb9\x76\xa5\x3a\xe4\xdb\x51\xd2\x7d\xc6\x30\xcf\xa4\x70\x29\x4c\xaa\x64\x84\xff\x25\x6e\x9f\x4a\x3e\x6c\xe9\x30\xa7\x19\x64\xe4\xce\xf5\xaf\x97\x1d\x48\x58\x96\x49\x65\xc7\xc3\x7e\x41\x3a\x1c\xdb\x46\xa8\x92\x92\x79\xe2\x60\x86\xac\xfc\xaa\xbf\x12\x6e\x96\xf8\x12\x23\x3f\x72\x7c\x05\x27\xa7\x68\x9e\x04\xa8\x21\xaf\x56\x22\x04\xe2\x58\xc5\x18\xe4\xa1\x47\x73\x82\x79\xcd\x94\xc6\x0b\x61\xc6\xbc\x7b\x21\xcc\x64\xe2\x72\x6b\x2d\xab\x93\xe3\xc7\xf0\x3a\x39\xfe\x76\xcc\x4e\x8e\x1d\xb7\x93\xe3\x71\x76\x76\xdd\xf1\x7b\xcf\x1f\x45\xb0\xf9\x96\x0c\x9d\xce\x79\x12\x50\x87\x1c\x5b\x09\x47\xd2\x0e\x06\x5f\xe4\x18\x86\x84\x27\x92\xb4\xe0\x63\x34\xed\xc2\x3c\x69\x71\x87\x34\x83\x44\xeb\x6a\x77\xc9\x1f\xe3\xee\x90\x0e\x52\xb8\x42\x04\xc3\x96\x25\xd5\x06\x08\xdd\x62\x26\x2b\x5b\x62\xa8\x31\xcc\xd1\x30\x5e\x8e\xdd\x91\x56\xa3\x73\x77\xdb\x09\x8f\x3a\xbd\x95\xf4\x8e\x17\x9a\x15\xa3\x54\xa9\x63\x13\xd6\x37\xb5\x51\x0b\xd8\xae\x79\xb6\xb6\x6d\xdd\x12\x3b\xc7\xd8\x70\x06\x8d\xc5\x48\xdf\xb9\x66\x31\x85\x4b\x69\x2c\x0f\x91\x63\x6e\xa9\xd7\xcd\xb2\xe4\x19\x35\x82\x63\x61\x60\x77\xfb\x30\xa8\x8d\x1a\x8b\x83\x20\xe2\x38\xff\x53\x29\xa9\x00\x45\xc6\x6a\xdd\x94\x36\x9b\x77\xfc\x8b\xb4\xaa\x29\x79\x4b\x8d\xae\x3b\x6e\x94\xc0\x9c\x28\x49\x60\x70\x2e\xa1\x66\x82\x67\xb6\x2d\xae\xd8\x8e\xce\xa3\x30\x93\x1b\x54\x98\x2f\xa8\x80\xda\x94\x25\xe0\x7b\xa7\xc7\xac\x99\x81\xb5\x2c\x73\x67\x9d\x43\x4d\xa1\x58\xb8\x9e\xd6\x6d\xf1\xd3\xc5\x5d\x34\xf3\xa7\x8c\xba\xc4\xbb\xb6\xae\x50\x6b\x72\xb4\x1f\x2c\x3a\x67\xca\xa7\x35\x39\x13\xa2\x52\x9e\x62\xe2\x80\x3b\x49\x32\x9a\x79\x13\xc6\x87\x20\xa7\x10\xc3\x73\xfa\xd3\x76\xba\xb1\xd7\x1f\x27\x6d\x1a\x8d\x42\x82\x67\xd9\x6d\x8f\xaa\xb6\x5f\xda\xe6\xf2\x2b\x19\x5b\xfc\x31\xc6\x2d\x35\xab\x6f\x48\xec\xbc\x94\x4b\x56\xda\x3e\x47\xf7\x27\x90\x95\x5b\xf1\xe1\x3b\x8f\xb7\x5c\xe4\x72\x1b\xdb\x08\x5c\x2a\xb9\xd5\xe1\x0d\x2e\x3e\x7f\xf3\xc7\xab\x97\x6f\xdc\x0a\x8d\xaa\xe9\x47\x9d\xa4\xd1\x86\xa9\x80\x1e\xdc\x46\x0a\xdf\xca\xbc\x29\xd1\x2b\xdc\xcf\x00\xfe\xfc\x71\x65\x97\x63\xd8\x30\xc5\xed\xf5\xd5\x68\x68\xfa\xf2\xb8\x29\xfc\x8b\x0b\x73\xea\x06\x09\x70\xc2\xf6\x31\x56\x19\xd7\xb4\x3d\xfb\xa8\x53\xa7\xc2\x1d\xdb\xad\x69\x3a\xf8\xfe\x7f\x2f\x59\x85\xf1\x82\x5a\x88\xe4\x99\x23\xea\x59\x75\x89\xbe\x17\x39\x16\x9c\x22\x7d\xcf\xb5\xe3\x11\x47\x3b\x6e\x82\x54\xec\x80\xf6\xbb\xba\x58\xaf\x71\xd9\xac\x56\xa8\x60\x45\x2d\x6f\x26\xab\x9a\x97\x87\x33\x2e\x35\xfc\xb9\x97\x7b\x11\x53\x7c\x18\xdb\x10\x7b\x77\x07\x88\x79\x02\x77\x9d\xcc\x28\x58\xe9\x1b\x9f\x5e\x0f\xef\x97\x86\x53\xaf\xbb\x7f\x0a\x6b\x85\x1a\x85\xd1\xc0\x1f\x93\x60\xfa\xaa\x5c\xef\x3d\xd2\x7a\xb5\x51\x27\x78\xe9\xe3\xeb\x2d\xbb\xc5\xdf\x08\x62\xab\x58\xad\xbb\x9d\x1e\x85\x8e\xb3\x2c\xcb\x32\xd4\xe1\x8d\x3f\xbc\x97\xcb\xe2\xc0\x36\xd4\x4f\xc6\x2e\xe0\x98\x5a\x35\x64\x1a\x1d\xd3\x14\xb6\x95\x2a\x0f\x79\x3c\xa8\x9b\x17\xc2\x3d\xec\xd8\x2e\xd4\x13\xb4\x5d\xb6\xdb\x08\xd7\x1f\xda\x8c\xf9\x85\xb3\xb8\x18\x76\xbd\x7a\xfc\x5d\xe5\x15\xc4\x8b\x43\xa3\x14\x22\x09\x97\xea\xdf\xb8\xd3\x3d\x7f\xdc\xd2\x07\x1f\xe2\x6e\xa4\x18\x3e\x47\xb8\x03\xd0\xd6\x6e\x3a\xbf\xfe\xb0\xbf\xd2\xbc\x00\x09\x67\x67\xf6\x29\xe1\xfe\xde\xfd\xbd\x8f\xb7\xbb\x68\xd6\x35\xff\xec\x21\x9a\x31\x38\x3d\x0b\xfc\xed\x6d\x70\xa8\x71\xe2\x4f\x43\xb4\xe2\x05\xc8\x24\x9a\x69\x12\xa5\xc3\xcd\x83\xc6\x05\xb0\x76\x58\x4c\xa2\x99\xfd\xd1\x86\x84\xfe\xfe\x02\x38\xfc\xa3\xb3\xf8\x02\xf8\xf3\xe7\x56\xbd\xbe\xe6\x1f\xe0\x0c\x58\x3b\xf1\xed\xb3\x0d\xd1\xf1\xec\x74\x27\x34\xc2\x4f\x2a\xfb\x31\x62\x18\xb1\xae\x54\xae\x99\xb6\x31\x54\x53\xda\x29\x6c\x21\x09\x37\x1f\xf3\xf6\xf5\x46\x16\x14\xd0\xef\xb5\x5d\x2a\x79\xc6\x0d\x5d\x39\x83\xca\x06\x8e\x76\x7f\x76\x7e\xb5\xf1\xbf\xe3\xf8\x0a\x63\x1f\xa2\x0e\x7f\xcd\xd9\x07\x96\x27\xfb\x99\xf0\xdf\x90\x81\x0e\x2f\x4b\x12\xcd\xe4\xa4\x23\x68\x38\x21\x01\x97\x9e\x6e\x6e\xc2\xcd\xbd\x71\x87\xbf\xb9\x89\x17\xb0\x49\xa2\x59\xe0\x7c\x7a\x06\x1b\x07\xd1\x19\x94\xe2\x24\x94\x1f\x2b\x14\x8f\xb8\xcb\x2f\x8d\x38\xad\xb2\x9e\xf7\xcb\xc1\x71\xd1\x8c\xa2\xad\x72\xb0\xf5\xed\xaa\x53\x38\xe0\x6f\x67\x10\xc7\x70\x07\x47\x47\x76\x78\x0b\x3e\x88\x66\xb3\x59\x26\x85\xe1\xa2\xc1\x68\x46\xfe\xf6\xa7\xf2\x28\x34\xe7\x76\x60\x16\xee\x7e\x86\x59\xae\x0d\xf8\x8e\x35\x67\xe3\x57\x10\x3f\x39\x13\xf1\xff\x62\x78\xd3\x25\x23\x59\x2d\x81\xb1\x92\x75\x47\x57\xb2\x08\x47\x31\xbb\x3a\x4e\x16\x60\x54\x83\xe1\x12\xb0\xba\x2e\x77\x04\xe0\x86\x70\x3a\xfa\x43\x2f\x5e\x65\xd4\x8e\xbb\xf6\xcd\xfb\x55\x53\x14\x53\x21\xdb\x15\x28\x94\xac\x80\xc1\x72\x67\xfc\xc3\xb5\x0f\xa5\x3e\xce\x7c\x09\xd7\x1f\x48\xa6\x77\x74\xf7\xd0\x3d\x0c\xa6\x25\xc5\x4a\x51\x50\x51\x3c\x3d\xf3\xa8\xf6\x60\xdf\xb9\xaf\x71\xe2\xe6\xa4\x68\xe6\xde\x8e\x0e\xa5\xfc\x8b\x52\x2b\x15\xae\x64\x47\xc4\xbe\xbc\x84\x88\x5a\x5a\x8e\x6d\xc2\xb0\x72\x94\x31\xac\xb2\xf0\xdf\xe7\x0e\x35\x64\xbf\xb7\xee\x1d\x56\xf3\xaa\x2e\xd1\x3e\x52\x52\x2f\x97\xc2\x85\x7d\xa1\x68\x0b\x8d\x7d\xc2\xd4\x6b\xa9\xcc\xda\xfe\x92\x27\xd5\xf0\xee\x6b\x98\x2f\xb1\x90\xaa\x3b\x61\x24\xbe\x37\x7c\x3b\xf1\x62\xed\xfa\xad\x1e\x87\xfd\xcf\x06\x4f\x64\xe1\x7f\xa3\x98\x26\x71\xd5\xff\xb9\x23\x72\x1e\xe6\x82\xd3\x00\x73\x17\xcd\x8e\x8e\x80\x6d\x24\xcf\x21\x47\x96\x43\x26\x73\x04\x2c\x79\xc5\x05\xa3\xb0\x8d\x66\xd6\xc7\xb6\x87\xbb\x7b\x88\x66\x37\x70\x06\x18\x3d\x44\xff\x0b\x00\x00\xff\xff\x72\x0d\xcb\x80\x42\x1f\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x5a\xdd\x73\xdb\x36\x12\x7f\x26\xff\x8a\x2d\xa7\x73\x11\x1b\x85\xbe\xb4\x1e\x4f\xc7\x69\x1e\xdc\x2f\x5f\x7a\x4d\x9a\x19\x37\xd3\x87\x4c\x26\x85\xc8\xa5\x04\x1b\x02\x78\x00\x28\x45\x67\xfb\x7f\xbf\x59\x7c\xf0\x43\xa2\x12\xe7\xda\xbc\x54\x26\x16\xbf\xfd\x61\x77\xb1\xbb\x00\x7a\x72\x02\xaf\x59\x79\xc3\x96\x08\xd7\x06\x1a\xad\x36\xbc\x42\x03\x75\x2b\x4b\xcb\x95\x34\x50\x2b\x0d\x5c\x5a\xd4\xac\xb4\x5c\x2e\x61\xcb\xed\x0a\x24\xb3\x7c\x83\xf0\x0b\xdb\xb0\xab\x52\xf3\xc6\xc2\xc5\xeb\x17\xa6\x80\x1f\x98\x10\x06\xac\x02\xbb\x42\x83\x03\x14\xa6\x11\xac\x46\x66\xb1\x02\xd3\x60\xc9\x99\x10\x3b\x58\xec\xe0\x52\x35\x2b\xd4\xbf\x5c\x01\x93\x15\x58\xcd\xa4\x11\x4e\xa8\xe2\x1a\x4b\x2b\x76\x01\x8c\x6b\x28\x95\xd6\x68\x1a\x25\x2b\xa2\x31\x50\x6d\x76\xd2\xb2\x0f\x45\x7a\x72\x92\x9e\x9c\xc0\x1b\x83\xf0\x92\xdd\xe0\x1f\x9a\x35\x0d\x6a\x9a\x8f\x1f\x1a\x65\x10\xd6\x68\x57\xaa\x72\xf4\xfa\xd9\x05\xfc\xb1\x42\x09\x0d\x33\x86\x60\x37\x4c\xb4\x68\x3a\xed\x73\xd2\x0d\xb5\x12\x42\x6d\x69\xd8\xee\x1a\x84\x52\xc9\x0d\x6a\xd3\xad\xab\x41\x5d\x2b\xbd\xc6\xea\x3c\x50\x80\x3b\xb8\x54\x5e\x76\xfc\xef\x6e\x48\x7b\x30\x7e\x07\x3f\x0c\x30\x17\xac\xbc\x01\xab\xbc\xd5\x6b\x56\xe2\xed\x3d\xdc\x05\xdc\x27\x53\xff\x3e\xf7\xfb\x50\x22\xe0\x2e\x94\x12\x70\xf0\xef\x0e\xbe\x57\x4a\x20\x93\x07\xdf\xa7\xe5\x07\x12\x01\x97\xd6\xb0\x44\x6d\x9c\x7b\x6b\xa1\x98\x35\x34\x0a\xaf\xda\xf5\x02\xf5\xa1\x3e\x27\x72\x76\xfa\x49\x5c\x63\x35\xf9\x63\x7f\x14\xae\x8e\x7c\x9f\x96\x3f\xc4\x7d\xfb\x8e\x4b\xfb\xed\xc1\x28\xbc\x90\xf6\xdb\x0b\xad\xd9\x6e\xef\xfb\xb4\xfc\x11\xdc\xa7\x67\x53\xb8\x4f\xcf\x0e\x80\x8f\xc9\x1f\xc1\xfd\xe6\xeb\xb9\xff\x31\xc2\xfd\xe6\xeb\x63\xb8\xf0\x10\xbe\xed\xc4\xc2\xee\xe0\x0d\x9f\x32\xc4\x31\xf9\x63\xb8\x4f\xcf\xa6\x70\x0f\x0d\x71\x4c\xfe\x18\xae\x37\x44\xdb\x2d\xd1\xe3\x1e\x1a\xe2\x6e\x24\xf5\x71\x5c\x17\x91\xdf\x7c\x3d\x1e\x85\x9f\xfd\xd7\x3d\xe0\x63\xf2\x47\x71\xcf\x4e\xa7\x70\xcf\x4e\x8f\xe1\x9e\x9d\x7e\x02\x97\x09\x01\xca\xae\x50\x83\x11\xbc\x44\x13\x46\xe1\x30\x76\x07\xf1\xd0\x65\x99\x8f\xe0\xd2\x7c\x33\xb1\xaf\x10\xbd\xa6\x51\xba\x3b\xf6\xfd\x10\xb7\xaf\x10\x7b\x76\x08\xdf\x0f\xf2\x43\x2b\xcb\x59\x51\x14\x03\xd6\x39\x7c\x75\x6d\x8a\xdf\x16\xd7\x58\xda\x0e\xd7\xf2\x35\x16\xbf\xf3\x35\xee\xcd\xff\x91\xd9\x29\x36\x47\xe4\x0f\xf9\x3e\x99\x1e\x05\x2e\x8d\x65\xb2\x44\x55\xc3\x2b\x55\xf5\x79\x7d\x40\xed\xa3\xb8\x6b\xd6\x98\x39\x18\xab\xdb\xd2\x9a\x69\xdc\x01\x8c\x93\x7f\xeb\x73\xda\xb4\x03\xef\x42\x29\xba\xa0\x4a\x14\x8d\xec\xaa\x1c\x56\xe3\x02\x08\xdc\x40\xc9\x84\xa0\xc2\xbc\x93\xe5\x4a\x2b\xa9\x5a\x23\x76\x05\xbc\xa8\x81\x5b\x58\x08\x55\xde\x98\xb9\xeb\x03\xf0\x03\x5b\x37\x02\x41\x49\x60\x50\xae\x98\x94\x28\x40\x35\xa8\x99\x83\x57\x9a\x3e\x53\x08\x5a\x05\x17\x5b\xc6\xad\xaf\x9f\xee\x93\x46\xdb\x6a\xaa\x98\xf0\x5a\xab\x35\x37\xe8\xd6\x86\xac\x9a\xc3\x76\xc5\xcb\x15\x18\xb4\x56\xa0\xf1\x0d\x86\x2b\xbb\x81\xf6\x23\x03\x1a\x4d\x2b\xac\x01\x25\x4b\x04\x6e\xa1\xe6\x92\x9b\x15\x9a\x58\xf4\x2f\xaa\x8a\x93\x28\x75\x15\x9e\x2a\x0b\xc6\xa4\x6a\x6d\x19\x97\x94\xfd\xd9\xd0\x1d\x35\x47\x51\xcd\x41\x49\xb1\xf3\x24\x95\xb4\x28\x2d\xa8\xda\x2b\xa7\x61\xd8\x72\x21\x60\x81\xd3\x86\xa3\x92\xb6\xa1\x10\xa7\xca\xcd\x22\x95\xdf\xfc\xee\x0b\x9e\x64\xba\x9b\xcc\x0c\x34\x82\x71\x09\xca\x11\x30\x70\x83\x3b\xac\x60\xb1\x0b\xfd\x0d\x75\x29\xda\x62\x15\x54\x4b\xb6\x46\x53\xc0\x45\xf8\xd3\xb2\x65\x47\x4d\xe9\x35\xfc\x79\x6d\xce\x33\x92\x99\xab\x35\xb7\xb8\x6e\xec\x2e\xfb\xd3\xf9\x64\x89\xc6\x89\x35\x9a\x3c\x63\x77\x0e\x89\xc8\xce\xbd\x69\x3b\xf9\x39\x08\x64\x9b\x7d\x69\xd5\x5a\xe0\x43\x1b\xac\x18\x55\x6f\x70\x53\x7c\x7f\x04\x33\x66\xa0\xc2\x9a\x4b\xcf\x1f\x65\xa9\xa8\x29\x3b\xb9\x36\x4a\xe6\x05\xfc\x4c\xf3\x0c\x51\x5e\x62\xe5\x99\x3e\xc9\xfe\x74\xb6\x30\x37\xbc\x69\xb0\x2a\xe0\xf7\x15\x82\x21\x62\x6b\xd6\x34\xe4\x1c\x6e\xa0\x25\x33\x6d\x57\x28\x81\x05\x53\x0d\xac\xed\xad\xe6\xa2\xd5\xf5\x4a\xd6\xfb\xa3\xf3\xb3\xf3\x79\xa3\xdc\x6e\x18\x0e\x14\x69\xd3\xf5\xb7\xa9\x73\x50\x07\xc4\x62\x70\xa0\x0e\x31\x73\xd8\xd7\x7a\xb5\x83\xce\x96\x5b\xd3\x75\x92\x7f\x43\x57\x7b\xd8\xc7\xc2\x05\x48\x2e\x86\x4b\xe9\x19\xe3\x7f\x5a\x26\xc6\x61\xf8\xc8\x40\x26\x5b\x21\xb2\x22\xca\x95\x4c\x82\x54\x16\x16\xe8\x2d\x4a\xfe\x23\x33\x53\xc0\x15\xa9\xcb\xc7\x41\xd2\x5b\xe8\x36\xda\xf6\xab\xf0\xf9\xde\xd9\xe9\x12\x6d\xb7\x69\x29\x1a\xbc\xd0\x23\xd3\x87\x4a\xb7\x53\x97\x7c\x83\xd2\xc3\xd3\xa6\x85\x99\x8a\x58\x39\xc1\xcc\x6e\x70\x17\x3a\xb0\x3c\x0e\xc0\x6d\x00\x07\x55\x04\x1b\x07\xc9\x3c\xe8\xbf\x42\x0b\xd4\x95\x2f\x83\x7e\x1f\x7a\x56\xfd\x15\x32\x57\x23\x32\xf3\x80\x39\x2a\x26\xb7\x3d\xa1\x20\x1d\xc4\x22\xaf\x1f\x51\xa0\x45\xd0\xb8\x56\x71\xf3\xfc\x9f\x6c\x3c\xd2\xc8\x3a\x03\xed\xfd\x68\xd4\xfc\x2b\xca\xa5\x5d\x4d\x3b\x25\x13\x6e\x30\xeb\x28\xcc\xc7\xfb\x84\x4b\x3b\xc1\xc0\x23\xce\x72\x1a\x9e\xf0\x48\x37\xec\xf5\xbf\x90\x15\x7e\x18\xa9\xe7\x8f\xec\x0a\x50\xe0\x3a\x64\x4e\x26\x7d\xa7\x30\xa1\xca\x4d\x9e\x71\xd2\xf4\xb1\x20\x08\x62\x83\x20\x70\x5f\xc0\xa0\xfd\x6c\x95\x71\xb2\xd7\xfa\x00\x6f\x07\xe9\x3d\x87\xd3\xd6\x77\x35\x6c\xcf\xe4\x3e\x0b\xec\xbb\x9a\xb2\xed\x04\x17\x02\x99\xd1\x58\x17\x7b\x4c\x2f\x0d\x1c\xb4\x32\x47\x0d\xd3\x01\xf8\x99\x45\x51\xf4\x6e\xd9\xa8\x1b\x3c\x60\x08\xdc\x1a\x14\x35\xa5\x5a\x6e\x7c\x25\xab\x19\x17\xc0\x5d\x59\xe7\xc6\xe5\x08\xd6\x55\xd9\x49\x97\x11\xf0\xec\x33\x89\x0e\x66\x0d\x48\xbe\xc2\x2d\x94\x2e\x55\x1a\x60\x20\x71\xdb\xb5\x36\xbe\xac\x71\xe3\x3b\xc5\x00\x32\x4d\x7a\xcc\x18\x66\xa5\x92\x3e\x85\x29\x9d\x4f\xf0\x7f\x85\xdb\xcf\x25\x1f\xa7\x0c\x98\xd3\x11\x78\x62\xcf\x8d\xb7\x97\x3b\x0f\xb3\xb2\x54\xba\x72\xf7\x04\xea\xe0\xa4\x3f\xb8\x35\x98\xa0\x4a\x4a\x66\xb9\x87\x39\x64\x15\x46\xc3\x96\x70\xf1\xf3\x49\x46\x3e\xcc\xfe\x0a\x27\xaf\x68\x96\x47\xa8\x43\x5e\x9d\x44\x0c\x44\xfb\x49\x5a\x5c\xda\x07\x73\x82\x59\xc3\xb4\xc1\x17\xd2\xe6\x93\xd1\x69\x8f\x26\x2e\x3f\xd6\xb1\x3a\x3b\x7d\x08\xaf\xb3\xd3\xbf\x8f\xd9\xd9\xa9\xe7\x76\x76\x3a\xcd\xee\xec\xb4\xe3\xf7\x86\x3f\x88\x60\xfb\x77\x32\xf4\x3a\x67\x39\xb4\xc7\x38\xbe\xe1\x23\x92\xee\x5c\xfa\x49\x8e\xf1\x8c\xfa\x99\x24\x1d\xf8\x14\x4d\x37\x30\xcb\x3b\xdc\x43\x9a\x51\xa2\x73\xb5\xdf\xe4\x0f\x71\x77\x4c\x07\x05\x5c\x21\x82\x65\x0b\x81\xc0\x25\xc4\x6e\xb1\x54\x6b\x57\x62\x6a\xa5\xa1\x42\xcb\xb8\x30\xd3\xae\xf6\x38\xde\xdd\x11\x73\xda\xe9\x9d\x64\x70\xbc\x34\xac\x9e\xa4\xea\x3b\x6e\xf2\x4d\x63\x75\x3c\x1f\x95\x4c\xc2\x02\x07\xcb\xd8\x70\x06\xad\xc3\x28\x5e\xfb\x66\xb1\x80\x57\xca\x3a\x1e\xb2\xa2\x83\x84\xd2\xd0\xb4\x0b\xc1\x4b\x68\xcd\x54\x51\xf2\x0c\x42\x18\x34\x56\x4f\xc5\x41\x14\xf1\x9c\x7f\xd2\x5a\x69\x40\x59\xb2\xc6\xb4\xc2\x65\xf3\x81\x7f\x91\x46\x0d\x25\x6f\x65\xd0\x77\xc7\xad\x96\x58\x11\x25\x05\x0c\x2e\x15\x34\x4c\xf2\xd2\xb5\xc5\x6b\xb6\xa3\xf5\x68\x2c\xd5\x06\x35\x56\x73\x2a\xa0\x2e\x65\x49\xf8\xca\xeb\xb1\x2b\x66\x61\xa5\xdc\x61\x62\x85\x07\x9a\x62\xb1\xf0\x3d\xad\x9f\x12\x4e\x03\xb7\x69\x12\x56\x99\x0e\x89\x0f\x6d\xbd\x46\x63\xd8\x32\x94\x1f\x1c\xae\xa9\x3a\xae\xc9\x9b\x10\xb5\x0e\x14\x73\x0f\x3c\x48\x92\x69\xe2\x95\x40\xb6\x0f\x72\x0e\x19\x3c\xa6\x9f\xae\xd3\xcd\x82\xfe\x2c\xef\xd2\x68\x1a\x13\x3c\x5d\x00\x0f\xa9\x1a\xf7\xa5\x3f\xa2\xfd\x35\xc6\x0e\x7f\x8a\x71\x47\xcd\xe9\x3b\x24\x76\x29\xd4\x82\x09\xd7\xe7\x98\xf1\x09\x64\xe9\x47\xbc\x4e\x98\x65\x5b\x2e\x2b\xb5\xcd\x5c\x04\x2e\xb4\xda\x9a\x78\x05\x9c\x5d\xfe\xfa\xdb\xf7\x17\xbf\xfa\x11\xba\x29\x29\xae\x4d\x5e\xa4\x1b\xa6\x23\x7a\x74\x1b\x29\x7c\xa9\xaa\x56\x60\x50\xd8\x9f\x01\xc2\xfa\xb3\xb5\x1b\xce\x60\xc3\x34\x77\xdb\xd7\xa0\x85\xc5\x2e\xe2\x16\xf0\x2f\x2e\xed\xb9\x3f\x48\x80\x17\x0e\xa7\x6c\xdf\xb4\x3d\xba\x36\x85\x57\xe1\x97\xed\xc7\x0c\x2d\xbc\xff\xf3\x15\x5b\x63\x36\xa7\x16\x22\x7f\xe4\x89\xfa\x29\x23\xa2\x6f\x64\x3c\x0e\xf7\x5c\x07\x1e\xf1\xb4\xb3\x36\x4a\x65\x1e\xa8\x9f\x35\xc4\xfa\x11\x17\xed\x72\x89\x1a\x96\x68\x0d\xa5\xa1\x86\x8b\xfd\xbb\x07\x6a\xf8\xab\x20\xf7\x2c\xa3\xf8\xb0\xae\x21\x0e\xee\x8e\x10\xb3\x1c\x6e\x07\x99\x51\x32\xe1\xf5\x8c\x7b\xf8\x30\x34\x71\xd8\x76\xfb\x4f\x63\xa3\xd1\xa0\xb4\x06\xf8\x43\x12\xcc\x58\x95\xef\xbd\x27\x5a\xaf\x2e\xea\x24\x17\x21\xbe\xe8\xd9\x86\x2e\xfe\x60\xab\x59\x63\x86\x9d\x1e\x93\xd1\xb2\xac\x2c\xd1\xc4\x27\xa6\xf8\x5c\xa3\xea\x3d\xdb\x50\x3f\x99\xf9\x80\x63\x7a\xd9\x92\x69\x4c\x46\xa7\xb0\xad\xd2\x55\xcc\xe3\x51\xdd\xac\x96\x4e\xd3\x8c\x66\x45\x82\x73\xe8\x26\xc2\xdb\x77\x5d\xc6\xfc\xc4\x5a\x7c\x0c\xfb\x5e\x3d\xfb\x72\x1d\x14\x64\xf3\x7d\xa3\xd4\x32\x8f\x9b\xea\xdf\xb8\x33\x23\x7f\xdc\xd0\x87\x10\xe2\xfe\x48\x71\x78\x1d\xe1\x17\x40\x53\x87\xe9\xfc\xed\xbb\x7e\x4b\xf3\x1a\x14\x3c\x7f\x4e\xd6\x85\xbb\x3b\xff\xbb\x8f\xb7\xdb\x34\x19\x9a\x3f\xb9\x4f\x13\x06\xe7\xcf\x23\x7f\xb7\x1b\x3c\x6a\x96\x87\xd5\x10\xad\x6c\x0e\x2a\x4f\x13\x43\xa2\xb4\xb8\x59\xd4\x38\x07\xd6\x1d\x16\xf3\x34\x71\x6f\x86\x24\xf4\xcf\x67\xc0\xe1\xbb\xc1\xe0\x33\xe0\x8f\x1f\x3b\xf5\xe6\x2d\x7f\x07\xcf\x81\x75\x27\xbe\x3e\xdb\x10\x9d\xc0\xce\x0c\x42\x23\xbe\xe8\xf5\xc7\x88\xc3\x88\xf5\xa5\x72\xc5\x8c\x8b\xa1\x06\xb5\x7f\xc0\x74\xe9\x32\xde\xaf\xc5\xdb\x1b\x55\x03\x2f\xdc\x7b\x21\x7e\x68\x04\x2f\xb9\xa5\x2d\x67\x51\xbb\xc0\x31\xfe\xe7\xe0\xd1\x30\x3c\x23\x86\x0a\x53\x87\x4b\xae\xd1\x63\x62\x1f\x58\x81\xec\x47\xc2\x7f\x43\x06\xda\xdf\x2c\x79\x9a\xa8\xa3\x8e\xa0\xc3\x09\x09\xf8\xf4\xf4\xfe\x7d\xdc\xb9\xef\xfd\xe2\xdf\xbf\xcf\xe6\xb0\xc9\xd3\x24\x72\x3e\x7f\x0e\x1b\x0f\x31\x38\x28\x65\x79\x2c\x3f\x4e\x28\x9b\x70\x57\x18\x9a\x70\xda\xda\x79\x3e\x0c\x47\xc7\xa5\x09\x45\xdb\xda\xc3\x36\x37\xcb\x41\xe1\x80\x2f\x9e\x43\x96\xc1\x2d\x9c\x9c\xb8\xc3\x5b\xf4\x41\x9a\x24\x09\xdd\xbd\x71\xd9\x62\x9a\x90\xbf\xc3\xaa\x02\x0a\x9d\x73\x07\x30\xf3\xbd\x8d\x85\x1f\xfc\xc2\xf9\x7f\x31\x3e\x14\xd0\xd2\x8b\xcb\x1e\x81\x8a\xe5\x00\x21\x46\xf1\x82\xcb\xca\x59\x69\x1e\x09\xdb\x5d\x93\xe5\x73\xa8\x99\x30\x98\x8f\x62\x4f\x85\xd8\x73\x37\xd9\xe1\x1e\xbc\xbb\xcf\xa6\x6d\xb6\x54\x5a\xb5\x96\x4b\x84\x56\x5a\x2e\xa6\x77\x6c\xbc\xed\x0e\xd7\xdb\x05\xbc\x18\xa7\xdf\xba\x15\x35\x17\xc2\xf5\x9a\x2e\x9d\xcd\x41\xe9\x41\x0f\xe4\xe2\x98\xb4\x91\xb0\xc6\x6b\xf4\x19\x51\x23\x33\xdd\xa1\xdd\x31\x9c\x35\x7d\x26\x98\x75\x59\x0c\x7d\xcd\xbf\x4d\x13\xd7\x24\xf9\x8b\xf4\x41\x97\x94\x38\x9d\x5d\x01\x4a\x12\xd4\x1a\xc0\x4f\x73\xc6\x28\xbb\xcd\x4e\x97\xcb\x01\x60\x0e\x4f\xf3\x34\x69\x82\x51\xed\x0a\x65\x36\x4f\x93\xc4\x65\xd1\x11\x60\x0e\xb7\x50\xc2\x77\x4f\xc2\xbc\x5b\x37\x78\xee\x17\x7a\x0f\xf7\xdd\x24\xbf\x9c\x63\xb3\x50\xeb\x73\xf8\x87\xb3\xc7\xad\x97\xbc\xf7\x93\xf3\x34\xd1\xc4\xef\xbb\x27\x65\xe7\x36\x5d\x04\x33\xea\x02\xb5\x4e\xbb\xeb\x87\xe8\x88\xfe\x21\x62\xc2\x4b\xae\xe2\x71\x13\xbc\xe5\x6e\xb7\x75\x2b\xdd\xeb\x41\x2d\x81\xcb\x70\x77\xd1\x79\xde\x5f\x64\xc7\xd9\xdc\xbd\x54\x28\xb1\xc1\x70\x2d\x54\xd3\xe3\x45\x68\x5a\x74\xf0\x1e\x56\xc0\x6b\x42\xeb\x89\x48\x25\x9f\x50\xa2\x76\x56\xa7\x0b\xe0\x01\x33\xb7\x6a\x42\xee\x9f\x11\xa6\x22\x61\x0e\xad\x89\x51\xe2\x53\x5f\xd5\xdd\x03\x85\x76\x31\x02\x75\xb1\x15\xa2\xa7\xb7\x4d\x57\x09\x73\x98\x0d\xd2\x56\x8c\xa2\x8f\x54\x3c\xb7\x95\x02\x4a\xc8\x54\xc1\xaf\xce\x1c\xf3\xc0\x77\xe0\xe0\x34\x49\x96\x2a\x6a\xa3\xbf\x92\xe0\x36\xd4\xce\xa5\xb5\xa4\x5c\xe7\x52\x0b\x7d\xf9\xc2\x97\x32\x27\x48\xdf\xae\xcd\x4f\x5a\xcf\x41\xdd\x90\x2c\x35\xae\xb3\xd0\xde\x3e\xa3\x6f\x5e\x2c\xd1\x38\xbc\x91\x72\x53\xc2\xf3\x4f\x1e\x05\x68\x11\xee\xf7\x7d\x7a\x38\x63\xb8\x38\x87\x1e\x96\x46\xfa\x42\xdf\x9f\xe7\xe9\x18\xc8\xe1\x84\x65\x47\x1c\x7f\x9d\x48\x89\xce\x55\xb7\xbc\x0f\x4a\xf7\x2e\xfb\x7d\x5b\xd7\xc7\xea\xda\x50\xa0\xd6\x6a\x0d\x0c\x16\x3b\x1b\x1e\x57\x7b\x07\x0e\xc4\x66\x0b\x78\xfb\x8e\x64\x46\xee\x72\xf2\x13\x15\x67\x41\x05\xa5\xae\x0d\x5a\x1a\xf4\xa8\x6e\xbd\x5f\xfa\xaf\x59\xee\x2f\x53\xd2\xc4\x5f\x30\xef\x4b\xf9\xaf\xbd\x54\xac\xdb\x03\x11\x77\x3d\x1b\xcb\xce\xc2\x71\xec\xba\x0a\x27\x47\x6d\x85\x53\x16\xff\xfb\xd8\xa3\x46\x3b\xbd\x74\x51\x0b\x86\xbb\xc7\x47\x7a\xc9\xa0\x5c\xe6\x32\x29\x37\x7d\x37\xea\xde\x39\xcc\x4a\x69\xbb\x72\xff\xb7\x89\xd2\x87\x0d\x82\x81\xd9\x02\x6b\xa5\x87\xd7\x10\x79\x38\x40\xbe\x3c\xf2\xaa\xea\x0f\x65\x23\x0e\xfd\xd3\xf6\x67\xb2\x08\xef\xe8\xc7\x49\x5c\x8d\x9f\xe4\x53\xef\x61\x2e\xb9\xf5\xdb\xe4\xe4\x04\xd8\x46\xf1\x0a\x2a\x64\x15\x94\xaa\x42\x40\xc1\xd7\x5c\xba\x27\xd8\x34\x71\x3e\xf6\x99\xf2\x3e\x4d\xde\xc3\x73\xc0\xf4\x3e\xfd\xdf\x00\xd1\xee\xc9\x90\xe6\x25\x00\x00"),
 		},
 		"/nosync": &vfsgen۰DirInfo{
 			name:    "nosync",
 			modTime: time.Date(2020, 10, 13, 23, 35, 11, 810858250, time.UTC),
 		},
+		"/nosync/doc.go": &vfsgen۰CompressedFileInfo{
+			name:             "doc.go",
+			modTime:          time.Date(2026, 10, 18, 23, 14, 16, 721517224, time.UTC),
+			uncompressedSize: 1391,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x5c\x54\x3d\x8f\xe3\x46\x0c\xed\xf7\x57\xbc\xee\x12\xc0\x27\xf7\xe9\xae\x3a\xec\x21\xc8\x2d\xb2\x45\x6a\x7a\x86\xb6\x18\x8f\x86\x02\x49\x59\xf0\xbf\x0f\x66\x24\x7b\x37\xd7\xd8\x92\x31\x7c\x7c\x5f\xe3\xe3\x11\x6f\x94\xae\x74\x61\x54\xf5\x7b\x4d\x98\x4d\x6f\x92\xd9\x51\xe4\x32\xc6\xca\xed\x13\x37\x36\x17\xad\x0e\x3d\x23\x46\x46\xdc\x67\xee\x2f\xf3\x3e\xdc\x46\x5f\x8e\x47\x9c\xd5\xf0\x5d\xe7\x91\xed\xc7\xfb\x01\xeb\xc8\xc6\xa0\x52\x70\x51\xd3\x25\xa4\xb2\xc3\x96\x0a\xad\x20\xb8\xd4\x4b\x61\xc4\x68\x4c\x79\x78\x39\x1e\x1b\xc0\x63\x18\xc6\x59\x8c\x53\x78\x5f\x28\xd3\xac\x16\x7d\x65\x67\xd9\xbe\x75\x62\x78\x50\xcd\x64\x19\x45\x4e\x46\x76\x7f\x10\xf2\x86\xf5\x9b\x33\xe3\xb4\x48\xc9\xc3\x5f\xfa\x7e\xaf\x69\x97\xea\x07\xa8\x75\xd8\xaf\x5f\x77\xd5\xe7\x42\x97\x87\xb8\x4b\xa7\xf0\xaf\x23\xe9\x34\x51\xcd\xbf\x37\xac\x50\xc4\x28\xfe\xc0\x1f\xf0\x1a\x8e\xa2\xe9\xea\x20\x63\x04\x5d\xb9\x62\x95\x18\x75\x09\x48\xbd\x69\xb9\x49\xbd\x74\x3c\x4f\x23\xe7\xa5\xb0\x35\x3f\x6a\x07\x1b\xf9\xde\xc7\xce\xc6\x0c\xaa\x19\x33\xd9\xb5\x1f\x4e\x54\x4a\x1b\x7c\x1a\xd6\x87\x9e\x13\xf5\x4b\x1c\xe0\xda\xdf\x1b\xd2\xaa\x76\xc5\x49\x63\x84\x54\x24\xcd\x1b\x00\x67\x9c\x4d\x27\xfc\xa0\x1b\xbd\x27\x93\x39\xfa\x12\xa9\x9f\x73\x88\x91\x02\x49\x6b\x70\xcd\x2d\xb7\x9d\xd8\x34\xe0\x67\x4d\x1b\xab\x37\xd5\x82\xac\xf5\x4b\xe0\xd4\xb4\x82\xa2\xa5\xf9\xc8\xea\xdb\x27\x96\x89\x5a\xaa\xe5\x8e\xb3\xd4\x0c\xea\xd6\xec\xae\xc8\xc3\xd6\xc7\xd9\xbe\x79\xd4\x92\x1d\x12\x58\xa9\x87\xe5\x8b\xcf\x5c\x33\x37\x9a\x2e\x99\x21\xe1\x48\x26\x21\x89\x0a\x9c\x53\x88\xd6\x01\x7f\x76\x1a\x35\xe3\xef\xfe\xb4\x92\x34\xbb\x41\x38\x2f\xb5\x1f\xd9\x64\x50\x6c\x6e\xea\x34\x4b\x73\x3e\x8c\x29\x1c\x3e\x73\x12\x2a\xe5\xbe\xb9\x2a\x81\x22\xb5\x45\xd8\x6a\x7f\x31\x9a\xfe\x68\xbf\x75\x1d\x49\x97\x1a\x9d\x19\xf9\xa6\xbe\xc5\x22\x7b\xef\x1e\xdb\xf6\x55\x74\x65\x87\x56\xde\x2b\xe4\xbc\x57\xa3\xcf\xf9\x80\xd7\x4e\x6b\xdf\xe1\xfb\xbd\xa8\x6d\x20\x6b\xab\xe3\x3a\x4a\x1a\x91\xb4\xdd\x33\x14\xbd\x0c\x6f\x26\x35\x0e\x30\xaa\x79\x78\xad\x51\xbb\xe4\xa6\xa8\xc8\x95\x1b\xd6\x52\x0b\xbb\x6f\xbd\x68\x91\x7f\x50\x6c\x2d\x38\x6c\x67\xb7\x7a\x6e\x93\x62\xfd\x5c\x5b\x40\xf6\x74\x26\x63\x73\x7f\x2e\x24\xf5\xa9\xca\x07\xfc\x8c\x91\x6d\x15\xe7\x8f\xb2\x7e\x1a\xf9\xd8\xf6\x1c\xd9\x45\x34\xb0\x44\x15\x27\xfe\x94\x68\xa3\x60\xec\xcb\xc4\xf9\x80\xd3\x12\x1d\xce\xf9\xc6\x46\x05\x21\x13\x3b\x0a\xd9\x85\xad\x9f\x6c\x06\xaf\x23\x45\x6f\x45\xd1\x95\x6d\xc0\xb7\xbd\x50\xcd\x6d\x71\x8c\x5c\xf2\xf3\x62\xfc\x1a\x86\xea\x15\x12\x30\x8e\xc5\x1a\xaf\x5e\xfd\xae\xd2\xb8\x30\x39\x67\x9c\xee\x5b\xbd\xba\x21\x07\x88\xb7\x8a\x3b\x73\x05\xed\xe0\x94\x4c\x37\x7f\x3f\x39\x4b\xa5\x38\xa4\x67\x79\xe2\x58\x99\xeb\x80\x7f\x48\xe2\xbb\xe9\x32\x0f\xed\x09\x54\x56\xba\x3f\x83\x7f\x99\xff\xf7\xe7\xfa\xf2\xdf\x00\x23\xe1\x02\xf8\x6f\x05\x00\x00"),
+		},
 		"/nosync/map.go": &vfsgen۰CompressedFileInfo{
 			name:             "map.go",
 			modTime:          time.Date(2020, 10, 13, 23, 35, 11, 810054302, time.UTC),
@@ -47,10 +54,10 @@ var FS = func() http.FileSystem {
 		},
 		"/nosync/mutex.go": &vfsgen۰CompressedFileInfo{
 			name:             "mutex.go",
-			modTime:          time.Date(2020, 10, 13, 23, 35, 11, 810361266, time.UTC),
-			uncompressedSize: 2073,
+			modTime:          time.Date(2026, 10, 18, 23, 9, 20, 16603394, time.UTC),
+			uncompressedSize: 4480,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x54\xcb\x6e\xdb\x30\x10\x3c\x4b\x5f\xb1\xc9\xc9\x4e\x62\xa5\xbd\xb6\xf5\xa1\x68\x81\x22\x40\x7a\x09\x50\xe4\x4c\x53\x2b\x99\xb0\x44\x1a\x24\x55\xd5\x4d\xf2\xef\xc5\xf2\x21\xcb\x92\xec\xc4\x2d\xaa\x93\xb0\xe4\xce\xce\xec\x0c\xb8\x65\x7c\xc3\x4a\x04\xa9\xcc\x4e\xf2\x34\xbd\xbd\x85\xef\x8d\xc5\x5f\x20\x0c\x30\xc8\x9b\xba\xde\x41\xbb\x16\x7c\x4d\x05\xa9\xe4\x62\x55\x29\xbe\x11\xb2\xcc\x52\xbb\xdb\x62\xb8\x6c\xac\x6e\xb8\x85\xa7\x34\xa1\x53\xcc\x61\xa5\x54\x95\xbe\x38\xb8\x7b\xc5\x37\x40\x65\x03\x75\x06\x77\xd6\x23\xeb\x46\x2e\xac\xa8\x11\x50\x6b\xa5\x41\x14\x50\xbb\x83\x4a\x23\xcb\x77\xe0\x61\xb2\xb4\x68\x24\x87\x59\x0d\x57\x6e\xce\xdc\x81\xcd\xe6\x34\x88\x3a\xb2\x30\xed\x29\x4d\x92\x2d\x93\x82\xcf\x2e\xbd\x8e\x0f\x50\x77\x22\x0e\x10\x2f\xe7\x69\xf2\x92\x26\x5d\xe7\x12\xac\x6e\x30\x30\xfd\x21\xa9\x0a\x8d\x7c\x2b\x5b\xa9\xec\x51\xa6\x1e\xac\xe3\x7a\x71\x8a\xac\x9f\x08\xaa\x08\x7f\x98\x7b\xfe\x63\xb6\x05\xab\x4c\xa4\xfb\xf0\x78\x96\x53\xf1\xfa\xde\xab\x56\x0b\x8b\xf7\x1e\x9a\x3e\x67\x5a\x42\xeb\xa2\xe2\x17\xd5\x48\x8b\x1a\x84\xb4\x13\x4e\x42\xa1\x34\x10\x00\x0d\x38\xb1\x27\xdd\x8e\x4d\x70\xbd\x54\x10\xb2\x84\x1e\x4c\xd8\xa1\x6e\xe1\x2a\x90\x1d\x18\xae\xdb\x6c\xc8\xee\x62\x09\xef\xe0\xf9\x99\x8e\xfa\x72\xce\x4e\xc4\xa0\xff\x54\x2e\x74\x7b\x9e\xf8\x7d\x4a\x0e\xfa\xa6\xd4\x0e\x43\xf3\xba\xaa\x57\xa2\x33\x92\x75\x10\xa0\x91\xa1\xc1\x94\xff\x69\xe8\xc3\xd0\xd1\x7f\xb5\x6d\x90\x88\xeb\xeb\xa8\xae\xb3\x2d\x57\x48\x5a\x8c\x90\x65\x85\x41\x35\x67\x55\xf5\x11\x84\x05\x77\x48\x16\xb1\xa2\x40\x6e\x41\xd9\x35\x6a\x30\xa2\x6e\x2a\xcb\x24\xaa\xc6\x38\x65\xa8\xcd\xd9\x4e\xc7\x6d\x4e\xae\x61\x60\xf5\x44\xb4\x97\x14\xed\xbf\xb2\x7c\x80\xb4\x58\x84\x95\x3c\x32\x61\xbf\x69\xd5\x6c\xdf\xfa\x66\xec\x1b\xf6\xaf\x06\x1f\xbd\x0b\x9f\xf3\x1c\x58\x9e\x1b\xc8\xb1\xb2\xec\x26\x20\xd6\x6c\x07\x2b\x04\x89\x25\xb3\xe2\x27\xde\x80\x55\x60\xd7\x7d\xcc\xbb\xc2\x15\x22\x60\xe9\x9c\xe8\xae\x13\xaa\x53\x6e\xe2\x02\xdb\x12\xae\xba\xee\x39\x5d\x98\xb9\x89\x44\xc5\xed\xb1\x2d\xb3\x08\x76\xbd\xf4\x6c\xdc\x72\x7b\xf5\x4f\x87\x3b\xf5\x1b\x8d\x43\x7b\xdc\xc2\x7d\xbf\x53\x2f\xf3\xab\x92\x08\x39\x72\x8d\x35\x4a\x6b\x06\x62\x42\xc3\x11\xae\xd4\x3b\x8b\x1c\x89\xf8\xe2\xfd\xbc\x67\x4a\x10\x4a\x49\x9a\x44\x8d\xe1\xfa\x8d\x5a\x1d\x99\x40\xbf\x5d\x9a\x7a\x82\x2f\x96\x53\x8a\xc7\x13\x22\x7c\x54\xfc\x27\x00\x00\xff\xff\xec\x95\x29\x83\x19\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x57\x41\x6f\xdb\xb8\x12\x3e\x4b\xbf\x62\xda\x43\x10\x37\xb1\xd2\x77\x4d\x93\x43\x91\x07\x14\xef\xa1\x0f\x0f\xf0\xee\xa2\x67\x9a\x1a\x4b\x44\x28\xd2\x4b\x52\x51\xbd\x41\xfe\xfb\x62\x86\xa4\x24\xcb\x76\xd1\x02\xeb\x93\x4c\x91\xdf\xcc\x7c\xf3\x7d\x24\xb5\x17\xf2\x59\x34\x08\xc6\xfa\x83\x91\x65\x79\x77\x07\x5f\xec\xbe\x45\xf7\xdf\xdf\xc0\xf5\xc6\x43\x63\x9d\xed\x83\x32\xe8\xc1\x1a\x10\xe0\x95\x69\x34\x42\x68\x1d\x8a\x1a\x84\xa9\xc1\x1a\x7d\x00\x3f\xa8\x20\x5b\xf4\xb0\xc5\x30\x20\x1a\x08\x2d\x76\x84\x36\xb4\x68\xc0\x1a\x84\xad\xb6\xf2\xd9\xdf\x82\xb7\xf4\x0e\xf8\x1f\x6c\x51\xdb\x21\x22\x18\xc4\x1a\x64\x2b\x8c\x41\xed\x21\x58\xd8\x0b\xf7\x3c\x0b\x4f\x60\xa1\x15\x01\x76\xca\xd4\x0c\x0f\x41\x3c\xa3\xa9\xe0\xf7\x11\x6e\x10\x2a\x80\x32\xbc\xf4\x16\x86\x56\xc9\x96\x83\x49\xdb\xed\x95\x46\x07\xc1\xa1\x08\x0c\xe5\xf7\x28\x95\xd0\xfa\x70\x0f\x52\x68\xed\xc1\xee\x40\x85\x98\x0a\xa7\x0a\x6a\x07\x02\x76\xbd\x91\x41\x59\x13\x43\x53\x40\x0f\x82\xa3\x81\x14\x06\xb6\xc8\x58\xbd\xdf\xa3\xa9\xb1\xa6\x90\x1a\x09\xa7\xb5\xba\xf6\xa0\x42\x05\xff\x0f\x2d\xba\x41\x79\xbc\x05\x63\xa7\x7a\x00\x5f\xd0\x71\x2d\x19\x90\x0b\xa4\x8a\x6e\x99\x56\xce\x5b\x68\x8d\x8e\x73\x9b\x38\x13\x6e\x2c\xa8\x06\xe1\xc1\x58\xb3\xe6\x8c\x95\x69\xc6\x7c\xb9\xc6\x6b\x8f\xc8\x0b\x73\x97\x6b\x2b\xfb\x0e\x4d\x10\x34\x65\x55\x95\x77\x77\x34\xed\x73\xac\x87\x2b\x54\x1e\x1c\x6a\x14\x1e\x6b\x7a\x6e\x05\x97\x65\x29\xd7\x10\x1b\x37\x55\xc0\x0b\x88\x72\xac\x09\x46\x5b\xd3\xa0\x0f\x15\x7c\xa3\x21\x97\x13\xed\x4d\xc0\x1a\x9c\x6a\xda\x00\x5b\xdc\x59\xc7\x29\x1d\x52\x8f\xa8\x52\x63\x43\x4b\xb9\x13\xa1\xae\x37\xa0\x0c\xc1\x25\x21\xb1\x60\x48\x44\xcc\x97\x88\xf1\x1c\xe5\x96\xa1\x89\x5f\xa4\x01\x31\xcb\xcd\xa1\x44\xf5\xa2\x4c\x43\x50\x3b\x67\xbb\xc8\x67\x94\x57\xc5\x3a\x67\x7d\x11\x9c\x87\xde\x04\xa5\x47\x8a\x63\x61\xb2\x65\x75\x9a\x86\xc5\x78\xcc\x45\x55\x12\xcf\x8c\x70\x2d\x5b\x86\x05\x1f\x5c\x2f\xc3\xeb\xdb\x0a\x5e\xcb\xe2\x61\x2d\xdb\xf2\x8d\xc3\x0c\xe2\x19\x79\xed\x28\x9c\xcc\xa6\x38\xc7\x25\xdb\x4c\xb6\x29\x02\x2d\x3e\x1f\xc1\xa3\x46\x19\xe8\x49\x0a\x4f\x95\xc1\xc3\x7a\x9c\xf1\xfa\x76\x5f\x16\x35\xee\x44\xaf\xc3\x7d\x59\x14\x7b\x61\x94\xbc\x7e\x1f\x4d\x7e\x7f\xac\x43\x0a\xca\xca\xb1\x6e\x64\xe0\xfd\xaa\x2c\xde\x52\xfe\xff\xeb\x03\x7e\x8f\xf4\x76\x7d\xe8\x85\x06\xfc\x2e\x75\xef\xc9\x14\x34\xb7\x2a\xc3\x61\x8f\x69\x5a\x4c\x80\xb2\xa2\x57\x58\x03\x6c\xad\xd5\x65\x31\x24\x49\x28\x13\xca\x42\xb6\xc0\xbf\xa3\xa2\x52\xb4\xaf\x44\x50\xd4\x79\x57\xc1\x7f\x26\xdd\x73\x06\x9a\x36\x9d\x03\x28\x03\x3d\xf9\x29\x5b\x84\xd2\x9f\x0a\x8a\x1b\x0d\x81\x4d\x6d\xed\xc6\x22\x5e\x84\xd2\x62\xab\x31\x11\x7c\xdd\xc1\x07\x4e\x7d\xc5\xa1\xaf\x99\x5b\xb5\x83\x77\x5d\x95\x2a\x78\x2d\x8b\x62\xfc\xf3\x08\xc1\xf5\x58\x16\x85\xc3\xd0\x3b\x43\x2c\xd1\xec\xae\x92\x2d\x3c\x3e\x82\x51\x3a\xcd\xa7\xff\xd0\xc5\xee\xcd\x5b\xc7\x2b\xba\x2a\xf1\x71\x73\x53\x16\x2c\x22\x5a\xb0\x82\xbb\x3b\xf8\xc3\x70\xb1\x1a\xc5\x0b\x7a\xe8\x20\xc5\xa5\xe6\xf4\xbe\x4a\x24\xa5\x49\xbd\x99\x88\x0a\xb1\x43\xae\x37\xeb\xa0\x3a\x04\x74\xce\x3a\xda\xc2\x3a\x7a\x61\x6c\x48\x48\xa7\x65\x47\xb0\x0b\x85\x2f\x84\x13\x23\x82\xdd\xa5\x27\xac\x23\xb3\x51\x2f\x91\x89\xdc\xea\xc7\x47\xf8\xb8\x24\x6f\x27\xb4\x5f\xb0\x37\x2e\x58\xaf\x49\x26\xcf\x18\xb9\x48\x95\x6e\xbe\xcd\xe4\x47\xcd\x47\x77\x37\x38\x9a\x7f\x41\x8c\xf0\x39\xf6\x1f\xeb\x28\x25\x92\x07\x01\xf1\xb4\x1a\x3d\x18\x1c\x12\x90\x8f\xfb\x82\x90\x7f\xf6\xca\x91\x84\xb2\xd4\x92\xa2\x73\xec\x49\xd3\x1c\xf9\x6b\x44\x07\xc8\xda\x26\x34\x1a\x7c\xe2\xfd\xc8\x45\x8d\xc7\x24\x3d\xe4\x9f\x32\x81\xda\x3b\x3b\x4d\xb3\xef\x94\xe1\x4c\x23\xce\x4f\x2f\xd9\xc4\x35\x31\xcc\x53\x9b\xd7\x1c\x5b\x2a\x41\x5e\x7a\x7d\xe2\x38\x37\xb0\xd0\x08\x54\x99\xe6\xa2\xfd\x66\x9a\xa4\x01\xca\xc8\x3a\xde\xea\xe2\xc2\xdb\x88\x19\x7d\xb8\xdc\x5b\xcf\x79\xd0\x0d\xf0\x61\xf3\xed\xac\x0d\xdd\x50\xcd\x59\xbf\xba\x02\x37\x54\x4b\xc6\x47\xa9\x2d\x66\x5f\x70\x6b\x9e\xe5\x9e\x8e\x4c\x7b\x34\x7c\xd1\xbb\xe3\xac\xc9\xbc\xb3\x85\x73\x0f\x5b\x07\x9b\xf4\x78\x44\xef\x45\x1f\x9f\xd0\x7f\xd1\xd4\x6e\x38\x76\xf5\xd1\xba\x73\xa4\x2e\x4d\xbe\x20\xea\xd7\xad\x7e\xc2\x74\xb2\x76\xbc\x07\x8e\xc7\x52\x6a\x16\xc9\xfa\xdd\x23\x7c\xa4\xb3\x88\x5c\x9d\x86\xc6\x43\x0f\x1a\x1b\xd2\x39\x9e\xae\x08\xc2\x1c\xd8\xaa\x91\xd8\x7c\x6b\x3c\x40\x63\x61\xa7\x9c\x0f\x55\x6c\xd8\x52\x09\x37\x8f\xb3\x90\x65\x51\x10\x2f\x9f\x96\x59\xcc\x07\xd6\x6b\x2e\x3e\xee\x3c\xe3\xf0\x53\xbb\x2a\x8b\xe2\x6d\x2a\x22\xdb\x39\x17\x31\x0d\xad\xd7\xb3\xbf\x4b\xdd\x65\xd4\x51\x1e\xd3\xb1\xba\x39\xf5\x5d\x32\x13\x37\x3e\x99\x27\xde\x27\x63\xbb\x4f\x5b\x0d\xd6\x81\xc8\xc6\x43\x97\xee\x0f\x34\x41\x85\xb3\x32\xd8\xfc\x84\xb9\x72\xa9\x73\x53\x2d\x68\xbe\xb9\x39\xe7\xa9\x71\xb3\x39\xf6\xd4\x34\xfc\x23\x4f\xa5\x76\xcc\x3d\x35\xb6\x62\xe6\x29\xbe\xf3\x79\xe8\x3d\x88\xe9\x44\xc8\x76\xda\x8c\x7e\xaa\x2d\xfa\xe9\x5b\x65\x33\x9e\x04\x9f\x40\x05\xe0\x97\xc6\x06\x10\xbb\x1d\xca\x00\x96\x84\x07\x5e\x75\xbd\x0e\xc2\xa0\xed\x7d\x3e\x20\x7e\xd9\x82\xb9\x83\x67\xb9\x5f\x78\xf0\x47\xfb\xd8\x2f\x7b\x71\x81\xb4\x5e\xff\x30\xc4\xd5\xd5\x52\xd3\xf0\xfa\x8f\x88\x9a\x6e\xfe\x5f\x9c\xed\xf7\x33\x29\x0a\x90\x56\x6b\x8c\x5f\x51\x76\x37\x3f\xcf\x02\x99\xd9\x28\xdf\xa6\x13\x77\x5a\x3e\x9d\xb9\xf2\xe8\x54\xfd\xd9\x6b\xe4\xe7\xba\x06\x51\xd7\x1e\x6a\xd4\x41\xe4\x4f\xc1\x4e\x1c\x60\x8b\x60\xb0\x11\x41\xbd\xd0\x05\x32\x7e\xd2\x4c\x71\x53\xb4\x7c\xf4\x11\x54\x1a\x82\x2d\x4a\xdb\xa1\x87\xbf\xd0\xd9\x5b\x10\x5a\xcf\x2b\xc9\x77\x0e\x6b\x18\x8c\x3f\x7d\xf2\x77\xd4\x39\xb0\x86\x35\x38\xe6\x41\xe9\x72\xcf\x7d\x96\xce\xd0\xc0\x87\x31\xad\x15\x4d\xb8\xe6\x52\xa8\x74\x56\xd0\xd0\x54\x72\xda\xf4\xf8\x1d\xf7\x7c\x36\xfe\x70\xac\xa6\x74\xf7\x4f\x41\x4f\x8b\x9e\x2e\x71\x33\x8c\x51\x92\x71\x27\x1d\x9a\xf1\x7e\x17\x77\xd2\x69\xe0\x68\x27\x25\x84\xbc\x87\xe6\x9e\xfc\xdb\x1a\x84\x1a\xa5\x43\xfa\x04\xf5\x17\x98\x3f\x5f\x3f\xad\xbd\xce\x75\x13\x19\xeb\x7f\xad\x66\x9a\x3b\xbd\x6b\x9c\x00\x83\x8a\xad\xbb\x10\x80\x1e\x47\x6b\x9e\xab\xff\x78\xbb\xe3\xfa\xe6\x1b\x5d\x1a\xb8\xb8\xc5\x4d\x3c\xd1\x16\xf7\xb0\xe6\xf9\xe5\x5b\xf9\xf7\x00\xbb\xd9\x64\x0f\x80\x11\x00\x00"),
 		},
 		"/nosync/once.go": &vfsgen۰CompressedFileInfo{
 			name:             "once.go",
@@ -75,6 +82,7 @@ var FS = func() http.FileSystem {
 		fs["/js/js.go"].(os.FileInfo),
 	}
 	fs["/nosync"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/nosync/doc.go"].(os.FileInfo),
 		fs["/nosync/map.go"].(os.FileInfo),
 		fs["/nosync/mutex.go"].(os.FileInfo),
 		fs["/nosync/once.go"].(os.FileInfo),
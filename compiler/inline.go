@@ -0,0 +1,454 @@
+package compiler
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"go/types"
+
+	"github.com/goplusjs/gopherjs/compiler/astutil"
+	"github.com/goplusjs/gopherjs/compiler/typesutil"
+	goastutil "golang.org/x/tools/go/ast/astutil"
+)
+
+// maxInlineNodes limits the size of the expression returned by a function
+// that is inlined.
+const maxInlineNodes = 40
+
+// inliner replaces calls to small leaf functions with the expression they
+// return. A function can be inlined if its body is a single return statement
+// whose expression doesn't call anything but builtins and conversions, and
+// takes no addresses. Calls are only replaced if all arguments are variables
+// or constants, so that the order of evaluation can't matter.
+//
+// Functions are passed around as the source of an equivalent function
+// literal, which is type-checked in the scope of the package declaring them.
+// This works within a package as well as for Decl.Inline of imported
+// packages.
+type inliner struct {
+	info         *types.Info
+	pkg          *types.Package
+	importedDecl func(*types.Func) *Decl
+	local        map[*types.Func]string
+	literals     map[*types.Func]*ast.FuncLit
+	fileSet      *token.FileSet
+}
+
+func newInliner(info *types.Info, pkg *types.Package, importedDecl func(*types.Func) *Decl) *inliner {
+	return &inliner{
+		info:         info,
+		pkg:          pkg,
+		importedDecl: importedDecl,
+		local:        make(map[*types.Func]string),
+		literals:     make(map[*types.Func]*ast.FuncLit),
+		fileSet:      token.NewFileSet(),
+	}
+}
+
+// addCandidates records the functions in files that can be inlined. It
+// returns the source of those that can also be inlined in other packages.
+func (in *inliner) addCandidates(files []*ast.File, fileSet *token.FileSet) map[*types.Func]string {
+	exported := make(map[*types.Func]string)
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			fun, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+			o, ok := in.info.Defs[fun.Name].(*types.Func)
+			if !ok {
+				continue
+			}
+			src, exportable := in.inlineSource(fun, o, fileSet)
+			if src == "" {
+				continue
+			}
+			in.local[o] = src
+			if exportable {
+				exported[o] = src
+			}
+		}
+	}
+	return exported
+}
+
+// inlineSource returns the function literal source for fun, or "" if it
+// can't be inlined. exportable reports whether the function can be inlined in
+// other packages, which can only reference its exported package-level names.
+func (in *inliner) inlineSource(fun *ast.FuncDecl, o *types.Func, fileSet *token.FileSet) (src string, exportable bool) {
+	sig := o.Type().(*types.Signature)
+	if fun.Body == nil || len(fun.Body.List) != 1 || sig.Results().Len() != 1 || sig.Variadic() || o.Name() == "init" || o.Name() == "main" && sig.Recv() == nil {
+		return "", false
+	}
+	ret, ok := fun.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return "", false
+	}
+	if !types.Identical(in.info.TypeOf(ret.Results[0]), sig.Results().At(0).Type()) || !isInlinableType(sig.Results().At(0).Type()) {
+		return "", false
+	}
+	if recv := sig.Recv(); recv != nil {
+		if _, isInterface := recv.Type().Underlying().(*types.Interface); isInterface {
+			return "", false
+		}
+	}
+
+	exportable = true
+	valid := true
+	nodes := 0
+	check := func(n ast.Node) bool {
+		if !valid {
+			return false
+		}
+		nodes++
+		switch e := n.(type) {
+		case *ast.Ident:
+			switch o := in.info.Uses[e].(type) {
+			case *types.PkgName:
+				valid = false // only the package scope is available when type-checking the literal
+			case *types.Var:
+				if !o.IsField() && isPkgLevel(o) && !o.Exported() {
+					exportable = false
+				}
+			case *types.Func:
+				if !o.Exported() {
+					exportable = false
+				}
+			}
+			if t := in.info.TypeOf(e); t != nil && typesutil.IsJsObject(t) {
+				valid = false
+			}
+		case *ast.FuncLit:
+			valid = false
+		case *ast.UnaryExpr:
+			if e.Op == token.ARROW {
+				valid = false
+			}
+			if _, isLit := astutil.RemoveParens(e.X).(*ast.CompositeLit); e.Op == token.AND && !isLit {
+				valid = false // the result could alias an argument
+			}
+		case *ast.SliceExpr:
+			if _, isSlice := in.info.TypeOf(e.X).Underlying().(*types.Basic); !isSlice {
+				if _, isSlice := in.info.TypeOf(e.X).Underlying().(*types.Slice); !isSlice {
+					valid = false // slicing an array could alias an argument
+				}
+			}
+		case *ast.SelectorExpr:
+			if sel, ok := in.info.Selections[e]; ok && sel.Kind() != types.FieldVal {
+				valid = false
+			}
+		case *ast.CallExpr:
+			if !in.isLeafCall(e) {
+				valid = false
+			}
+		case *ast.TypeAssertExpr:
+			if e.Type == nil {
+				valid = false
+			}
+		}
+		return valid
+	}
+	ast.Inspect(fun.Type, check)
+	if fun.Recv != nil {
+		ast.Inspect(fun.Recv, check)
+	}
+	nodes = 0
+	ast.Inspect(ret.Results[0], check)
+	if !valid || nodes > maxInlineNodes {
+		return "", false
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("func(")
+	var fields []*ast.Field
+	if fun.Recv != nil {
+		fields = append(fields, fun.Recv.List...)
+	}
+	fields = append(fields, fun.Type.Params.List...)
+	for i, field := range fields {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		if len(field.Names) == 0 {
+			buf.WriteString("_ ")
+		}
+		for j, name := range field.Names {
+			if j != 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(name.Name)
+			buf.WriteByte(' ')
+		}
+		if err := printer.Fprint(&buf, fileSet, field.Type); err != nil {
+			return "", false
+		}
+	}
+	buf.WriteString(") ")
+	if err := printer.Fprint(&buf, fileSet, fun.Type.Results.List[0].Type); err != nil {
+		return "", false
+	}
+	buf.WriteString(" { return ")
+	if err := printer.Fprint(&buf, fileSet, ret.Results[0]); err != nil {
+		return "", false
+	}
+	buf.WriteString(" }")
+	return buf.String(), exportable
+}
+
+// isLeafCall reports whether call is a conversion or a call to a builtin
+// that doesn't panic or block.
+func (in *inliner) isLeafCall(call *ast.CallExpr) bool {
+	if in.info.Types[call.Fun].IsType() {
+		return true
+	}
+	if id, ok := astutil.RemoveParens(call.Fun).(*ast.Ident); ok {
+		if b, ok := in.info.Uses[id].(*types.Builtin); ok {
+			switch b.Name() {
+			case "len", "cap", "real", "imag", "complex":
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isInlinableType(t types.Type) bool {
+	switch t.Underlying().(type) {
+	case *types.Struct, *types.Array:
+		return false // results of calls are not copied again
+	}
+	return !typesutil.IsJsObject(t)
+}
+
+// literal returns the type-checked function literal for f, or nil if f can't
+// be inlined.
+func (in *inliner) literal(f *types.Func) *ast.FuncLit {
+	if lit, ok := in.literals[f]; ok {
+		return lit
+	}
+	in.literals[f] = nil
+
+	src, ok := in.local[f]
+	if !ok && f.Pkg() != in.pkg && f.Pkg() != nil && !typesutil.IsJsPackage(f.Pkg()) {
+		src = in.importedDecl(f).Inline
+	}
+	if src == "" {
+		return nil
+	}
+	expr, err := parser.ParseExprFrom(in.fileSet, "", src, 0)
+	if err != nil {
+		return nil
+	}
+	lit, ok := expr.(*ast.FuncLit)
+	if !ok {
+		return nil
+	}
+	if err := checkExpr(in.fileSet, f.Pkg(), lit, in.info); err != nil {
+		return nil
+	}
+	in.literals[f] = lit
+	return lit
+}
+
+// inlineCalls replaces the calls in file that can be inlined.
+func (in *inliner) inlineCalls(file *ast.File) {
+	keep := make(map[*ast.CallExpr]bool)
+	goastutil.Apply(file, func(cursor *goastutil.Cursor) bool {
+		switch n := cursor.Node().(type) {
+		case *ast.GoStmt:
+			keep[n.Call] = true
+		case *ast.DeferStmt:
+			keep[n.Call] = true
+		case *ast.ExprStmt:
+			if call, ok := n.X.(*ast.CallExpr); ok {
+				keep[call] = true
+			}
+		case *ast.CallExpr:
+			if keep[n] {
+				break
+			}
+			if e := in.inlineCall(n); e != nil {
+				cursor.Replace(e)
+			}
+		}
+		return true
+	}, nil)
+}
+
+// inlineCall returns the expression that replaces call, or nil.
+func (in *inliner) inlineCall(call *ast.CallExpr) ast.Expr {
+	if call.Ellipsis.IsValid() {
+		return nil
+	}
+	var f *types.Func
+	var args []ast.Expr
+	switch fun := astutil.RemoveParens(call.Fun).(type) {
+	case *ast.Ident:
+		f, _ = in.info.Uses[fun].(*types.Func)
+	case *ast.SelectorExpr:
+		f, _ = in.info.Uses[fun.Sel].(*types.Func)
+		if f == nil {
+			return nil
+		}
+		sel, isMethod := in.info.Selections[fun]
+		if !isMethod {
+			break
+		}
+		if sel.Kind() != types.MethodVal || len(sel.Index()) != 1 {
+			return nil
+		}
+		recv := in.receiverArg(fun.X, f.Type().(*types.Signature).Recv().Type())
+		if recv == nil {
+			return nil
+		}
+		args = append(args, recv)
+	}
+	if f == nil {
+		return nil
+	}
+	lit := in.literal(f)
+	if lit == nil {
+		return nil
+	}
+
+	args = append(args, call.Args...)
+	var params []*types.Var
+	for _, field := range lit.Type.Params.List {
+		for _, name := range field.Names {
+			v, _ := in.info.Defs[name].(*types.Var)
+			params = append(params, v)
+		}
+	}
+	if len(params) != len(args) {
+		return nil
+	}
+	subst := make(map[*types.Var]ast.Expr)
+	for i, arg := range args {
+		if !in.isSimpleArg(arg) || params[i] == nil || !types.Identical(in.info.TypeOf(arg), params[i].Type()) {
+			return nil
+		}
+		subst[params[i]] = arg
+	}
+
+	result := lit.Body.List[0].(*ast.ReturnStmt).Results[0]
+	e := &ast.ParenExpr{Lparen: call.Pos(), X: in.copyExpr(result, subst, call.Pos()), Rparen: call.Rparen}
+	in.info.Types[e] = in.info.Types[call]
+	return e
+}
+
+// receiverArg returns the receiver of a method call with the implicit address
+// operation made explicit, or nil if that's not possible. Implicit
+// dereferences are not inlined, since the nil check could get lost.
+func (in *inliner) receiverArg(x ast.Expr, recvType types.Type) ast.Expr {
+	t := in.info.TypeOf(x)
+	if types.Identical(t, recvType) {
+		return x
+	}
+	id, ok := astutil.RemoveParens(x).(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	if ptr, ok := recvType.(*types.Pointer); ok && types.Identical(ptr.Elem(), t) {
+		if _, isStruct := t.Underlying().(*types.Struct); !isStruct {
+			return nil // would need a pointer object
+		}
+		e := &ast.UnaryExpr{OpPos: id.Pos(), Op: token.AND, X: id}
+		in.info.Types[e] = types.TypeAndValue{Type: recvType}
+		return e
+	}
+	return nil
+}
+
+// isSimpleArg reports whether e can be evaluated at any time and any number
+// of times without changing the result of the program.
+func (in *inliner) isSimpleArg(e ast.Expr) bool {
+	if tv := in.info.Types[e]; tv.Value != nil || tv.IsNil() {
+		return true
+	}
+	switch e := astutil.RemoveParens(e).(type) {
+	case *ast.Ident:
+		_, isVar := in.info.Uses[e].(*types.Var)
+		return isVar
+	case *ast.UnaryExpr:
+		return e.Op == token.AND && in.isSimpleArg(e.X) && in.info.Types[e.X].Value == nil
+	}
+	return false
+}
+
+// copyExpr returns a copy of e with the type information of the original and
+// parameters replaced by subst.
+func (in *inliner) copyExpr(e ast.Expr, subst map[*types.Var]ast.Expr, pos token.Pos) ast.Expr {
+	if e == nil {
+		return nil
+	}
+	if id, ok := e.(*ast.Ident); ok {
+		if v, ok := in.info.Uses[id].(*types.Var); ok {
+			if arg, ok := subst[v]; ok {
+				if _, isIdent := arg.(*ast.Ident); !isIdent {
+					p := &ast.ParenExpr{Lparen: pos, X: arg, Rparen: pos}
+					in.info.Types[p] = in.info.Types[arg]
+					return p
+				}
+				return in.copyExpr(arg, nil, pos)
+			}
+		}
+	}
+	var c ast.Expr
+	switch e := e.(type) {
+	case *ast.Ident:
+		id := &ast.Ident{NamePos: pos, Name: e.Name}
+		if o, ok := in.info.Uses[e]; ok {
+			in.info.Uses[id] = o
+		}
+		c = id
+	case *ast.BasicLit:
+		c = &ast.BasicLit{ValuePos: pos, Kind: e.Kind, Value: e.Value}
+	case *ast.ParenExpr:
+		c = &ast.ParenExpr{Lparen: pos, X: in.copyExpr(e.X, subst, pos), Rparen: pos}
+	case *ast.SelectorExpr:
+		sel := &ast.SelectorExpr{X: in.copyExpr(e.X, subst, pos), Sel: in.copyExpr(e.Sel, nil, pos).(*ast.Ident)}
+		if s, ok := in.info.Selections[e]; ok {
+			in.info.Selections[sel] = s
+		}
+		c = sel
+	case *ast.IndexExpr:
+		c = &ast.IndexExpr{X: in.copyExpr(e.X, subst, pos), Lbrack: pos, Index: in.copyExpr(e.Index, subst, pos), Rbrack: pos}
+	case *ast.SliceExpr:
+		c = &ast.SliceExpr{X: in.copyExpr(e.X, subst, pos), Lbrack: pos, Low: in.copyExpr(e.Low, subst, pos), High: in.copyExpr(e.High, subst, pos), Max: in.copyExpr(e.Max, subst, pos), Slice3: e.Slice3, Rbrack: pos}
+	case *ast.StarExpr:
+		c = &ast.StarExpr{Star: pos, X: in.copyExpr(e.X, subst, pos)}
+	case *ast.UnaryExpr:
+		c = &ast.UnaryExpr{OpPos: pos, Op: e.Op, X: in.copyExpr(e.X, subst, pos)}
+	case *ast.BinaryExpr:
+		c = &ast.BinaryExpr{X: in.copyExpr(e.X, subst, pos), OpPos: pos, Op: e.Op, Y: in.copyExpr(e.Y, subst, pos)}
+	case *ast.CallExpr:
+		call := &ast.CallExpr{Lparen: pos, Rparen: pos}
+		if in.info.Types[e.Fun].IsType() {
+			call.Fun = e.Fun // type expressions are never translated on their own
+		} else {
+			call.Fun = in.copyExpr(e.Fun, subst, pos)
+		}
+		for _, arg := range e.Args {
+			call.Args = append(call.Args, in.copyExpr(arg, subst, pos))
+		}
+		c = call
+	case *ast.TypeAssertExpr:
+		c = &ast.TypeAssertExpr{X: in.copyExpr(e.X, subst, pos), Lparen: pos, Type: e.Type, Rparen: pos}
+	case *ast.CompositeLit:
+		lit := &ast.CompositeLit{Type: e.Type, Lbrace: pos, Rbrace: pos}
+		for _, elt := range e.Elts {
+			lit.Elts = append(lit.Elts, in.copyExpr(elt, subst, pos))
+		}
+		c = lit
+	case *ast.KeyValueExpr:
+		c = &ast.KeyValueExpr{Key: in.copyExpr(e.Key, subst, pos), Colon: pos, Value: in.copyExpr(e.Value, subst, pos)}
+	default:
+		return e // type expressions
+	}
+	if tv, ok := in.info.Types[e]; ok {
+		in.info.Types[c] = tv
+	}
+	return c
+}
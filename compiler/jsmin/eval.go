@@ -0,0 +1,76 @@
+package jsmin
+
+// A DynamicCode is a place where JavaScript code evaluates a string as code,
+// which a strict Content Security Policy forbids.
+type DynamicCode struct {
+	Offset int
+	What   string
+}
+
+// timerFuncs evaluate their first argument if it is a string.
+var timerFuncs = map[string]bool{"setTimeout": true, "setInterval": true, "setImmediate": true, "execScript": true}
+
+// FindDynamicCode returns the places in src that evaluate strings as code:
+// uses of eval, including indirect ones like window.eval, calls of the
+// Function constructor and timers that are passed a string. It doesn't
+// follow values, so code that reaches eval through a variable or computed
+// property isn't found.
+func FindDynamicCode(src []byte) ([]DynamicCode, error) {
+	toks, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+	var found []DynamicCode
+	for i := range toks {
+		t := &toks[i]
+		if t.kind != word {
+			continue
+		}
+		next := &toks[i+1] // the last token is eof
+		afterDot := i > 0 && toks[i-1].is(".")
+		switch name := string(t.text); {
+		case name == "eval":
+			// Object keys and methods of other objects are named eval, too,
+			// but only eval itself is called with one argument or passed
+			// around.
+			if next.is(":") || afterDot && !isGlobal(toks, i-1) {
+				continue
+			}
+			found = append(found, DynamicCode{t.offset, "eval"})
+		case name == "Function":
+			if afterDot && !isGlobal(toks, i-1) {
+				continue
+			}
+			// Function.prototype and instanceof Function are fine, but a
+			// reference to the constructor may be called anywhere.
+			if next.is(".") || next.is(":") || i > 0 && toks[i-1].is("instanceof") {
+				continue
+			}
+			found = append(found, DynamicCode{t.offset, "the Function constructor"})
+		case timerFuncs[name]:
+			if afterDot && !isGlobal(toks, i-1) {
+				continue
+			}
+			if next.is("(") && (toks[i+2].kind == str || toks[i+2].kind == template) {
+				found = append(found, DynamicCode{t.offset, name + " with a string"})
+			}
+		}
+	}
+	return found, nil
+}
+
+// globalObjects are the names of the global object.
+var globalObjects = map[string]bool{"$global": true, "window": true, "self": true, "globalThis": true, "global": true, "this": true}
+
+// isGlobal reports whether the dot at toks[dot] follows a name of the global
+// object, like in window.eval.
+func isGlobal(toks []token, dot int) bool {
+	if dot == 0 {
+		return false
+	}
+	t := &toks[dot-1]
+	if t.kind != word || !globalObjects[string(t.text)] {
+		return false
+	}
+	return dot < 2 || !toks[dot-2].is(".")
+}
@@ -0,0 +1,54 @@
+package jsmin
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFindDynamicCode(t *testing.T) {
+	for _, test := range []struct {
+		src  string
+		want []string // the code at each place found and what it is
+	}{
+		{`eval("x")`, []string{"eval: eval"}},
+		{`var y = 1; eval(code);`, []string{"eval: eval"}},
+		{`window.eval("x")`, []string{"eval: eval"}},
+		{`$global.eval("1")`, []string{"eval: eval"}},
+		{`var e = eval; e("x")`, []string{"eval: eval"}},
+		{`new Function("return 1")`, []string{"Function: the Function constructor"}},
+		{`Function("a", "return a")`, []string{"Function: the Function constructor"}},
+		{`self.Function("x")`, []string{"Function: the Function constructor"}},
+		{`setTimeout("f()", 10)`, []string{"setTimeout: setTimeout with a string"}},
+		{"window.setInterval(`f()`, 10)", []string{"setInterval: setInterval with a string"}},
+		{"eval(a);\nnew Function(b);", []string{"eval: eval", "Function: the Function constructor"}},
+
+		{`obj.eval("x")`, nil},
+		{`window.obj.eval("x")`, nil},
+		{`var o = { eval: 1 };`, nil},
+		{`x instanceof Function`, nil},
+		{`Function.prototype.call`, nil},
+		{`var o = { Function: 1 };`, nil},
+		{`setTimeout(function() {}, 10)`, nil},
+		{`setTimeout(f, 10)`, nil},
+		{`timers.setTimeout("f()", 10)`, nil},
+		{`var s = "eval(x)"; // eval(y)`, nil},
+		{`var evaluate = 1;`, nil},
+	} {
+		found, err := FindDynamicCode([]byte(test.src))
+		if err != nil {
+			t.Errorf("FindDynamicCode(%q): %v", test.src, err)
+			continue
+		}
+		var got []string
+		for _, f := range found {
+			end := f.Offset
+			for end < len(test.src) && isWordByte(test.src[end]) {
+				end++
+			}
+			got = append(got, test.src[f.Offset:end]+": "+f.What)
+		}
+		if strings.Join(got, "; ") != strings.Join(test.want, "; ") {
+			t.Errorf("FindDynamicCode(%q) = %q, want %q", test.src, got, test.want)
+		}
+	}
+}
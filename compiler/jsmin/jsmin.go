@@ -0,0 +1,430 @@
+// Package jsmin minifies JavaScript code. It removes comments and whitespace
+// and, in code that sticks to ES5, gives local variables short names.
+package jsmin
+
+import (
+	"bytes"
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+// A Mapping relates a token of the minified code to its offset in the source.
+type Mapping struct {
+	Generated int
+	Original  int
+}
+
+// Minify returns src without comments, except license comments, and with as
+// little whitespace as keeps its meaning.
+func Minify(src []byte) ([]byte, error) {
+	out, _, err := MinifyWithMappings(src)
+	return out, err
+}
+
+// MinifyWithMappings is like Minify, but also returns a mapping for the first
+// token of each line of src.
+func MinifyWithMappings(src []byte) ([]byte, []Mapping, error) {
+	toks, err := tokenize(src)
+	if err != nil {
+		return nil, nil, err
+	}
+	out, mappings := print(toks, rename(toks))
+	return out, mappings, nil
+}
+
+type kind int
+
+const (
+	eof kind = iota
+	word
+	number
+	str
+	template // a template literal, or a piece of one next to a substitution
+	regexp
+	punct
+)
+
+type token struct {
+	kind   kind
+	text   []byte
+	offset int
+	// newline tells whether there is a line terminator before the token.
+	newline bool
+	// comments are license comments on the lines before the token.
+	comments [][]byte
+	// endsStatementHead tells whether the token is the ")" after the
+	// condition of an if, for, while or with statement or the "}" of a block,
+	// after which a slash starts a regular expression.
+	endsStatementHead bool
+}
+
+func (t *token) is(s string) bool {
+	return (t.kind == punct || t.kind == word) && string(t.text) == s
+}
+
+// opensTemplate reports whether t is a piece of a template literal followed by
+// a substitution.
+func (t *token) opensTemplate() bool {
+	return t.kind == template && bytes.HasSuffix(t.text, []byte("${"))
+}
+
+// expressionKeywords may be followed by an expression.
+var expressionKeywords = map[string]bool{
+	"await": true, "case": true, "delete": true, "do": true, "else": true,
+	"in": true, "instanceof": true, "new": true, "of": true, "return": true,
+	"throw": true, "typeof": true, "void": true, "yield": true,
+}
+
+// endsExpression reports whether t may be the last token of an expression.
+func (t *token) endsExpression() bool {
+	switch t.kind {
+	case word:
+		return !expressionKeywords[string(t.text)]
+	case number, str, regexp:
+		return true
+	case template:
+		return !t.opensTemplate()
+	case punct:
+		return t.is(")") || t.is("]") || t.is("}") || t.is("++") || t.is("--")
+	}
+	return false
+}
+
+// regexpAllowed reports whether a slash after t starts a regular expression
+// rather than being the division operator.
+func regexpAllowed(t *token) bool {
+	switch t.kind {
+	case eof:
+		return true
+	case word:
+		return expressionKeywords[string(t.text)]
+	case template:
+		return t.opensTemplate()
+	case punct:
+		if t.is(")") || t.is("}") {
+			return t.endsStatementHead
+		}
+		return !t.is("]") && !t.is("++") && !t.is("--")
+	}
+	return false
+}
+
+// statementKeywords are followed by a parenthesized head and a statement.
+var statementKeywords = map[string]bool{
+	"for": true, "if": true, "while": true, "with": true,
+}
+
+// opensBlock reports whether a "{" after t opens a block rather than an object
+// literal.
+func opensBlock(t *token) bool {
+	switch t.kind {
+	case eof:
+		return true
+	case word:
+		return !expressionKeywords[string(t.text)] || t.is("do") || t.is("else")
+	case punct:
+		return t.is(")") || t.is(";") || t.is("{") || t.is("}") || t.is("=>")
+	}
+	return false
+}
+
+var punctuators = []string{
+	">>>=", "...", "===", "!==", "**=", "<<=", ">>=", ">>>", "&&=", "||=", "??=",
+	"=>", "==", "!=", "<=", ">=", "&&", "||", "??", "?.", "++", "--", "+=", "-=",
+	"*=", "/=", "%=", "&=", "|=", "^=", "<<", ">>", "**",
+}
+
+func isWordByte(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '$' || c == '\\' || c >= utf8.RuneSelf
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// space returns the length of the white space or line terminator at the start
+// of b, or 0.
+func space(b []byte) (n int, newline bool) {
+	switch b[0] {
+	case '\n', '\r':
+		return 1, true
+	case ' ', '\t', '\v', '\f':
+		return 1, false
+	}
+	if b[0] < utf8.RuneSelf {
+		return 0, false
+	}
+	r, n := utf8.DecodeRune(b)
+	switch {
+	case r == '\u2028' || r == '\u2029':
+		return n, true
+	case unicode.IsSpace(r) || r == '\ufeff':
+		return n, false
+	}
+	return 0, false
+}
+
+type syntaxError struct {
+	src    []byte
+	offset int
+	msg    string
+}
+
+func (e *syntaxError) Error() string {
+	line := bytes.Count(e.src[:e.offset], []byte("\n")) + 1
+	return fmt.Sprintf("line %d: %s", line, e.msg)
+}
+
+func tokenize(src []byte) ([]token, error) {
+	var toks []token
+	prev := &token{kind: eof}
+	// templates holds for each template literal whose substitution is being
+	// scanned the number of open braces outside of it.
+	var templates []int
+	braces := 0
+	// parens and blocks tell for each open parenthesis and brace whether it
+	// starts a statement head and a block, respectively.
+	var parens, blocks []bool
+	newline := false
+	var comments [][]byte
+	fail := func(offset int, msg string) ([]token, error) {
+		return nil, &syntaxError{src, offset, msg}
+	}
+
+	for i := 0; i < len(src); {
+		if n, nl := space(src[i:]); n != 0 {
+			newline = newline || nl
+			i += n
+			continue
+		}
+		if bytes.HasPrefix(src[i:], []byte("//")) {
+			for i < len(src) && src[i] != '\n' && src[i] != '\r' {
+				i++
+			}
+			continue
+		}
+		if bytes.HasPrefix(src[i:], []byte("/*")) {
+			n := bytes.Index(src[i+2:], []byte("*/"))
+			if n == -1 {
+				return fail(i, "comment not terminated")
+			}
+			comment := src[i : i+n+4]
+			// A comment on a line of its own is kept if it is a license.
+			if (newline || len(toks) == 0) && (bytes.HasPrefix(comment, []byte("/*!")) || bytes.Contains(comment, []byte("@license")) || bytes.Contains(comment, []byte("@preserve"))) {
+				comments = append(comments, comment)
+				newline = true
+			}
+			if bytes.ContainsAny(comment, "\n\r\u2028\u2029") {
+				newline = true
+			}
+			i += n + 4
+			continue
+		}
+
+		t := token{offset: i, newline: newline, comments: comments}
+		start := i
+		c := src[i]
+		switch {
+		case isDigit(c) || c == '.' && i+1 < len(src) && isDigit(src[i+1]):
+			t.kind = number
+			hex := bytes.HasPrefix(src[i:], []byte("0x")) || bytes.HasPrefix(src[i:], []byte("0X"))
+			for i++; i < len(src); i++ {
+				c := src[i]
+				if !isWordByte(c) && c != '.' && !((c == '+' || c == '-') && !hex && (src[i-1] == 'e' || src[i-1] == 'E')) {
+					break
+				}
+			}
+		case isWordByte(c):
+			t.kind = word
+			for i < len(src) {
+				if n, _ := space(src[i:]); n != 0 || !isWordByte(src[i]) {
+					break
+				}
+				i++
+			}
+		case c == '"' || c == '\'':
+			t.kind = str
+			for i++; ; i++ {
+				if i >= len(src) || src[i] == '\n' || src[i] == '\r' {
+					return fail(start, "string literal not terminated")
+				}
+				if src[i] == '\\' {
+					i++
+					if bytes.HasPrefix(src[i:], []byte("\r\n")) {
+						i++
+					}
+					continue
+				}
+				if src[i] == c {
+					i++
+					break
+				}
+			}
+		case c == '`' || c == '}' && len(templates) != 0 && templates[len(templates)-1] == braces:
+			t.kind = template
+			if c == '}' {
+				templates = templates[:len(templates)-1]
+			}
+			for i++; ; i++ {
+				if i >= len(src) {
+					return fail(start, "template literal not terminated")
+				}
+				if src[i] == '\\' {
+					i++
+					continue
+				}
+				if src[i] == '`' {
+					i++
+					break
+				}
+				if bytes.HasPrefix(src[i:], []byte("${")) {
+					i += 2
+					templates = append(templates, braces)
+					break
+				}
+			}
+		case c == '/' && regexpAllowed(prev):
+			t.kind = regexp
+			inClass := false
+			for i++; ; i++ {
+				if i >= len(src) || src[i] == '\n' || src[i] == '\r' {
+					return fail(start, "regular expression not terminated")
+				}
+				if src[i] == '\\' {
+					i++
+					continue
+				}
+				if src[i] == '[' {
+					inClass = true
+				}
+				if src[i] == ']' {
+					inClass = false
+				}
+				if src[i] == '/' && !inClass {
+					i++
+					break
+				}
+			}
+			for i < len(src) && isWordByte(src[i]) {
+				i++
+			}
+		default:
+			t.kind = punct
+			i++
+			for _, p := range punctuators {
+				if bytes.HasPrefix(src[start:], []byte(p)) {
+					i = start + len(p)
+					break
+				}
+			}
+			switch c {
+			case '(':
+				parens = append(parens, prev.kind == word && statementKeywords[string(prev.text)])
+			case ')':
+				if n := len(parens); n != 0 {
+					t.endsStatementHead = parens[n-1]
+					parens = parens[:n-1]
+				}
+			case '{':
+				braces++
+				blocks = append(blocks, opensBlock(prev))
+			case '}':
+				braces--
+				if n := len(blocks); n != 0 {
+					t.endsStatementHead = blocks[n-1]
+					blocks = blocks[:n-1]
+				}
+			}
+		}
+		t.text = src[start:i]
+		toks = append(toks, t)
+		prev = &toks[len(toks)-1]
+		newline = false
+		comments = nil
+	}
+	if len(templates) != 0 {
+		return fail(len(src), "template literal not terminated")
+	}
+	toks = append(toks, token{kind: eof, offset: len(src), newline: newline, comments: comments})
+	return toks, nil
+}
+
+// joinsNext reports whether a line break between prev and next may be dropped,
+// that is, whether no semicolon is inserted there.
+func joinsNext(prev, next *token) bool {
+	switch {
+	case prev.kind == eof || prev.opensTemplate():
+		return true
+	case prev.kind == punct && !prev.endsExpression():
+		return true
+	case next.kind == template:
+		return next.text[0] == '}'
+	case next.kind != punct || next.is("++") || next.is("--"):
+		return false
+	}
+	switch next.text[0] {
+	case ')', ']', '}', ',', ';', '.', '?', ':', '=', '&', '|', '^', '*', '%', '<', '>':
+		return true
+	}
+	return next.is("!=") || next.is("!==")
+}
+
+// needsSpace reports whether the tokens prev and next would run together
+// without a space between them.
+func needsSpace(prev, next *token, prevText, nextText []byte) bool {
+	p, c := prevText[len(prevText)-1], nextText[0]
+	switch {
+	case isWordByte(p) && (isWordByte(c) || next.kind == number):
+		return true
+	case prev.kind == number && c == '.':
+		return true
+	case (p == '+' || p == '-') && c == p:
+		return true
+	case p == '/' && (c == '/' || c == '*'):
+		return true
+	case p == '<' && c == '!', p == '-' && c == '>':
+		// Avoid HTML-like comments.
+		return true
+	}
+	return false
+}
+
+func print(toks []token, names map[int][]byte) ([]byte, []Mapping) {
+	var out []byte
+	var mappings []Mapping
+	var prev *token
+	var prevText []byte
+	for i := range toks {
+		t := &toks[i]
+		for _, comment := range t.comments {
+			if len(out) != 0 {
+				out = append(out, '\n')
+			}
+			out = append(out, comment...)
+			out = append(out, '\n')
+			prev = nil
+		}
+		if t.kind == eof {
+			break
+		}
+		text := t.text
+		if name, ok := names[i]; ok {
+			text = name
+		}
+		if prev != nil {
+			switch {
+			case t.newline && !joinsNext(prev, t):
+				out = append(out, '\n')
+			case needsSpace(prev, t, prevText, text):
+				out = append(out, ' ')
+			}
+		}
+		if t.newline || i == 0 {
+			mappings = append(mappings, Mapping{Generated: len(out), Original: t.offset})
+		}
+		out = append(out, text...)
+		prev, prevText = t, text
+	}
+	return out, mappings
+}
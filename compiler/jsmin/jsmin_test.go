@@ -0,0 +1,159 @@
+package jsmin
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/parser"
+	gotoken "go/token"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMinify(t *testing.T) {
+	for _, test := range []struct{ src, want string }{
+		// Renaming.
+		{"function f(longName, other) { var local = longName + other; return local; }", "function f(b,c){var a=b+c;return a;}"},
+		{"function f(value) { var o = { value: value, key: 1 }; return o.value + o.key; }", "function f(b){var a={value:b,key:1};return a.value+a.key;}"},
+		{"function f() { try { g(); } catch (err) { return err; } var err2 = 1; return err2; }", "function f(){try{g();}catch(a){return a;}var a=1;return a;}"},
+		{"function f() { outer: for (var i = 0; i < 1; i++) { continue outer; } }", "function f(){outer:for(var a=0;a<1;a++){continue outer;}}"},
+
+		// Regular expressions and division.
+		{"function f(a, b, g) { return a\n/b/g; }", "function f(a,b,c){return a\n/b/c;}"},
+		{"function f(x) { var y = (x) / 2 / x; return y; }", "function f(a){var b=(a)/2/a;return b;}"},
+		{"function f(x) { var y = {} / x; return y; }", "function f(a){var b={}/a;return b;}"},
+		{"function f(x) { return {a: x}\n/x/g; }", "function f(a){return{a:a}\n/a/g;}"},
+		{"function f(x) { if (x) /x/.test(x); }", "function f(a){if(a)/x/.test(a);}"},
+		{"function f(x) { while (g(x)) /x/.test(x); }", "function f(a){while(g(a))/x/.test(a);}"},
+		{"function f(x) { {}\n/x/.test(x); }", "function f(a){{}\n/x/.test(a);}"},
+		{"function f(x) { return typeof /x/ + x; }", "function f(a){return typeof/x/+a;}"},
+		{"var r = /[/]\\/x/g;", "var r=/[/]\\/x/g;"},
+
+		// Line breaks where a semicolon would be inserted.
+		{"return\nx", "return\nx"},
+		{"a\n++b", "a\n++b"},
+		{"throw\nx", "throw\nx"},
+		{"x\n--\ny", "x\n--\ny"},
+		{"var i = 1 - -1, j = 1 + +1, k = a++ + ++b;", "var i=1- -1,j=1+ +1,k=a++ + ++b;"},
+
+		// Comments.
+		{"/*! license */\nvar x = 1; // comment\n/* block */ var y = 2;", "/*! license */\nvar x=1;var y=2;"},
+
+		// Features rename doesn't know keep their names.
+		{"function f(a) { let b = a; return b; }", "function f(a){let b=a;return b;}"},
+		{"function f(a) { return x => x + a; }", "function f(a){return x=>x+a;}"},
+		{"function f(a) { return { get x() { return a; } }; }", "function f(a){return{get x(){return a;}};}"},
+		{"function f(a) { return eval(\"a\"); }", "function f(a){return eval(\"a\");}"},
+		{"function f(a) { return `${a}`; }", "function f(a){return`${a}`;}"},
+	} {
+		got, err := Minify([]byte(test.src))
+		if err != nil {
+			t.Errorf("Minify(%q): %v", test.src, err)
+			continue
+		}
+		if string(got) != test.want {
+			t.Errorf("Minify(%q) = %q, want %q", test.src, got, test.want)
+		}
+	}
+}
+
+func TestMinifyErrors(t *testing.T) {
+	for _, test := range []struct{ src, want string }{
+		{"var s = 'abc\n';", "line 1: string literal not terminated"},
+		{"var r = /abc\n/;", "line 1: regular expression not terminated"},
+		{"x;\n/* abc", "line 2: comment not terminated"},
+		{"`${a}", "line 1: template literal not terminated"},
+	} {
+		if _, err := Minify([]byte(test.src)); err == nil || err.Error() != test.want {
+			t.Errorf("Minify(%q): got error %v, want %q", test.src, err, test.want)
+		}
+	}
+}
+
+// prelude evaluates the constant Prelude of package prelude, which imports
+// this package and so can't be imported by its tests.
+func prelude(t *testing.T) []byte {
+	fset := gotoken.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, filepath.Join("..", "prelude"), nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	consts := map[string]ast.Expr{}
+	for _, f := range pkgs["prelude"].Files {
+		for _, decl := range f.Decls {
+			if d, ok := decl.(*ast.GenDecl); ok && d.Tok == gotoken.CONST {
+				for _, spec := range d.Specs {
+					s := spec.(*ast.ValueSpec)
+					for i, name := range s.Names {
+						consts[name.Name] = s.Values[i]
+					}
+				}
+			}
+		}
+	}
+	var eval func(e ast.Expr) string
+	eval = func(e ast.Expr) string {
+		switch e := e.(type) {
+		case *ast.BinaryExpr:
+			return eval(e.X) + eval(e.Y)
+		case *ast.Ident:
+			return eval(consts[e.Name])
+		case *ast.BasicLit:
+			return constant.StringVal(constant.MakeFromLiteral(e.Value, e.Kind, 0))
+		}
+		t.Fatalf("unexpected expression %T in Prelude", e)
+		return ""
+	}
+	return []byte(eval(consts["Prelude"]))
+}
+
+// The minified prelude has the tokens of the prelude, only with other names for
+// variables.
+func TestPreludeEquivalence(t *testing.T) {
+	src := prelude(t)
+	min, err := Minify(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srcToks, err := tokenize(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	minToks, err := tokenize(min)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(srcToks) != len(minToks) {
+		t.Fatalf("got %d tokens, want %d", len(minToks), len(srcToks))
+	}
+	line := func(offset int) int { return strings.Count(string(src[:offset]), "\n") + 1 }
+	for i, s := range srcToks {
+		m := minToks[i]
+		if s.kind != m.kind {
+			t.Fatalf("line %d: %q became %q", line(s.offset), s.text, m.text)
+		}
+		renamed := s.kind == word && !keywords[string(s.text)] && (i == 0 || !srcToks[i-1].is("."))
+		if !renamed && string(s.text) != string(m.text) {
+			t.Fatalf("line %d: %q became %q", line(s.offset), s.text, m.text)
+		}
+	}
+
+	if _, err := exec.LookPath("node"); err != nil {
+		t.Skip("node not found")
+	}
+	dir, err := ioutil.TempDir("", "jsmin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	f := filepath.Join(dir, "prelude.js")
+	if err := ioutil.WriteFile(f, min, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if out, err := exec.Command("node", "--check", f).CombinedOutput(); err != nil {
+		t.Errorf("node --check: %v\n%s", err, out)
+	}
+}
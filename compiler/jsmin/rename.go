@@ -0,0 +1,426 @@
+package jsmin
+
+import (
+	"sort"
+)
+
+// keywords can't be used as variable names.
+var keywords = map[string]bool{
+	"break": true, "case": true, "catch": true, "class": true, "const": true,
+	"continue": true, "debugger": true, "default": true, "delete": true,
+	"do": true, "else": true, "enum": true, "export": true, "extends": true,
+	"false": true, "finally": true, "for": true, "function": true, "if": true,
+	"implements": true, "import": true, "in": true, "instanceof": true,
+	"interface": true, "let": true, "new": true, "null": true, "package": true,
+	"private": true, "protected": true, "public": true, "return": true,
+	"static": true, "super": true, "switch": true, "this": true, "throw": true,
+	"true": true, "try": true, "typeof": true, "var": true, "void": true,
+	"while": true, "with": true, "yield": true,
+}
+
+// unsupported are the words of language features whose scoping rules rename
+// doesn't know. Code using them keeps its names.
+var unsupported = map[string]bool{
+	"async": true, "await": true, "class": true, "const": true, "eval": true,
+	"export": true, "import": true, "let": true, "super": true, "with": true,
+	"yield": true,
+}
+
+type scope struct {
+	parent *scope
+	// function is set for function scopes, which var declarations belong to,
+	// and unset for the scope of a catch clause.
+	function bool
+	// decls counts the references to each variable declared in the scope.
+	decls map[string]int
+	// uses are the variables of enclosing scopes referenced in the scope.
+	uses  map[binding]bool
+	names map[string][]byte
+}
+
+// binding is a variable declared in a scope, or a global one if s is nil.
+type binding struct {
+	s    *scope
+	name string
+}
+
+func (s *scope) declare(name string) {
+	if _, ok := s.decls[name]; !ok {
+		s.decls[name] = 0
+	}
+}
+
+func (s *scope) varScope() *scope {
+	for !s.function {
+		s = s.parent
+	}
+	return s
+}
+
+type bracket struct {
+	open   byte
+	object bool
+	// outer is the scope to return to when the bracket closes, if it encloses
+	// the body of a function or catch clause.
+	outer *scope
+}
+
+type varStmt struct {
+	depth      int
+	expectName bool
+}
+
+type renamer struct {
+	toks   []token
+	root   *scope
+	scopes []*scope // in the order they start
+	// tokScopes holds the scope of each token.
+	tokScopes []*scope
+	// notVar marks the words that aren't variables: property names, object
+	// keys and labels.
+	notVar []bool
+	// blockColons marks the colons that precede a statement.
+	blockColons map[int]bool
+	stack       []bracket
+}
+
+// rename returns short names for the local variables in toks, by token
+// index. It returns nil if the code uses features it doesn't know.
+func rename(toks []token) map[int][]byte {
+	r := &renamer{
+		toks:        toks,
+		tokScopes:   make([]*scope, len(toks)),
+		notVar:      make([]bool, len(toks)),
+		blockColons: make(map[int]bool),
+	}
+	r.root = r.newScope(nil, true)
+	if !r.analyze() {
+		return nil
+	}
+
+	refs := make(map[int]binding)
+	for i := range toks {
+		name := string(toks[i].text)
+		if toks[i].kind != word || r.notVar[i] || keywords[name] || name == "arguments" {
+			continue
+		}
+		s := r.tokScopes[i]
+		d := s
+		for d != nil {
+			if _, ok := d.decls[name]; ok {
+				break
+			}
+			d = d.parent
+		}
+		if d == r.root {
+			d = nil
+		}
+		b := binding{d, name}
+		for u := s; u != d && u != r.root; u = u.parent {
+			u.uses[b] = true
+		}
+		if d != nil {
+			d.decls[name]++
+			refs[i] = b
+		}
+	}
+
+	// Enclosing scopes are named first, so that nested ones can avoid the
+	// names of the variables they use.
+	for _, s := range r.scopes[1:] {
+		taken := make(map[string]bool)
+		for b := range s.uses {
+			if b.s == nil {
+				taken[b.name] = true
+				continue
+			}
+			taken[string(b.s.names[b.name])] = true
+		}
+		var decls []string
+		for name := range s.decls {
+			decls = append(decls, name)
+		}
+		sort.Slice(decls, func(i, j int) bool {
+			if s.decls[decls[i]] != s.decls[decls[j]] {
+				return s.decls[decls[i]] > s.decls[decls[j]]
+			}
+			return decls[i] < decls[j]
+		})
+		n := 0
+		for _, name := range decls {
+			short := shortName(n)
+			for keywords[short] || taken[short] {
+				n++
+				short = shortName(n)
+			}
+			n++
+			s.names[name] = []byte(short)
+		}
+	}
+
+	names := make(map[int][]byte)
+	for i, b := range refs {
+		names[i] = b.s.names[b.name]
+	}
+	return names
+}
+
+func (r *renamer) newScope(parent *scope, function bool) *scope {
+	s := &scope{
+		parent:   parent,
+		function: function,
+		decls:    make(map[string]int),
+		uses:     make(map[binding]bool),
+		names:    make(map[string][]byte),
+	}
+	r.scopes = append(r.scopes, s)
+	return s
+}
+
+var eofToken = &token{kind: eof}
+
+func (r *renamer) prev(i int) *token {
+	if i == 0 {
+		return eofToken
+	}
+	return &r.toks[i-1]
+}
+
+// analyze finds the scopes and their declarations, and the words that aren't
+// variables. It reports whether the code is understood.
+func (r *renamer) analyze() bool {
+	toks := r.toks
+	cur := r.root
+	var vars []varStmt
+	var cases []int
+	expectKey := false
+
+	for i := 0; i < len(toks)-1; i++ {
+		t, next := &toks[i], &toks[i+1]
+		r.tokScopes[i] = cur
+		if r.notVar[i] {
+			continue
+		}
+		text := string(t.text)
+		if expectKey {
+			expectKey = false
+			if (t.kind == word || t.kind == str || t.kind == number) && next.is(":") {
+				r.notVar[i] = true
+				r.tokScopes[i+1] = cur
+				i++
+				continue
+			}
+			if !t.is("}") {
+				return false // e.g. a shorthand property, method or getter
+			}
+		}
+		if t.kind == template || t.is("=>") || t.is("...") || t.kind == word && unsupported[text] {
+			return false
+		}
+
+		if n := len(vars); n != 0 && vars[n-1].depth == len(r.stack) {
+			v := &vars[n-1]
+			switch {
+			case v.expectName:
+				if t.kind != word {
+					return false // a destructuring pattern
+				}
+				cur.varScope().declare(text)
+				v.expectName = false
+				continue
+			case t.is(","):
+				v.expectName = true
+				continue
+			case t.is(";") || t.is("in") || t.is("of") || t.newline && r.prev(i).endsExpression() && beginsOperand(t):
+				vars = vars[:n-1]
+			}
+		}
+
+		switch {
+		case t.is("function"):
+			s, j, ok := r.function(i, cur)
+			if !ok {
+				return false
+			}
+			r.stack = append(r.stack, bracket{open: '{', outer: cur})
+			cur = s
+			i = j
+		case t.is("catch") && next.is("("):
+			if i+4 >= len(toks) || toks[i+2].kind != word || !toks[i+3].is(")") || !toks[i+4].is("{") {
+				return false
+			}
+			s := r.newScope(cur, false)
+			s.declare(string(toks[i+2].text))
+			for j := i + 1; j <= i+4; j++ {
+				r.tokScopes[j] = s
+			}
+			r.stack = append(r.stack, bracket{open: '{', outer: cur})
+			cur = s
+			i += 4
+		case t.is("var"):
+			vars = append(vars, varStmt{depth: len(r.stack), expectName: true})
+		case t.is("case") || t.is("default"):
+			cases = append(cases, len(r.stack))
+		case t.is(":"):
+			if n := len(cases); n != 0 && cases[n-1] == len(r.stack) {
+				r.blockColons[i] = true
+				cases = cases[:n-1]
+			}
+		case t.kind == word && next.is(":") && r.statementStart(i):
+			r.notVar[i] = true // a label
+			r.blockColons[i+1] = true
+		case (t.is("break") || t.is("continue")) && next.kind == word && !next.newline:
+			r.notVar[i+1] = true
+		case (t.is(".") || t.is("?.")) && next.kind == word:
+			r.notVar[i+1] = true
+		case t.is("(") || t.is("["):
+			r.stack = append(r.stack, bracket{open: t.text[0]})
+		case t.is("{"):
+			object := r.objectStart(i)
+			r.stack = append(r.stack, bracket{open: '{', object: object})
+			expectKey = object
+		case t.is(")") || t.is("]") || t.is("}"):
+			n := len(r.stack)
+			if n == 0 || !matches(r.stack[n-1].open, t.text[0]) {
+				return false
+			}
+			if outer := r.stack[n-1].outer; outer != nil {
+				cur = outer
+			}
+			r.stack = r.stack[:n-1]
+			for len(vars) != 0 && vars[len(vars)-1].depth > len(r.stack) {
+				vars = vars[:len(vars)-1]
+			}
+			for len(cases) != 0 && cases[len(cases)-1] > len(r.stack) {
+				cases = cases[:len(cases)-1]
+			}
+		case t.is(",") && len(r.stack) != 0 && r.stack[len(r.stack)-1].object:
+			expectKey = true
+		}
+	}
+	return len(r.stack) == 0
+}
+
+// function declares the name and parameters of the function starting at
+// token i. It returns the scope of the function and the index of the brace
+// its body starts with.
+func (r *renamer) function(i int, cur *scope) (*scope, int, bool) {
+	toks := r.toks
+	prev := r.prev(i)
+	if toks[i+1].is("*") || prev.is(")") || prev.is("else") || prev.is("do") {
+		return nil, 0, false // a generator, or a declaration in an if or loop statement
+	}
+	s := r.newScope(cur, true)
+	j := i + 1
+	if toks[j].kind == word {
+		if r.statementStart(i) {
+			if n := len(r.stack); n != 0 && r.stack[n-1].outer == nil || !cur.function {
+				return nil, 0, false // a declaration in a block
+			}
+			cur.varScope().declare(string(toks[j].text))
+			r.tokScopes[j] = cur
+		} else {
+			s.declare(string(toks[j].text))
+			r.tokScopes[j] = s
+		}
+		j++
+	}
+	if !toks[j].is("(") {
+		return nil, 0, false
+	}
+	r.tokScopes[j] = s
+	j++
+	for !toks[j].is(")") {
+		if toks[j].kind != word {
+			return nil, 0, false // a default value or a destructuring pattern
+		}
+		s.declare(string(toks[j].text))
+		r.tokScopes[j] = s
+		j++
+		if toks[j].is(",") {
+			r.tokScopes[j] = s
+			j++
+		} else if !toks[j].is(")") {
+			return nil, 0, false
+		}
+	}
+	r.tokScopes[j] = s
+	j++
+	if !toks[j].is("{") {
+		return nil, 0, false
+	}
+	r.tokScopes[j] = s
+	return s, j, true
+}
+
+// statementStart reports whether token i starts a statement.
+func (r *renamer) statementStart(i int) bool {
+	prev := r.prev(i)
+	switch {
+	case prev.kind == eof || prev.is(";") || prev.is("}"):
+		return true
+	case prev.is("{"):
+		return !r.stack[len(r.stack)-1].object
+	case prev.is(":"):
+		return r.blockColons[i-1]
+	}
+	return r.toks[i].newline && prev.endsExpression()
+}
+
+// objectStart reports whether the brace at token i starts an object literal
+// rather than a block.
+func (r *renamer) objectStart(i int) bool {
+	prev := r.prev(i)
+	switch prev.kind {
+	case punct:
+		switch {
+		case prev.is(":"):
+			return !r.blockColons[i-1]
+		case prev.is("{"), prev.is(";"), prev.endsExpression():
+			return false
+		}
+		return true
+	case word:
+		return expressionKeywords[string(prev.text)] && !prev.is("do") && !prev.is("else")
+	}
+	return false
+}
+
+func matches(open, close byte) bool {
+	switch open {
+	case '(':
+		return close == ')'
+	case '[':
+		return close == ']'
+	}
+	return close == '}'
+}
+
+// beginsOperand reports whether t can start an expression, but not continue
+// one.
+func beginsOperand(t *token) bool {
+	switch t.kind {
+	case word:
+		return !t.is("in") && !t.is("instanceof") && !t.is("of")
+	case number, str, regexp, template:
+		return true
+	case punct:
+		return t.is("{") || t.is("!") || t.is("~") || t.is("++") || t.is("--")
+	}
+	return false
+}
+
+const (
+	nameStart = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ$_"
+	namePart  = nameStart + "0123456789"
+)
+
+// shortName returns the i-th name of the sequence a, b, ..., _, a0, ...
+func shortName(i int) string {
+	name := []byte{nameStart[i%len(nameStart)]}
+	for i /= len(nameStart); i > 0; i /= len(namePart) {
+		i--
+		name = append(name, namePart[i%len(namePart)])
+	}
+	return string(name)
+}
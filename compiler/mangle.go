@@ -0,0 +1,149 @@
+package compiler
+
+import (
+	"bytes"
+)
+
+// pinnedPackages are looked up in $packages by the prelude and the runtime, so
+// their keys are never shortened.
+var pinnedPackages = map[string]bool{
+	"runtime":                         true,
+	"time":                            true,
+	"github.com/gopherjs/gopherjs/js": true,
+}
+
+// mangler shortens names in minified programs that only the generated code
+// refers to: the keys of $packages and, if the program doesn't use the reflect
+// package, the names of named types. It rewrites code right before it is
+// written, so the positions recorded for the source map remain correct.
+type mangler struct {
+	packages  map[string]string
+	typeNames map[string]string // nil if type names are kept
+}
+
+func newMangler(pkgs []*Archive) *mangler {
+	m := &mangler{packages: make(map[string]string)}
+	hasIncJS := false
+	usesReflect := false
+	for _, pkg := range pkgs {
+		if len(pkg.IncJSCode) != 0 {
+			hasIncJS = true
+		}
+		if pkg.ImportPath == "reflect" {
+			usesReflect = true
+		}
+	}
+	if !usesReflect {
+		m.typeNames = make(map[string]string)
+	}
+	if hasIncJS {
+		// JavaScript code may refer to packages by their path.
+		return m
+	}
+
+	i := 0
+	for _, pkg := range pkgs {
+		if pinnedPackages[pkg.ImportPath] {
+			continue
+		}
+		key := shortIdent(i, false)
+		for pinnedPackages[key] {
+			i++
+			key = shortIdent(i, false)
+		}
+		m.packages[pkg.ImportPath] = key
+		i++
+	}
+	return m
+}
+
+var (
+	packagesPrefix = []byte(`$packages["`)
+	newTypePrefix  = []byte(`$newType(`)
+)
+
+// rewrite returns code with the names shortened.
+func (m *mangler) rewrite(code []byte) []byte {
+	if m == nil {
+		return code
+	}
+	var out []byte
+	for {
+		i := bytes.Index(code, packagesPrefix)
+		j := -1
+		if m.typeNames != nil {
+			j = bytes.Index(code, newTypePrefix)
+		}
+		if i == -1 && j == -1 {
+			break
+		}
+		if i == -1 || j != -1 && j < i {
+			start, end, ok := typeNameString(code[j+len(newTypePrefix):])
+			if !ok {
+				out = append(out, code[:j+len(newTypePrefix)]...)
+				code = code[j+len(newTypePrefix):]
+				continue
+			}
+			start += j + len(newTypePrefix)
+			end += j + len(newTypePrefix)
+			out = append(out, code[:start]...)
+			out = append(out, m.typeName(string(code[start:end]))...)
+			code = code[end:]
+			continue
+		}
+
+		start := i + len(packagesPrefix)
+		end := bytes.IndexByte(code[start:], '"')
+		if end == -1 {
+			break
+		}
+		end += start
+		out = append(out, code[:start]...)
+		if key, ok := m.packages[string(code[start:end])]; ok {
+			out = append(out, key...)
+		} else {
+			out = append(out, code[start:end]...)
+		}
+		code = code[end:]
+	}
+	if out == nil {
+		return code
+	}
+	return append(out, code...)
+}
+
+// typeNameString finds the name in the arguments of a $newType call for a
+// named type, e.g. `0,$kindStruct,"main.T",true,`, which is how
+// minified type declarations start.
+func typeNameString(args []byte) (start, end int, ok bool) {
+	i := 0
+	for i < len(args) && args[i] >= '0' && args[i] <= '9' {
+		i++
+	}
+	if i == 0 || !bytes.HasPrefix(args[i:], []byte(",$kind")) {
+		return 0, 0, false
+	}
+	i += len(",$kind")
+	for i < len(args) && (args[i] >= 'a' && args[i] <= 'z' || args[i] >= 'A' && args[i] <= 'Z' || args[i] >= '0' && args[i] <= '9') {
+		i++
+	}
+	if !bytes.HasPrefix(args[i:], []byte(`,"`)) {
+		return 0, 0, false
+	}
+	start = i + len(`,"`)
+	n := bytes.IndexByte(args[start:], '"')
+	if n == -1 || !bytes.HasPrefix(args[start+n:], []byte(`",true,`)) {
+		return 0, 0, false
+	}
+	return start, start + n, true
+}
+
+func (m *mangler) typeName(name string) string {
+	short, ok := m.typeNames[name]
+	if !ok {
+		// Upper case names can't be mistaken for predeclared types.
+		short = shortIdent(len(m.typeNames), true)
+		m.typeNames[name] = short
+	}
+	return short
+}
@@ -0,0 +1,64 @@
+package compiler
+
+import "testing"
+
+func archives(paths ...string) []*Archive {
+	var pkgs []*Archive
+	for _, path := range paths {
+		pkgs = append(pkgs, &Archive{ImportPath: path})
+	}
+	return pkgs
+}
+
+func TestManglerPackages(t *testing.T) {
+	m := newMangler(archives("runtime", "example.com/a", "time", "example.com/b", "main"))
+	for _, test := range []struct{ code, want string }{
+		{`$packages["example.com/a"]`, `$packages["a"]`},
+		{`x=$packages["example.com/b"];y=$packages["main"];`, `x=$packages["b"];y=$packages["c"];`},
+		{`$packages["runtime"].$init();$packages["time"]`, `$packages["runtime"].$init();$packages["time"]`},
+		{`$packages["example.com/unknown"]`, `$packages["example.com/unknown"]`},
+		{`$packages["unterminated`, `$packages["unterminated`},
+	} {
+		if got := string(m.rewrite([]byte(test.code))); got != test.want {
+			t.Errorf("rewrite(%q) = %q, want %q", test.code, got, test.want)
+		}
+	}
+}
+
+func TestManglerIncJS(t *testing.T) {
+	pkgs := archives("runtime", "example.com/a", "main")
+	pkgs[1].IncJSCode = []byte("$packages[\"example.com/a\"].f();")
+	m := newMangler(pkgs)
+	code := `$packages["example.com/a"]`
+	if got := string(m.rewrite([]byte(code))); got != code {
+		t.Errorf("got %q, want package keys kept for .inc.js files", got)
+	}
+}
+
+func TestManglerPinnedCollision(t *testing.T) {
+	pinnedPackages["b"] = true
+	defer delete(pinnedPackages, "b")
+
+	m := newMangler(archives("example.com/a", "b", "example.com/c"))
+	code := `$packages["example.com/a"];$packages["b"];$packages["example.com/c"]`
+	want := `$packages["a"];$packages["b"];$packages["c"]`
+	if got := string(m.rewrite([]byte(code))); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestManglerTypeNames(t *testing.T) {
+	code := `T=$newType(0,$kindStruct,"main.T",true,"main",true,null);U=$newType(8,$kindInt,"main.U",true,"main",false,null);V=$newType(0,$kindStruct,"main.T",true,"main",true,null);W=$newType(4,$kindInt,"main.W",false,"main",false,null);`
+
+	m := newMangler(archives("main"))
+	want := `T=$newType(0,$kindStruct,"A",true,"main",true,null);U=$newType(8,$kindInt,"B",true,"main",false,null);V=$newType(0,$kindStruct,"A",true,"main",true,null);W=$newType(4,$kindInt,"main.W",false,"main",false,null);`
+	if got := string(m.rewrite([]byte(code))); got != want {
+		t.Errorf("without reflect: got\n%s\nwant\n%s", got, want)
+	}
+
+	// reflect exposes type names through Type.Name and Type.String.
+	m = newMangler(archives("reflect", "main"))
+	if got := string(m.rewrite([]byte(code))); got != code {
+		t.Errorf("with reflect: got\n%s\nwant type names kept", got)
+	}
+}
@@ -424,6 +424,13 @@ var FS = func() http.FileSystem {
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\xcc\xc1\x6a\xc3\x30\x0c\x06\xe0\x73\xf4\x14\x3a\x26\x2c\x90\xbd\xc0\xae\x83\x5d\x76\x18\x7b\x01\xc5\xb1\x8d\x1b\x57\x32\xb2\x0c\x2d\xa5\xef\x5e\x9a\xf6\x50\x42\x05\xba\xfc\xdf\xcf\x3f\x4d\xf8\x31\xb7\x94\x17\x3c\x54\x80\x42\x6e\xa5\xe8\xb1\x71\x3a\x01\x38\xe1\x6a\xd8\x43\xa7\xc4\x8b\x1c\xff\x95\x0a\xee\xee\x0b\x3f\xa1\x0b\xd5\xc8\xc8\xde\xf8\xc6\xd1\xdb\x6e\xa0\x25\xb6\x62\xfa\x60\x27\xe5\xfc\x9d\xb2\xff\x23\x8e\x7e\xab\xbc\xf2\x00\x10\x1a\x3b\xfc\xa9\xbf\xc2\x73\x16\xb7\xf6\x61\xc1\xc4\x36\x60\xcf\xcf\x24\x71\xc4\x59\x24\x8f\xe8\x55\xef\x2f\x3a\xe0\x05\x3a\xf5\xd6\x94\x31\x50\xae\x7e\x44\x4e\x19\xae\x70\x0b\x00\x00\xff\xff\x50\x4d\xd3\x3a\xf0\x00\x00\x00"),
 		},
+		"/src/internal/syscall/unix/vfs_unix.go": &vfsgen۰CompressedFileInfo{
+			name:             "vfs_unix.go",
+			modTime:          time.Date(2026, 10, 18, 21, 17, 6, 917575605, time.UTC),
+			uncompressedSize: 1480,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x92\x41\x6f\xd4\x30\x10\x85\xcf\xf1\xaf\x98\xae\x10\x24\x65\x9b\x20\xb8\x15\xed\xa1\x12\xa5\xea\x01\x55\x6a\x29\xd7\x95\x93\x8c\x13\xef\x3a\x76\xe4\x99\x74\x59\x55\xfd\xef\xc8\xc9\x46\xcd\x0a\xb5\x01\xc1\x2d\xb1\x3d\xef\x7d\x6f\x66\xb2\x0c\xde\xe7\x9d\x36\x25\x6c\x68\x79\xb2\xd3\xb6\x74\x3b\x12\xa2\x95\xc5\x56\x56\x08\x9d\xd5\x3f\x85\xd0\x4d\xeb\x3c\x43\x2c\xa2\x05\xed\xa9\x90\xc6\x2c\x84\x88\x16\x95\xe6\xba\xcb\xd3\xc2\x35\x59\xe5\xda\x1a\xfd\x86\x9e\x3f\x36\xb4\x10\x89\x10\x59\x06\x8e\xd2\x5b\x6c\xdc\x03\x5e\x18\x03\x1d\x21\x01\xd7\x08\xa7\x92\x21\x28\x11\xe4\x68\xdc\x6e\x09\xbb\x5a\x17\x35\x54\x0e\xd8\xf5\x0f\xb4\x3d\x6b\xb0\x71\x7e\x0f\x4a\x1b\x04\xda\x13\x63\xd3\xeb\x29\x18\xf1\x66\x08\xb2\x07\x45\xe0\x6c\x81\xa0\x19\x34\x01\x5a\x99\x1b\x2c\x97\x60\xf4\x16\x83\x49\x90\x1b\x20\x26\xa2\x87\x88\x69\x0f\x1f\xbc\xef\x7a\xeb\x20\x10\xb8\x5a\xe9\x39\x3c\x7f\x91\x11\xb8\x96\xbd\x5d\x47\x58\x42\x8d\x1e\x53\xc1\xfb\x16\x8f\xb4\x2c\xa3\x57\xb2\x40\x78\x14\xd1\x4d\x8b\x56\x72\x5c\x6a\xaf\xca\x70\xb3\x84\x56\x72\x0d\xc4\x5e\xdb\x6a\x09\xca\xc8\x8a\x0e\xe7\xe8\x1b\xe8\xb4\xe5\x4f\x1f\x13\x88\xfb\x23\xf4\xde\xf9\x44\x44\x5f\x89\x25\xbf\xa2\x42\x0c\xa7\x63\xb2\x3b\x96\xbc\xe6\x64\xa8\x15\xd1\xbd\x35\xda\x6e\x5f\xa9\x2d\xb5\x87\xdc\x39\x33\x56\x3c\xf5\xad\x09\xcd\xf5\xc8\x9d\xb7\xf4\x72\x33\x96\xe0\x3c\x58\x6d\x40\xab\x61\x08\xf6\x1d\x8f\x73\x48\x85\xea\x6c\x11\x74\xe2\x64\xda\x9c\x47\x11\x29\x82\xf3\x15\x6c\x28\xbd\x32\x2e\x97\x26\xbd\x42\x8e\x17\x6f\x1e\x14\x2d\x12\x11\x69\x05\x8a\x60\xd5\xdf\xdf\xdb\x12\x95\xb6\x58\x86\xaa\x68\xc0\x09\x7e\x22\x7a\x12\xe3\xaf\xa2\xf4\x7a\xec\x77\x9c\xa4\xf1\xb3\x55\x32\x44\x19\x57\xe6\x7c\x8b\xd8\x9e\x39\xaf\x2b\x6d\xa5\x19\xe8\xfe\xc3\x70\xe0\x71\x64\x3e\x5f\x0d\x69\x3f\x87\x9f\x93\x55\xdf\x98\x09\xb7\xa2\x74\x6a\x37\x58\x1d\x3c\x06\xfd\x64\x9a\x6b\x3d\x72\xaf\x47\xe4\xf5\x6c\xf9\x6c\xde\xf9\x3d\x92\xbf\x6d\xd2\xa4\x0d\x87\x15\xf9\x9b\xc8\x47\x8e\x23\x74\x38\x9b\x0b\xfb\x62\xe1\x81\xe7\x0f\xd2\xce\xae\xfe\xbf\x04\x3b\x16\x3f\x1a\xc7\xdb\x8b\xef\xeb\xdb\xcb\x6f\x37\x3f\x2e\xbf\x5c\xdf\x86\xea\x0f\x73\x61\x5f\x11\x0b\x39\x7f\x0d\x00\x97\xef\xd5\x7e\xc8\x05\x00\x00"),
+		},
 		"/src/internal/testenv": &vfsgen۰DirInfo{
 			name:    "testenv",
 			modTime: time.Date(2020, 10, 13, 23, 35, 11, 0, time.UTC),
@@ -543,10 +550,10 @@ var FS = func() http.FileSystem {
 		},
 		"/src/net/http/fetch.go": &vfsgen۰CompressedFileInfo{
 			name:             "fetch.go",
-			modTime:          time.Date(2020, 10, 13, 23, 35, 11, 0, time.UTC),
-			uncompressedSize: 3551,
+			modTime:          time.Date(2026, 10, 18, 19, 40, 22, 52828245, time.UTC),
+			uncompressedSize: 3345,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x56\x5f\x6f\xdb\x36\x10\x7f\x16\x3f\xc5\x4d\xc3\x3a\x29\xb5\xa5\x16\x28\xfa\xe0\xc5\x0f\xa9\x9b\x76\xc1\xda\xa5\x48\xb2\xa7\x20\x18\x68\xe9\x24\x31\x91\x48\x85\xa4\x92\x18\x81\xbf\xfb\x70\xa4\x24\xcb\x49\xda\x62\x01\xea\x4a\xe2\xf1\xee\x77\x77\xbf\xfb\x93\xa6\xf0\x7a\xdd\x89\x3a\x87\x6b\xc3\x58\xcb\xb3\x1b\x5e\x22\x54\xd6\xb6\x8c\x89\xa6\x55\xda\x42\xc4\x82\x10\xb5\x56\xda\x84\x2c\x08\x8b\xc6\xd2\x7f\x42\xf9\xdf\x54\xa8\xce\x8a\x9a\x5e\x8c\xd5\x99\x92\x77\x21\x63\x41\x58\x0a\x5b\x75\xeb\x24\x53\x4d\x5a\xaa\xb6\x42\x7d\x6d\x76\x0f\xd7\x26\x64\x31\x63\x69\x0a\xc6\x6a\xe4\xcd\x19\xf2\x1c\x35\x88\xa6\xad\xb1\x41\x69\x0d\x70\x09\x42\x25\xf4\x7d\x55\x2b\x83\x1a\xee\x35\x6f\x5b\xd4\x50\x28\x0d\xf4\x99\xaf\x6b\x3c\x77\x97\x41\x15\x0e\xae\x59\xa4\x69\x81\x36\xab\x12\xd3\x62\x96\xdc\x57\xdc\xde\x97\x89\xd2\x65\x9a\x30\xbb\x69\x71\xdf\x96\xb1\xba\xcb\x2c\x3c\xb2\xa0\x45\x99\x0b\x59\xc2\xe5\xd5\x7a\x63\x91\x05\x5e\x0c\xe0\xe0\xda\x24\xa7\xeb\x6b\xcc\x2c\xdb\x32\x56\x74\x32\x83\x48\xc3\xc1\x54\x4b\xec\xa0\x44\x6d\x7f\x37\x86\x48\x82\x90\x76\x06\xa8\x35\xb8\x88\xc5\x64\x41\x14\x50\xa3\x8c\x74\xd2\x9b\x8a\x61\xb9\x84\x37\x74\x12\xdc\x71\x4d\xe1\x0d\x82\xf5\xaa\x02\x80\x25\x34\xfc\x06\xa3\xac\xe2\x72\xd0\x49\x87\xa8\xf5\xaa\xda\x3b\xf4\xca\x59\x10\xd0\x3f\x9d\x78\x50\xc9\x8a\xd7\x75\x14\x6a\xe4\x79\x18\xf7\x2f\xb6\x42\x19\xce\x48\x09\x79\x10\x69\x34\x5d\x6d\x27\xbe\x39\x80\x41\x40\x18\xfd\x59\xf2\x19\x6d\x14\xe6\x4a\x62\x18\x27\x1f\x94\xaa\xa3\x41\xa4\x87\x71\x38\xa7\xd4\x1c\x9f\x7e\xf2\x1f\x35\xda\x4e\x4b\xf7\xbc\x75\xbf\x6b\x2f\x33\xd5\x76\xc7\xeb\x8e\xd4\x9d\x48\x8b\xba\xe0\x19\x46\x71\x12\x4d\xfc\xdb\x4e\x01\x72\xa3\xe4\x0b\x00\xd3\x14\x8e\x8c\xe9\x1a\x34\x20\xec\xef\x06\x38\x7c\x3c\xfd\x7a\xfc\x90\x61\x6b\x85\x92\x09\xdb\x03\xe8\xd9\x9a\xfc\x8d\xf7\xbd\x42\x8f\xa3\x41\x63\x78\x49\x48\xce\xad\x16\xb2\x8c\xe2\x9d\x79\x7a\x32\x58\xa3\x27\x45\x90\x71\x83\xb0\x86\xc5\x12\x0e\xe7\xeb\x55\xb5\x20\xb9\x31\x81\xb0\x84\xf5\x20\x43\xa9\x76\x52\xce\xb8\x97\x73\x21\x81\x37\x8e\x07\xcc\xc5\x65\xcb\x02\x09\x4b\xc8\x54\xbb\x89\xda\x19\xec\xa8\xc0\xf6\xb4\x8e\xcf\x97\x72\x71\xc5\x06\x45\x72\x06\x52\xd4\x3f\x60\xa1\xab\x91\x28\xf6\x6e\x13\xfc\x34\x85\x8b\x4a\x18\x10\xa5\x54\x1a\xa9\x9c\x36\xfd\xa1\x57\x89\x39\x14\x5a\x35\x90\x71\x99\x61\x0d\x0d\xda\x4a\xe5\x09\x9c\x2b\x28\xb8\x9e\xc1\x09\xe4\x22\x07\xa9\x2c\xa0\xcc\x54\x47\x59\x73\x2a\x32\x25\x33\x8d\x54\x24\x54\xba\xc2\x76\x9c\x62\x0f\xf7\x15\x6a\x04\x8d\xd4\x2c\xc8\x0f\x5b\x61\x6f\x4d\x18\x68\x90\x4b\x21\xcb\xa2\xab\x13\xf8\xaa\x8c\x85\xce\xa0\x1e\x90\xf5\x62\x0e\x8b\x46\xd3\x26\x1f\x54\xbe\x49\x7a\x77\x12\x67\xe6\xa4\x20\x7d\x1a\x5d\xca\x25\x62\x0e\x56\xf5\xb6\xfa\xdb\x74\x3a\x03\x61\xc9\x1b\x58\xe3\xae\x8d\x60\x0e\x5c\xe6\x60\xd1\xd0\xe3\x7d\x85\x12\x6c\xc5\xad\xd7\x92\x29\xa2\x52\xd7\x26\xec\x69\xfd\xf8\xa0\x84\xf1\x2e\xfe\x3e\xf8\x69\x0a\xae\xbf\x5c\x68\x2e\x8d\xb3\x2f\x08\xd3\x99\xea\x64\x7e\xa1\x85\x6b\x4f\x4e\x3f\x05\x7e\x82\xa1\x33\x14\x94\x4f\x74\x15\x8e\xbe\x9d\x24\x70\x62\xc1\x74\x2d\x69\x30\x7d\x53\x12\xb2\x24\xf5\x14\x02\x25\x89\x78\x2a\x17\x68\xfa\xbe\xf5\xc4\xa8\xef\x5c\x8f\x23\x1b\x2c\x1c\xec\x4b\xc4\x3b\x48\x91\xc6\x5b\x38\x38\xc3\xdb\x0e\x8d\x8d\x21\x3a\x38\xeb\x2d\xcc\x26\xed\xa9\x72\x2c\x32\xc4\xe2\x6b\x93\x7c\xae\xd5\x9a\xd7\xbe\x5e\xfe\xf4\x27\x61\xec\x2a\x29\x66\x01\x75\xdf\x1b\xdc\xcc\xc0\x55\xb4\xbb\xa2\xb9\x2c\x29\xf9\xb7\x89\x97\x76\xd5\x43\x72\xff\xf6\x52\x3b\xa1\xfe\x92\xab\xe7\xde\x68\x1f\x72\xea\xed\x32\x0f\x67\x13\xe5\xf1\x58\x38\xaa\xb5\xa4\xa3\xe1\xed\xa5\x71\x65\x7b\x25\x86\x3e\xf2\xb8\x25\x65\xa1\xe7\x6f\xb8\x00\xf7\x47\x58\xbe\xba\x2f\x54\xd7\x61\x6f\xa9\x3f\xed\xdf\xdc\x49\xa6\x31\x47\x69\x05\xaf\xe9\x34\x34\xbc\xc1\xb9\xd2\xa2\x14\xae\x63\x6e\x99\x6f\x8a\xb7\x8e\x94\xf0\xcb\x92\x78\xe0\xc0\x53\x75\x9d\x7e\x3c\x5d\xc0\x27\x21\x73\x50\x9d\x05\x2f\x48\x41\xa6\xd4\x6d\x06\x26\xfa\xe4\x62\x4e\x43\x41\xb9\xb2\x70\x99\x1a\x65\x35\x27\x6a\x13\x69\x68\x6e\x00\xcf\xef\x88\x7a\x8e\xd0\x89\xb7\xe3\xff\xce\x11\xe1\x43\x57\x14\xa8\xcf\x55\xa7\x33\x04\x6e\x7f\x32\xf2\x7e\x25\x18\xf3\x46\x3c\x08\xd7\x1a\xe9\x6d\x36\xb4\x2a\x3f\xb0\xdd\x70\x3d\xaa\xeb\x68\xf0\x90\x02\x2e\x0a\x27\x34\xf1\x35\x18\x8e\x87\xaa\x84\x34\xdd\xf1\x0b\x9a\xce\x58\xe0\xf5\x3d\xdf\x18\xc8\x48\xc0\x79\xe9\xcd\x09\x99\xd5\x9d\x6b\x6c\x4a\x0e\x1d\x79\xd2\x1e\xa5\xa8\x27\x0d\xf2\x99\x1d\x16\x50\xe2\x2f\x43\xd2\x15\x5e\x51\xc7\x55\xf9\xc6\x65\x85\xaa\xe4\x9b\x56\x8d\x30\xb8\xcf\x59\xcf\x25\x17\x90\x70\xe6\x32\xf7\xcf\xd9\x97\xb1\xd5\xcf\x40\xb5\x36\x66\x6c\x9c\xb9\xa4\xe7\xc9\x58\x1d\xeb\x83\xcc\xfb\x69\xf2\xe2\xd8\x8d\xf7\x50\x3c\x1d\xb5\x3f\x9c\xb4\x9e\x80\x04\xdc\xd7\xcb\xe3\xd6\xc7\x64\x37\x2d\xab\xb1\xea\x7a\x87\x94\x3e\xe6\xce\x25\xa7\xd8\x55\x87\xab\x94\x17\xa6\x64\x76\x43\x9a\x57\x5c\x2a\x29\x32\x5e\x7b\x13\x7f\xe1\x26\xba\xc1\xcd\xfe\xd0\xeb\x81\x5c\x66\x37\x14\x5c\x5f\x80\xd1\xee\x5b\x5f\x85\x4f\x06\x25\x85\x2f\x08\x32\x25\x2d\x4a\xfb\x05\x65\x69\x2b\xc7\x28\x69\xdf\xbf\x8b\xe6\x6f\x9d\x90\x28\x20\xab\x47\xb2\xf5\x3b\x61\xf2\x8d\x6b\x83\x27\xd2\xf6\x26\xbc\xa7\x2b\xaf\x68\xee\x35\x85\xf1\x0c\xde\xbe\x99\xc1\xfb\x77\xf1\x1f\xee\xfa\x72\x42\xc3\x27\x46\x97\x90\xd5\x0e\x91\x03\x34\x99\xdb\x7e\x28\xf7\xa9\x3d\x9c\xc3\xab\x21\xa3\x5e\xcb\xb9\xe5\xb6\x33\x7d\xa3\x80\xbd\x25\xc5\xb8\xa3\xc9\x6e\x00\xaf\x21\x84\x10\x5e\x83\xbf\x74\x81\x0f\x36\x7a\xf1\x02\xb9\x15\xc7\xb3\x89\x81\x95\xca\x71\xf1\x5d\x03\x4e\xde\x8b\xfb\x04\x8d\x78\x7c\x70\xfc\xd1\x6a\xea\xf0\x02\xf6\xfc\xf7\x12\x54\x2e\xe3\x55\x80\x57\xd3\xa5\xe0\xd1\xbf\x2c\xf6\x10\xb8\x5a\x1a\x68\x55\xa2\xf5\xa2\x61\xec\xf7\xaf\xa0\x9f\x13\x8b\x31\x38\xb7\xee\xfb\x76\x31\xc6\xf5\x70\x4e\x55\xe5\x90\x3d\xd8\x28\x4e\x3e\x2a\x89\x51\xbc\x60\xfd\xf2\xb7\x9d\xb0\xff\xe5\x35\xee\x59\xa6\xc6\x95\xad\x68\x6c\x72\x4c\xe5\x55\x44\xa1\x44\x9b\x52\x7f\x5b\xf8\x7e\x19\xc5\x50\x70\x51\x63\xbe\x80\xdf\x8c\xab\x6c\xb7\xd2\x8d\xd4\xfc\x5f\xf8\x62\x36\x01\xf1\x93\x4b\x63\xa3\x3f\x5a\xd3\xe4\x1d\xda\xb6\x28\xa0\x55\xc6\x88\x75\x8d\xcf\x86\x3b\x7b\xd6\xdf\x86\x45\x74\xe2\xd5\xa0\xc8\x6f\x1a\x98\xd3\xae\x31\xf2\xd6\x6f\x93\x9e\xc1\x8b\x9d\x3a\xfa\xe0\xf7\xc0\xef\xed\x9d\xcf\xfa\xea\x96\x6d\xd9\x7f\x01\x00\x00\xff\xff\xcd\xea\xf8\xb6\xdf\x0d\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x56\x5f\x6f\xdb\xbe\x15\x7d\x16\x3f\xc5\x9d\x86\xfd\x26\xa5\xb2\xd4\x02\x45\x1f\xbc\xf8\x21\x75\xd3\x2e\x58\xbb\x14\x49\xf6\x14\x04\x03\x2d\x5d\x59\x4c\x24\x52\x21\xa9\x38\x46\xe0\xef\x3e\x5c\x92\xb2\xad\xa6\x5d\xf1\xf3\x43\x22\x89\x97\xe7\xfe\x3b\xe7\x92\x45\x01\x6f\x56\x83\x68\x2b\xb8\x37\x8c\xf5\xbc\x7c\xe0\x6b\x84\xc6\xda\x9e\x31\xd1\xf5\x4a\x5b\x48\x58\x14\xa3\xd6\x4a\x9b\x98\x45\x71\xdd\x59\xfa\x27\x94\xff\x5b\x08\x35\x58\xd1\xd2\x8b\xb1\xba\x54\xf2\x29\x66\x2c\x8a\xd7\xc2\x36\xc3\x2a\x2f\x55\x57\xac\x55\xdf\xa0\xbe\x37\x87\x87\x7b\x13\xb3\x94\xb1\xa2\x00\x63\x35\xf2\xee\x0a\x79\x85\x1a\x44\xd7\xb7\xd8\xa1\xb4\x06\xb8\x04\xa1\x72\xfa\xbe\x6c\x95\x41\x0d\x1b\xcd\xfb\x1e\x35\xd4\x4a\x03\x7d\xe6\xab\x16\xaf\xdd\x66\x50\xb5\x0b\xd7\xcc\x8b\xa2\x46\x5b\x36\xb9\xe9\xb1\xcc\x37\x0d\xb7\x9b\x75\xae\xf4\xba\xc8\x99\xdd\xf6\x38\xf5\x65\xac\x1e\x4a\x0b\x2f\x2c\xea\x51\x56\x42\xae\xe1\xf6\x6e\xb5\xb5\xc8\x22\x6f\x06\x70\x72\x6f\xf2\xcb\xd5\x3d\x96\x96\xed\x18\xab\x07\x59\x42\xa2\xe1\xe4\x18\x25\x75\xa1\x24\x7d\xd8\x9b\x42\x22\x41\x48\x9b\x01\x6a\x0d\xae\x62\x29\x79\x10\x35\xb4\x28\x13\x9d\x07\x57\x29\x2c\x16\xf0\x96\x56\x22\x8d\x66\x68\xc3\x86\xf9\x02\xee\x4d\x7e\xb6\xe1\xc2\x26\x3a\xf7\x7e\xf2\x25\x6f\xdb\x24\xd6\xc8\xab\x38\x4d\x59\x44\x58\x64\xfb\x97\x05\x48\xd1\x3a\x88\xa8\x28\xe0\xcc\x98\xa1\x43\x03\xc2\xfe\xdd\x00\x87\x4f\x97\xdf\xce\x9f\x4b\xec\xad\x50\x32\x27\x13\x8d\x76\xd0\x12\xde\x66\x3e\x2a\x93\xff\x1b\x37\x09\x6a\x9d\x27\x94\xe5\xb9\x8b\x34\xff\x82\x36\x89\x3b\x34\x86\xaf\x31\x4e\xf3\x6b\xab\x85\x5c\x27\xce\xeb\xce\x7b\xf6\xd1\x7a\xc3\x4a\x49\xb2\xfa\xa8\x54\x9b\xa4\xf0\x32\xf5\x22\x54\x7e\x7e\xf9\x39\x6c\xdc\xe7\x0d\x8b\x09\xc2\x13\x6f\x07\x82\xb8\x90\x16\x75\xcd\x4b\x4c\xd2\x3c\x09\x95\x64\xb4\x53\xc2\x02\x4a\xd5\x6f\x93\x3e\x83\x43\xf1\xd8\x14\x70\x7c\xbe\x95\xf3\x3b\x36\x86\x20\x33\x2a\xcf\xff\xe9\x9b\x63\x55\x92\xfa\x72\x50\xf4\x45\x01\x37\x8d\x30\x20\xd6\x52\x69\x24\x02\x6e\xc3\xa2\x87\xc4\x0a\x6a\xad\x3a\x28\xb9\x2c\xb1\x85\x0e\x6d\xa3\xaa\x1c\xae\x15\xd4\x5c\x67\x70\x01\x95\xa8\x40\x2a\x0b\x28\x4b\x35\x50\x46\x0e\xa2\x54\xb2\xd4\x48\xb4\x22\xb2\x0b\x3b\x70\xea\x09\x6c\x1a\xd4\x08\x1a\x49\x5e\x94\x87\x6d\x30\x78\x13\x06\x3a\xe4\x52\xc8\x75\x3d\xb4\x39\x7c\x53\xc6\xc2\x60\x50\x8f\x91\x05\x33\x17\x8b\x46\xd3\xe7\x1f\x55\xb5\xcd\x43\x3a\xb9\x73\x73\x51\x13\x9e\x46\x47\x05\x89\x58\x81\x55\xc1\x57\xd8\x4d\xab\x19\x08\x4b\xd9\xc0\x0a\x0f\xc2\xc3\x0a\xb8\xac\xc0\xa2\xa1\xc7\x4d\x83\x12\x6c\xc3\xad\x47\x29\x15\x51\x6c\xe8\x73\xea\xc0\x84\x9e\xbe\x28\x71\x7a\xa8\xbf\x2f\x7e\x51\x80\x53\xe4\x8d\xe6\xd2\x38\xff\x82\x62\xba\x52\x83\xac\x6e\xb4\x70\x82\x76\xf8\xc2\x4c\x62\x18\x0c\x15\xe5\x33\x6d\x85\xb3\xef\x17\x39\x5c\x58\x30\x43\x4f\x08\x26\xc8\x58\xc8\x35\xc1\x53\x09\x94\x34\x08\x2b\x55\x09\x34\x41\xe9\x3f\x38\xf5\x5a\x7f\xd9\xb3\xc1\xc2\xc9\xd4\x22\x3d\x84\x94\x68\x7c\x84\x93\x2b\x7c\x1c\xd0\xd8\x14\x92\x93\xab\xe0\x21\x3b\x12\x74\xe3\x58\x64\x82\x62\xbf\xb4\x6a\xc5\x5b\xcf\xe9\x7f\xfa\x95\x38\x75\x0a\x4b\x59\x44\xf3\xea\x01\xb7\x19\x38\xb6\xbb\x2d\x9a\xcb\x35\x82\xc6\xc7\xdc\x5b\x3b\xf1\x90\xdd\x7f\x83\xd5\xc1\x28\x6c\x22\x83\xd1\x69\x28\x39\x4d\x43\x59\xc5\xd9\x11\x78\x10\xea\x8e\x45\xaa\xb7\x84\xd1\xf1\xfe\xd6\x38\x15\xdf\x89\x51\x63\x2f\x3b\x02\x8b\x3d\x7f\xe3\x39\xb8\x1f\xc5\xf2\xcd\x7d\xc9\x68\x31\x78\x0a\xab\xe1\xcd\xad\x94\x1a\x2b\x94\x56\xf0\x96\x56\x63\xc3\x3b\x9c\x29\x2d\xd6\x42\xc6\x99\xf3\xec\x86\xc4\xa3\x23\xe5\xf1\x8c\x22\x75\x5d\x7e\xba\x9c\xc3\x67\x21\x2b\x50\x83\x05\x6f\x48\x45\xa6\xd6\x6d\x47\x26\xfa\xe6\x62\x45\x63\x54\x39\x59\xb8\x4e\xed\x6d\x35\xb7\x8d\x27\x0d\x4d\x5a\xe0\xd5\x13\x51\xcf\x11\x3a\xf7\x7e\xfc\xef\x1a\x11\x3e\x0e\x75\x8d\xfa\x5a\x0d\xba\x44\xe0\xf6\x37\x87\xc4\x5f\x29\x8c\x59\x27\x9e\x85\x1b\x99\xf4\xb6\x1f\xcb\xfe\x88\x73\xc7\xd1\x59\xdb\x26\x63\x86\xbf\x98\xc7\xe3\xf2\xa8\x4a\x28\x8a\x03\xbf\xa0\x1b\x8c\x05\xde\x6e\xf8\xd6\x40\x49\x06\x2e\x4b\xef\x4e\xc8\xb2\x1d\xdc\x60\x53\x72\x9c\xd4\x47\x83\x55\x8a\xd6\x85\x34\xce\xd5\x1f\xfc\xb0\x88\x1a\x7f\x1b\x13\x56\x7c\x07\x0b\x07\xea\xba\x42\x2a\xf9\xae\x55\x27\x0c\x4e\x39\xeb\xb9\xe4\x0a\x12\x67\xae\x73\xff\xb9\xfa\xba\x9f\xfc\x19\xa8\xde\xa6\x8c\x45\x4f\x5c\xd3\x25\xc0\xe1\x2c\x1b\x20\x66\x3d\x60\x52\x52\x13\xf6\xfa\x20\xf7\xa8\xf5\xb2\x81\xc9\xba\x17\x0d\x8b\xd2\x49\x14\xc1\xb1\x6d\xd0\x11\x27\x22\x5d\x26\xfe\x6c\x38\x3a\x77\xd3\x63\xe2\x53\xe0\x5e\x2f\x2f\x3b\x5f\x93\xc3\x49\xd2\xec\x55\x17\x12\x52\xfa\x9c\xbb\x94\x1c\xb0\x53\x87\x53\xca\x6b\xf0\xa8\x7c\x20\xe4\x25\x97\x4a\x8a\x92\xb7\xde\xc5\xbf\x70\x9b\x3c\xe0\x76\x72\x06\x8e\x81\xdc\x96\x0f\x54\x5c\x2f\xc0\xe4\xf0\x2d\xa8\x70\xba\x67\x47\xe5\x8b\xa2\x52\x49\x8b\xd2\x7e\x45\xb9\xb6\x8d\x63\x94\xb4\x1f\xde\x27\xb3\x77\xce\x48\xd4\x50\xb6\x7b\xb2\x85\x5b\x54\xfe\x9d\x6b\x83\x17\xd2\x06\x17\x3e\xd3\xa5\x07\x9a\x79\xa4\x38\xcd\xe0\xdd\xdb\x0c\x3e\xbc\x4f\xff\xe1\xb6\x2f\x8e\x68\xf8\x83\xd3\x05\x94\xad\x8b\xc8\x05\x64\xb0\x45\x7f\xfd\x89\xa2\x92\x1b\x84\xd0\xda\xd3\x19\xfc\x31\x76\xd4\xa3\x5c\x5b\x6e\x07\x13\x06\x05\x4c\x0e\x70\xe3\x96\x8e\xae\x0a\xf0\x06\x62\x88\xe1\x0d\xf8\x4d\x37\xf8\x6c\x93\x9f\x6e\xa0\xb4\xd2\x34\x3b\x72\xb0\x54\x15\xce\x7f\xe9\xc0\xd9\x7b\x73\xdf\xa0\x7d\x3c\xbe\x38\x7e\x69\x79\x9c\xf0\x1c\x26\xf9\x7b\x0b\x92\xcb\x7e\x2b\xc0\x1f\xc7\x97\x82\x17\xff\x32\x9f\x44\xe0\xb4\x34\xd2\x6a\x8d\xd6\x9b\xc6\xe9\xce\xe3\x85\x73\x62\xbe\x2f\xce\xa3\xfb\xbe\x9b\xef\xeb\x7a\x3a\x23\x55\xb9\xc8\x9e\x6d\x92\xe6\x9f\x94\xc4\x24\x9d\xfb\x4e\x44\xd1\xee\x88\xfd\xdc\x28\xf9\x9a\xa0\xaf\x3a\xe5\x45\x76\x3a\x83\xba\xb3\xfe\xea\x56\x27\xb1\x44\x5b\xd0\x7c\x9b\xfb\x79\x99\xa4\x50\x73\xd1\x62\x35\x87\xbf\x19\xa7\x6c\x02\x3f\x50\xf3\x4f\xc5\x97\xb2\xa3\x20\x7e\xb3\x69\x3f\xe8\xcf\x56\x4a\xdb\xfd\xd8\x16\x35\xf4\xca\x18\xb1\x6a\xf1\xd5\xe1\xce\x5e\xcd\xb7\xf1\x82\x7a\x94\xd5\x08\xe4\x6f\x1a\x58\xc5\x69\x08\x85\x78\x4b\xaa\x39\x9d\x79\x06\xcf\x0f\x70\xf4\xc1\xdf\x03\xf7\x85\xf3\x96\xae\x82\x73\xf6\x93\xb9\xba\x63\x3b\xf6\xbf\x01\x00\xcb\x69\xe4\x5a\x11\x0d\x00\x00"),
 		},
 		"/src/net/http/http.go": &vfsgen۰CompressedFileInfo{
 			name:             "http.go",
@@ -566,6 +573,17 @@ var FS = func() http.FileSystem {
 			name:    "os",
 			modTime: time.Date(2021, 2, 20, 9, 0, 11, 158544841, time.UTC),
 		},
+		"/src/os/exec": &vfsgen۰DirInfo{
+			name:    "exec",
+			modTime: time.Date(2026, 10, 18, 21, 5, 2, 637853077, time.UTC),
+		},
+		"/src/os/exec/exec.go": &vfsgen۰CompressedFileInfo{
+			name:             "exec.go",
+			modTime:          time.Date(2026, 10, 18, 21, 5, 2, 637853077, time.UTC),
+			uncompressedSize: 642,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x64\x51\xcb\x6e\xdb\x30\x10\x3c\x8b\x5f\xb1\xe6\x21\x20\x5b\x83\x8e\xaf\x0d\x7c\x69\x6b\x04\xb9\x04\x05\x82\x9e\x0b\x9a\x5a\xd9\x94\x28\xae\xbb\x4b\xc5\x36\x0a\xff\x7b\x41\x39\x45\x5f\x17\x82\xc4\xcc\xce\xec\x0c\x57\x2b\x78\xbf\x9b\x62\x6a\xa1\x97\xe5\xe2\x14\x73\x4b\x27\x51\xea\xe8\xc3\xe0\xf7\x08\x78\xc6\xa0\x54\x1c\x8f\xc4\x05\x8c\x6a\x34\x89\x56\xaa\xd1\xfb\x58\x0e\xd3\xce\x05\x1a\x57\x7b\x3a\x1e\x90\x7b\xf9\x7d\xe9\x45\x2b\xab\xd4\x6a\x05\x5d\xcc\xed\xf6\x8c\x61\x2a\x7e\x97\x10\xbc\x0c\x02\xcf\xd4\xa2\xeb\x05\x3a\x62\x28\x07\x84\x91\x5a\x04\xea\xa0\x8b\x09\x97\x20\x31\x07\x04\x12\xf7\x52\x7c\x01\xca\xe9\x02\x27\xe2\x41\xaa\xda\x29\x96\xc3\x3c\x22\x17\x29\x38\x42\xf0\x29\xd5\xf1\x29\xa1\x53\xdd\x94\xc3\x3f\x7e\xa6\x4a\x82\x14\x8e\x79\x6f\xc1\x20\x33\x20\x33\xb1\x85\x1f\xaa\x61\xfc\x3e\x45\x46\xf8\xb0\x81\x5e\xdc\x63\xa2\x9d\x4f\xee\x11\x8b\xd1\x6f\x88\xb6\xaa\x89\x1d\xfc\xe2\x6d\x66\xde\xd7\xdc\x62\x17\x33\xb6\x55\xa2\x61\x2c\x13\xe7\xba\xed\x96\xf9\x99\xca\xf6\x1c\xa5\xa8\xe6\xaa\x9a\x16\x3b\x64\xa8\x3b\x99\xd9\xad\x2a\xcd\x5e\x8c\x81\x5e\x91\x8d\x7d\x00\x84\xc5\x06\x72\x4c\x33\x5e\x09\xdf\x96\x40\x43\x25\xa1\x33\xef\xfa\x59\x94\xd8\x3e\xc0\x82\x86\x1b\xa7\x39\xfa\x1c\x83\x41\x5b\x1f\xd7\x7a\xd4\x4c\x9b\xff\x16\xa8\xd8\xd5\x58\xd5\x48\xb9\x79\xce\x11\xdc\x53\x7e\xa5\x01\x8d\xee\x44\x5b\xf7\xc9\xa7\x64\xb4\x14\x5f\x5e\x2e\x39\xe8\xe5\xdc\xff\x2d\xf2\x42\xca\x1b\x1c\xe5\x73\x64\x0c\x85\xf8\xa2\xad\xfb\x48\x94\x8c\x85\xbb\x3b\x90\x72\xab\xaa\xfe\x9d\xb6\xee\x29\x17\x63\xef\xee\xd7\xeb\x75\x8d\x74\xff\x67\x37\x39\xa6\xb9\x90\xbf\xaa\xfa\x82\x3c\x46\x91\x48\x59\x5d\xd5\xcf\x01\x00\xf9\xd0\x0b\x04\x82\x02\x00\x00"),
+		},
 		"/src/os/go116_os.go": &vfsgen۰FileInfo{
 			name:    "go116_os.go",
 			modTime: time.Date(2021, 2, 20, 9, 0, 11, 158242422, time.UTC),
@@ -573,10 +591,10 @@ var FS = func() http.FileSystem {
 		},
 		"/src/os/os.go": &vfsgen۰CompressedFileInfo{
 			name:             "os.go",
-			modTime:          time.Date(2021, 2, 20, 9, 0, 11, 159363162, time.UTC),
-			uncompressedSize: 645,
+			modTime:          time.Date(2026, 10, 18, 21, 5, 2, 637853077, time.UTC),
+			uncompressedSize: 892,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x5c\x92\x41\x6b\xdc\x30\x10\x85\xcf\x9e\x5f\x31\xd5\x49\x62\x5b\x3b\x69\x6f\xd9\x9a\x12\x4a\xd8\x16\x4a\x0b\x2d\xa5\x87\x10\x8a\x6c\x8f\xbd\xe3\xb5\x25\x23\xc9\xcd\xc2\xb2\xff\xbd\x48\x6b\x6f\x20\xe0\x83\x99\xf9\xde\x9b\x99\x67\x17\x05\x6e\xaa\x99\x87\x06\x7b\x0f\x30\xe9\xfa\xa0\x3b\x42\xeb\x01\x78\x9c\xac\x0b\x28\x21\x13\xe4\x9c\x75\x5e\x00\x64\xa2\xe3\xb0\x9f\xab\xbc\xb6\x63\xd1\xd9\x69\x4f\xae\xf7\x2f\x2f\xbd\x17\xa0\x00\x8a\xa2\xb3\x77\x03\x9b\x83\xd1\x23\x61\xab\x7d\x70\xda\x34\xe8\x66\x13\x78\xa4\x7c\x2d\x40\x3b\x9b\xfa\xda\x96\x0a\x67\x36\xe1\xc3\x7b\xb8\xd4\x17\xfa\xaf\x76\x9d\x97\x0a\x1f\x9f\x7c\x70\x6c\x3a\x3c\x61\x51\xa0\xb1\x01\x6b\x3d\x0c\xd4\xa0\x35\xf8\x87\x4d\x63\x9f\x3d\x64\x8e\xc2\xec\x0c\xde\xbb\xce\xc3\x79\xf1\x61\xc3\x41\x2a\x3c\x41\xc6\x2d\x4e\xce\xd6\xe4\x3d\xde\x95\xd8\xfb\x7c\x37\xd8\x4a\x0f\xf9\x8e\x82\x14\x4b\x47\xa8\xed\x15\x7a\x93\xa0\xdf\xa6\xa1\x96\x0d\x35\xd1\x22\xd3\xae\xfb\x17\xd5\x0b\x73\xd1\xc6\xa2\x50\x90\x65\x71\x30\x96\x38\xea\x03\xc9\x75\xe1\xb7\x18\xdb\xf9\x37\x32\x5d\xd8\x4b\xf5\xee\x36\x82\xad\x75\xc8\xd1\xe7\x66\x8b\x8c\x1f\x5f\x23\x5b\xe4\xcd\x26\xcd\x4b\x96\x8f\xfc\x84\xe5\x85\xf9\x6a\x1a\x3a\x4a\xc6\x0d\xde\xaa\xfc\x57\x1a\x20\xa3\xe1\x19\xe2\xc3\x2d\x0e\x64\x64\xd4\x28\x2c\x4b\xbc\x49\x1e\xcb\x56\xeb\x42\x27\xf1\x49\x24\xfc\xfc\x2a\xe9\x8a\x5a\xeb\xe8\xe1\x78\xc9\x6b\xed\xd2\x91\xea\x39\xe8\x6a\x20\xa9\x50\xae\x37\xa5\x3f\x22\xa5\xba\x64\x2e\xc4\x52\xf4\xf9\x77\x7a\x96\xe2\xe1\x2a\x4b\x1f\x8b\xc7\x69\xa0\x91\x4c\xa0\x06\xe3\xf1\xbb\x1f\xf7\x3f\x3f\x7f\x29\x7b\x2f\x14\x9c\xe1\x7f\x00\x00\x00\xff\xff\xa8\x45\x9b\x0d\x85\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\x52\xc1\x6a\xdc\x30\x10\x3d\x5b\x5f\x31\xd5\x49\xee\xa6\x76\xd2\xf6\x94\xed\x52\x42\x09\x69\x4b\x49\x4b\x43\xc8\x21\x84\x22\x5b\x63\xef\xec\xca\x92\xd1\xc8\xd9\x94\xb0\xff\x5e\x64\x7b\xb7\x10\x02\x3e\x98\x99\xf7\xde\xbc\x79\x9a\xb2\x84\x45\x35\x90\x35\xb0\x61\x21\x7a\x5d\x6f\x75\x8b\xe0\x59\x08\xea\x7a\x1f\x22\x28\x91\x49\x0c\xc1\x07\x96\x42\x64\xb2\xa5\xb8\x1e\xaa\xa2\xf6\x5d\xd9\xfa\x7e\x8d\x61\xc3\xff\x7f\x36\x2c\x45\x2e\x44\x59\xb6\xfe\xdc\x92\xdb\x3a\xdd\x21\x34\x9a\x63\xd0\xce\x40\x18\x5c\xa4\x0e\x8b\x43\x41\x34\x83\xab\x8f\x6d\x95\xc3\x40\x2e\x7e\x78\x2f\xa6\xfa\x8c\xfe\xa3\x43\xcb\x2a\x87\xfb\x07\x8e\x81\x5c\x0b\xcf\x50\x96\xe0\x7c\x84\x5a\x5b\x8b\x06\xbc\x83\x3b\x72\xc6\xef\x58\x64\x01\xe3\x10\x1c\x5c\x84\x96\xc5\x7e\xd6\x21\x47\x51\xe5\xf0\x2c\x32\x6a\xa0\x0f\xbe\x46\x66\x38\x5f\xc1\x86\x8b\x2b\xeb\x2b\x6d\x8b\x2b\x8c\x4a\xce\x1d\x99\x2f\x8f\xa0\x37\x23\xe8\xd6\x19\x6c\xc8\xa1\x49\x12\x99\x0e\xed\x63\x62\xcf\x98\x89\x9b\x8a\x32\x17\x59\x96\x06\xc3\x0a\x3a\xbd\x45\x75\x30\x7c\x02\xa9\x5d\xfc\x40\xd7\xc6\xb5\xca\xdf\x9d\x25\x60\xe3\x03\x50\xd2\x39\x5d\x02\xc1\xa7\x97\x90\x25\xd0\x62\x31\xce\x1b\x25\xef\xe9\x01\x56\x13\xe6\x9b\x33\xf8\xa4\x08\x16\x70\x96\x17\x37\xe3\x00\x95\x04\xf7\x22\x7d\xd4\x80\x45\xa7\x12\x27\x87\xd5\x0a\x4e\x47\x8d\xd9\xd5\xc1\xd0\xb3\xfc\x2c\x47\xf8\xfe\x45\xd2\x15\x36\x3e\xe0\xe5\xd3\x94\xd7\xa1\x8b\x4f\x58\x0f\x51\x57\x16\x55\x0e\xea\xb0\xd3\x78\x11\x63\xaa\x73\xe6\x52\xce\x45\x2e\xae\x71\xa7\xe4\xe5\x91\x36\x3e\x16\x75\xbd\xc5\x0e\x5d\x44\x03\x69\xf9\xab\x9f\x17\xbf\xbf\x7c\x5d\x6d\x58\xe6\xc9\x47\x59\x42\x65\x7d\xbd\xbd\x75\x91\xec\x9d\xa6\x89\x38\x49\x33\x34\xda\x32\x9e\x00\x93\xab\x11\x76\x9a\x22\x19\xd8\xf9\xc1\x9a\x89\x04\x71\x8d\xf0\x5d\x3f\xea\x9b\x3a\x50\x1f\x93\x58\x5c\x07\xd4\x06\xd2\xd5\x5d\x7b\x83\xc5\x86\x21\xa0\xee\x19\xea\x75\x3a\xf5\xf9\xf5\x90\x81\x22\xa3\x6d\x96\xc0\x7f\x39\xdd\x53\x91\x66\x7f\x1c\x67\x70\xf2\x39\x69\x61\x07\xe4\x38\xa2\x36\xc5\x94\x89\xea\xe1\xed\xaf\x49\x23\x7f\xc5\x78\x0a\xaa\xf2\xde\xbe\x12\xd3\xbc\x8a\x23\x2b\xf6\xe2\xdf\x00\x40\x23\x60\x21\x7c\x03\x00\x00"),
 		},
 		"/src/os/signal": &vfsgen۰DirInfo{
 			name:    "signal",
@@ -584,10 +602,10 @@ var FS = func() http.FileSystem {
 		},
 		"/src/os/signal/signal.go": &vfsgen۰CompressedFileInfo{
 			name:             "signal.go",
-			modTime:          time.Date(2020, 10, 13, 23, 35, 11, 0, time.UTC),
-			uncompressedSize: 233,
+			modTime:          time.Date(2026, 10, 18, 23, 6, 48, 187263444, time.UTC),
+			uncompressedSize: 3055,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\xce\xbf\xca\xc2\x40\x10\x04\xf0\x7e\x9f\x62\xca\x84\x0f\xbe\x13\xad\x2d\xc4\x42\x3b\xc5\x17\x90\x4b\xb2\x09\x1b\x2f\x7b\xe1\xfe\xd8\x84\xbc\xbb\xa0\x69\x22\xd8\xce\x6f\x60\xc6\x18\xfc\x55\x59\x5c\x83\x3e\x12\x8d\xb6\x7e\xd8\x8e\x11\xa5\x53\xeb\x88\x8c\xc1\x75\x15\x41\x22\xd4\x27\xc8\x30\x3a\x1e\x58\x13\x37\x68\x7d\xc0\xe9\x72\xb8\x1d\xcf\xfb\x3e\xfe\x13\xb5\x59\xeb\xa5\x7e\x6f\x24\xda\xca\x71\x91\x45\xd3\x6e\x5b\x62\x9a\x57\xcc\xba\xd2\x6f\x96\x4e\x7d\xf8\xcd\x81\xeb\x67\x51\xe2\xc3\x00\x26\x04\x4e\x39\x28\x36\x98\x97\x1b\xce\xfb\xb1\x78\xcf\xbe\x02\x00\x00\xff\xff\x29\x0b\xd3\x08\xe9\x00\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x56\x41\x6f\xe3\x36\x13\x3d\x8b\xbf\x62\x22\x7c\xf8\x2a\x65\x5d\x79\xdb\xde\x92\xf5\xa1\x28\xb6\x81\xd1\x60\xb7\x45\x76\xd1\x43\x10\x2c\x68\x71\x64\xd1\xa6\x49\x87\xa4\x9c\x06\x89\xff\x7b\x31\x24\x25\xcb\x4e\x76\x5b\x34\x27\x8b\x7c\xf3\xf8\x66\xe6\x71\x98\xe9\x14\xde\x2c\x3a\xa9\x04\xac\x1c\x63\x5b\x5e\xaf\xf9\x12\xc1\xc9\xa5\xe6\x8a\x31\xb9\xd9\x1a\xeb\xa1\x60\x59\xbe\x94\xbe\xed\x16\x55\x6d\x36\xd3\xa5\xd9\xb6\x68\x57\xee\xf0\x63\xe5\x72\x56\x32\x36\x9d\xc2\x5c\xc3\x07\x23\xb0\x5a\xb9\x49\x62\x71\xc0\x2d\x82\x40\x25\x77\x68\x51\x80\x37\xa0\xa4\xf3\xa8\xd1\x3a\x30\x1a\x7c\x8b\xb0\xb5\xa6\x46\xe7\xc0\x2c\x56\x58\xfb\x09\x38\x03\x9c\xd8\x7a\x20\x48\x07\x5c\x08\x14\xd0\x18\x0b\xb8\x43\xfb\x98\xd8\xc1\xb7\xdc\xd3\xb6\x36\x5e\x36\x12\x05\x18\x0b\x72\xa9\x8d\x45\x51\xc1\x27\xda\xe4\xca\x19\x22\x5b\x23\x6e\x5d\xaf\x0e\x1a\x6b\x36\xe0\xf9\x5a\xea\x65\x90\x20\xb0\xe1\x9d\xf2\xc0\x6b\x2f\x8d\x9e\xc0\x43\x2b\xeb\x96\x88\x2d\x3a\x4f\x6c\xb0\x78\x04\x8b\x1b\xb3\x93\x7a\x49\x74\x14\x34\xe8\xe3\x4b\x2e\x75\x05\xbf\x1a\x0b\x37\xf3\xab\x3f\x3e\xcf\x3f\x4d\x5e\x61\x25\xba\xa3\x30\xd3\xd0\x37\xb1\xd9\x4e\x7b\xb9\xc1\x98\x8e\xe8\x36\xdb\x88\x5c\x1a\x6b\x3a\x2f\x35\xba\x23\x45\x1b\xb3\x43\x01\x5c\x8b\x54\x95\x05\xaf\xd7\x01\xef\xf8\x26\xd0\x3d\xf0\xc7\x0a\x6e\x52\xfd\x03\x27\xb7\x56\xee\x30\xf4\xe2\xbe\xc3\x0e\x05\xd0\x89\xea\xf8\x94\x08\xb5\x9d\x76\xa0\x8c\xd9\x42\xcb\xb5\x70\x29\xd9\x0d\x98\x1d\x5a\x6a\x1f\x85\xd4\x2d\xd7\x1a\x95\xab\xe0\xbd\x72\xf8\xd0\xa2\xc5\x09\x68\x33\xea\x39\x1d\x57\x31\xb6\xe3\x96\xfc\x73\x68\xf9\x0c\x36\x7c\x8d\xc5\x86\x6f\x6f\x3b\xa9\xfd\x4f\x3f\xde\x35\x9d\xae\x8b\xb2\x64\x59\xea\x1b\xc0\x2b\xa0\x85\x31\xaa\x64\xd9\x16\xb5\xa0\x96\x01\xdc\xde\xc5\x1d\x96\x3d\x70\xe9\xe3\x1a\x81\x60\xf8\xa3\x3a\xb4\xe8\x5b\xb4\x31\x1b\xc2\xb9\xe0\x20\xde\x3b\x3c\x8b\x3a\xe9\x48\xca\x08\x9c\xb7\x5d\xed\x9f\xf6\x14\x5b\x2b\xe3\x50\x10\x85\x1e\x02\x52\x5e\x8e\x65\x52\x28\x8c\xc7\x7c\x33\x30\x1c\xec\x3c\xb7\xde\x41\xd2\x49\x37\x85\x52\x0e\xdf\x45\xdd\xc2\xf9\x11\x43\x09\x4f\x2c\x93\x0d\xad\xc2\x6c\x06\x5a\x2a\x5a\xc8\xc2\x67\xac\xca\x31\x9c\x65\x7b\x96\xbd\xfb\xfe\xbc\x6e\xd9\x3e\x11\x2f\xac\xe1\xa2\xe6\xee\x1f\xd8\xcf\x0e\xec\x41\x72\x71\x5e\xb7\xe5\x70\x94\x96\x8a\xa8\xf7\xe1\x5a\xc7\xec\x3f\xf0\x0d\x82\x45\xdf\x59\x1d\xed\xa9\x69\xc1\x34\xb4\x1d\xad\xd3\xdf\xae\xce\x91\x63\x8d\x85\x3c\x07\xd9\x80\xf4\x50\x73\xfd\x9d\x3f\xdc\x69\xf0\xc1\x2b\x55\x14\x7c\xa0\x2f\x88\x2a\x36\xb6\x24\xd1\xd4\xd7\x27\x96\x59\xbc\xef\xa4\x45\xb8\x98\xc1\xca\x55\x57\xca\x2c\xb8\xaa\xae\xd0\x17\x79\xda\xc9\xcb\x90\x56\x8f\x9b\x05\xdc\x67\x2d\xb0\x91\x1a\x05\x3c\x3f\x9f\xc6\xa5\x91\x93\x97\x2f\xb0\x54\x8f\x98\x24\xe4\x79\xa8\x6e\x6f\xea\x8b\x59\x7f\x40\x35\xd7\x3b\xb3\xc6\x22\x37\x2e\x2f\x23\x61\x6d\xb4\xf3\x5c\xfb\x61\x21\x45\x91\x32\xaa\x93\x7b\x45\xfc\xc7\x30\xef\xf2\xb2\xfa\x85\x2b\x55\xe4\x6b\x7c\x74\xf9\x30\x37\x4b\x96\x91\x57\x25\xc5\xbd\xbd\x04\x09\xef\x42\xbd\x5d\x75\x8d\x7a\xe9\xdb\xa2\xbc\x04\xf9\xe6\x4d\x90\x2b\x9b\xb0\x45\xc8\x08\x99\x6b\x81\x7f\x15\xb2\xac\x6e\x42\x09\x09\x9b\x58\xc3\xc1\x04\x2a\xab\xb9\xf6\x45\x48\x5f\x6a\x4f\x75\x0f\xde\x18\x72\x27\x0c\xcb\x28\xfd\x3d\x1b\xd5\x63\xcf\x46\x4d\xb4\xb8\x55\xbc\xc6\x93\xa9\x46\xaa\xa9\xb7\xf0\x73\xf0\xd7\xb0\x9e\x26\xa9\x1b\x4f\x46\x22\x8b\xc3\x31\x59\x21\xa2\x47\x36\x98\x8c\x88\xe3\xa0\x20\x99\x7d\xba\xc7\xce\x89\x26\x08\x7b\xb3\x19\x79\xef\xd0\xcb\x90\x46\xff\xce\xbc\xec\xc4\x60\x07\x96\xdd\x77\xd2\xc3\xc5\xb1\x29\x8e\x79\xd3\x88\x8f\xf4\x01\xfe\x82\xef\x7f\xb4\x7c\x9d\x84\xe7\xf1\x92\xca\x06\x8c\x12\x44\x3d\x4c\xc3\x5b\x27\x97\x77\x97\x61\x79\x74\x1b\x93\x98\xe4\x8a\x38\xee\x07\xae\x49\xd0\x31\xa1\x18\xba\xac\x02\x15\x7a\x2c\x06\xc6\x60\x9f\x32\x7a\x22\x48\x3b\x7b\xc5\xde\x27\x27\x18\x3d\xb0\x52\x48\x39\xb4\x5d\x36\x87\xe2\x8f\x66\xd1\xa8\xa2\x02\x9b\xa1\x31\x61\x6f\x3a\x1d\x86\x80\xc5\x86\xe6\xc0\xe1\xc5\xa7\x5f\x37\xf3\xab\xdf\xe6\xd7\xd7\xe1\xf1\xba\x99\x5f\xdd\x7c\xfa\xf8\x7b\x15\xd5\xa2\xb5\xf1\x86\xd5\xf4\xd0\x90\x65\x69\x65\x54\x16\x02\x7d\x99\x80\x59\x13\x0c\xad\xad\x8a\xf3\x95\xab\xde\x5b\x6b\x6c\x79\x09\x67\x66\x1d\x51\xd9\x96\x6b\x59\x17\x68\x2d\x25\x42\x22\x43\x36\x45\xc9\xbe\x9e\x76\x9f\x65\xc9\xb2\xe3\xde\xc0\xa1\x59\xec\x9b\x25\xfd\x57\x3d\x4b\xd5\xdd\x0f\x93\x3a\xda\xf7\x0b\x6a\xbe\x50\xc7\xb3\xef\x89\xf5\xad\x4d\xaf\x62\xdf\xd8\xc3\x05\x99\x8c\xeb\xde\x3f\x8c\x33\xe0\x5b\xfa\x5d\xa4\x85\xc1\x0f\x87\x47\xe1\xff\xe9\xd1\x23\x25\xe5\xa9\x14\x21\xdd\x7f\xd3\xa2\xa5\x7a\x41\x16\xe1\xa7\x5c\x89\xa4\xaf\xaf\xb7\x1d\xbe\x9e\xd6\xfe\x2b\x84\xe2\x88\x31\x3c\xfa\x4f\xc3\x8c\x1a\xb3\x9f\x86\x5b\xac\x77\x45\x99\x22\x29\x86\x46\x95\x42\xdd\x17\x2b\x4c\xc3\xb7\xa1\x9e\xfd\x3f\x15\xbd\xbe\x71\xfd\xe8\xed\x2f\x13\x66\x5c\xcd\x51\x50\xc3\x95\xc3\xfe\xf1\x20\xbf\xa6\x13\x6e\xdf\xde\xb1\x51\xaf\xfa\xd5\x1f\x2e\xee\x86\x04\x9c\x5c\x9e\xe8\xfe\x93\x4b\xff\x59\x7b\xa9\xe6\x42\x61\x51\xf6\xc2\xcf\xfa\xe3\x9e\x9f\x8f\x93\x38\x1b\x27\x31\xc8\x25\xd7\xfd\x3d\x00\x51\xd4\xb0\xf5\xef\x0b\x00\x00"),
 		},
 		"/src/path": &vfsgen۰DirInfo{
 			name:    "path",
@@ -683,12 +701,19 @@ var FS = func() http.FileSystem {
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\xce\xb1\x4e\x03\x31\x0c\xc6\xf1\xb9\x7e\x8a\x6f\x2c\x02\x9a\x34\xa5\x3c\x00\x0c\x9d\x8a\x10\xf0\x02\x49\xce\x1c\xa6\x77\x6e\x75\x71\x24\x2a\xd4\x77\x47\xbd\x0e\x87\xd8\xf0\xe2\xe1\x2f\xff\x64\xe7\x70\x9d\xaa\x74\x0d\x3e\x0b\xd1\x21\xe6\x5d\x6c\x19\x0d\xa7\xda\x12\xbd\x57\xcd\x28\x6c\x9b\xc7\x67\x1e\x32\xab\xcd\x45\x6d\x15\xae\x30\x2e\x7c\xd3\xcc\x39\x3c\xed\x0d\xd2\x1f\x3a\xee\x59\x8d\x9b\x05\x5e\xd8\xea\xa0\x10\x15\x93\xd8\x9d\xef\x4d\xb4\x5d\xd0\x6c\xb8\x84\xa5\xf7\x74\x9a\xf0\x6d\xfc\x7a\xb5\x98\x77\xf3\x74\x34\x2e\x67\x7a\xf4\xff\xad\x3b\x87\xb7\x0f\xfe\x1b\x20\x05\x4b\x6c\x1e\xb0\x57\xdc\xdf\xdd\x26\x31\x94\x63\x31\xee\xcb\x0d\xc2\xda\x63\x3b\x96\x55\xf8\x5d\xa6\x57\xc3\xda\x5f\x86\x4e\xf4\x13\x00\x00\xff\xff\xad\x79\xbd\xd2\x2a\x01\x00\x00"),
 		},
+		"/src/runtime/deterministic.go": &vfsgen۰CompressedFileInfo{
+			name:             "deterministic.go",
+			modTime:          time.Date(2026, 10, 18, 19, 44, 26, 672269073, time.UTC),
+			uncompressedSize: 611,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\x90\x4f\x8f\xd3\x3c\x10\xc6\xcf\xf5\xa7\x78\x5e\xeb\x3d\x34\xa2\x9b\xc2\x15\xb4\x27\xb6\x2a\x70\x61\xc5\xc2\x79\xe5\xc4\xd3\x64\x5a\x67\x1c\xd9\x93\xa2\x15\xda\xef\x8e\xdc\x3f\x54\x41\xe2\x66\x6b\x7e\xf3\x7b\x66\x66\xbd\xc6\x9b\x66\xe2\xe0\xb1\xcf\xe6\xf6\xe9\xe2\xd8\x53\xda\xe7\x67\x4f\x4a\x69\x60\xe1\xac\xdc\x1a\x33\xba\xf6\xe0\x3a\x42\x9a\x44\x79\x20\x63\x78\x18\x63\x52\xd8\x8e\xb5\x9f\x9a\xba\x8d\xc3\xfa\xda\x7b\x7b\xec\xb3\x35\x45\xfe\x98\x62\x97\xdc\x90\x51\x42\x14\x3f\x59\x7b\x68\x4f\xff\x48\x83\xba\x0e\x4b\xaa\xbb\x1a\xcd\x0b\xec\x15\x82\x52\x56\xdc\xdd\xcd\x58\x5b\x15\x7f\x6e\x7b\xf2\x53\x28\xc2\x14\x27\x65\xa1\x8c\x19\xe6\x42\x78\x81\x13\x5f\xe6\x47\x59\x20\x65\x44\x81\xc3\x91\x93\x4e\x2e\xa0\x0d\xb1\x3d\xd4\xf8\xde\x13\x32\x91\x07\x67\xa8\x3b\x90\x14\xfb\x2e\xc5\xe1\x34\xee\xf6\xeb\xe3\xa7\xcd\xb7\x2f\x4f\xcf\x4f\x9b\xcd\x03\x48\x8e\x9c\xa2\x0c\x24\x8a\xa3\x4b\xec\x9a\x40\x2b\xc4\x84\xb6\x8f\x99\x04\xc9\x89\x8f\xc3\x25\x77\x4c\x2c\x4a\xbe\x36\xbb\x49\x5a\xb0\xb0\x2e\x2b\xfc\x32\x8b\x53\xd8\xfb\x7b\xec\x73\xfd\x43\x3c\xed\x58\xc8\x9b\x05\xef\x30\xa6\xd8\x52\xce\x97\xda\x36\xc4\xc6\x85\x7a\x4b\xba\xb4\x97\x8a\xad\x3e\xfc\x81\xfe\x9b\x0b\x8a\xf8\x6c\xbe\xbf\x22\xe7\x56\x92\xa3\xad\xce\xcf\xd9\x2e\xb6\x32\x8b\x57\xb3\xb8\x05\x7d\x74\x21\x2c\xed\xff\x24\x65\xa9\x87\xd9\xc1\x57\xf8\x1b\x1b\x5d\xca\xf4\x59\xd4\xae\x4e\xb7\x5b\xe1\xdd\xdb\xaa\x32\xaf\xe6\xf7\x00\x9b\x25\x64\x62\x63\x02\x00\x00"),
+		},
 		"/src/runtime/go114_runtime.go": &vfsgen۰CompressedFileInfo{
 			name:             "go114_runtime.go",
-			modTime:          time.Date(2021, 2, 25, 13, 16, 17, 867212066, time.UTC),
-			uncompressedSize: 3510,
+			modTime:          time.Date(2026, 10, 18, 21, 24, 5, 577163615, time.UTC),
+			uncompressedSize: 3488,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x57\x4d\x6f\xdb\x38\x10\x3d\x8b\xbf\x62\xe0\x43\x20\x39\x89\x6c\x7d\xc4\x75\x5c\x2b\x8b\xed\x07\x8a\x02\x8b\xb6\xd8\xb6\x27\xc3\x5b\xd0\x16\x2d\x31\x95\x48\x97\xa4\x6c\x67\xdb\xfc\xf7\xc5\x50\x52\x2c\xc7\x69\xda\x2d\xd0\x53\xac\xe1\xf0\x71\xe6\xf1\x71\x66\x32\x18\xc0\xe9\xa2\xe2\x45\x0a\xd7\x9a\xec\x3f\x32\x19\xf8\x41\x4c\xc8\x9a\x2e\x3f\xd3\x8c\x81\xaa\x84\xe1\x25\x23\x84\x97\x6b\xa9\x0c\xb8\xc4\xe9\x35\xb6\x01\x17\x86\x29\x41\x8b\x81\xbe\xd1\x3d\xe2\xf4\x2a\xa1\xe9\x8a\xf5\x08\x71\x7a\x19\x37\x79\xb5\xf0\x97\xb2\x1c\x64\x72\x9d\x33\x75\xad\xf7\x3f\xae\x75\x8f\x78\x84\x2c\xa5\xd0\x16\x70\x30\x80\xe7\xf8\x9b\x0a\xa3\x61\x25\x15\x94\x55\x61\xf8\xba\xe0\x4b\x6a\xb8\x14\x13\x58\xc9\x4a\x81\xa2\x22\x95\x25\xc8\x34\x85\x28\x3c\x5f\x70\x03\xa2\x2a\x17\x4c\x69\x9f\x38\x65\x00\x09\x44\xc1\x68\x7c\x39\x0e\x2f\x46\x01\x71\xca\x10\x0d\x51\x74\x39\x1a\x47\xe1\xe5\x13\xe2\x94\x11\x24\x30\x8e\xc2\xf0\x32\x8a\x63\x74\x88\x21\x81\x30\x8a\x46\xd1\xe8\x62\x38\xbe\xc4\x78\x56\x95\x58\x42\xc9\xca\x9c\xea\xdc\x5d\x43\x9d\x8d\xff\x4e\xda\x34\xcf\x40\x33\x96\x9e\x81\x86\x8a\x0b\xb3\x36\xca\x6b\x7f\xc0\x57\xe2\xe4\x30\x49\xec\x77\x14\xba\xe8\x07\xa7\xa0\xfb\x88\xf3\x99\xdd\xcc\x86\x73\x8f\x18\xca\x8b\x09\x71\xf4\x96\x9b\x65\x8e\x3b\x96\x54\x33\xd0\x90\x24\x30\x9c\xdc\x7d\x4d\x21\x9e\x10\xc7\x49\xa9\xa1\x08\x78\xad\xfd\xd7\x0d\xc5\x6f\x17\xd7\x6c\x69\xdc\xb5\x57\x5b\x56\x74\xc9\x5c\xcf\x77\x67\xf3\xc5\x8d\x61\x1e\x71\x9c\x1c\xfe\xb9\x8b\x00\xf7\xdb\x53\x1f\x30\x07\x73\x0f\xa6\x53\x18\x3f\xb4\x16\xd6\x6b\xc1\xc8\x2e\x26\xa0\xa4\x29\x3e\x05\x17\x6e\xde\x2f\x03\x0f\xfa\x50\x86\xdd\xb8\x6d\xa8\x16\x43\x31\x9a\x7e\x14\xb4\xe0\x99\x60\x69\x14\xba\x6b\xef\xc7\x00\xd3\x04\xc6\xbf\x06\xf0\xf0\x16\x9a\xa6\xee\xfa\x0c\xf4\x79\xec\xfd\xdc\xe9\xc1\xe8\x37\x1c\xff\xf8\xe1\x3f\x88\x7c\xec\xfd\xb6\xb4\x53\xb6\xa2\x55\x61\x30\xe3\x4d\x80\xd2\xca\xf1\x57\x78\x5f\xb5\x7d\x68\x35\x1b\x58\xf5\x6c\xa2\xef\x7b\x84\xb5\x47\xfc\x7d\x8f\xc8\x7a\xe0\x7b\xd6\x70\x85\x84\xa3\xee\x6d\x00\xdf\xe3\x1c\xd7\xf6\x09\x6c\x82\x6e\xfa\xce\x1a\x12\xb8\xa3\xd9\x3a\x87\x8f\x00\x85\x5d\xa0\xb0\x5f\x86\x16\x28\x7a\x18\x28\x7a\x04\x28\xea\x02\x45\xfd\x32\xb2\x40\xf1\xc3\x40\xf1\x23\x40\x71\x17\x28\xee\x97\xb1\x05\x0a\x1e\x04\xd2\x70\x9e\xd4\xef\xf0\xb6\xb9\x55\x64\x0d\x30\x63\xc0\x68\x61\x83\x01\x64\xd2\x48\xc0\xca\x42\xd0\xcd\xea\x23\x87\xab\x2b\x08\x9e\xe0\x57\x3f\xb1\xf9\x76\xcc\x51\x6b\x8e\x0f\xcc\x23\xe2\x28\x66\x2a\x25\xda\x8a\xe6\xe6\x1e\xb9\x25\xd8\x14\xde\x48\xc3\x26\xf0\x21\x67\x9a\x81\x92\x95\xe1\x82\x69\x58\x33\xb5\x92\xaa\x04\x93\x33\x9b\x2b\x6c\xb9\xc9\x81\x82\xa0\x86\x6f\x18\x30\x91\x72\x2a\x04\xd3\xda\xaf\x4b\xea\x11\x1f\xf7\x4a\xab\xd7\xe8\x07\xe5\xf1\x05\xe5\xe4\xf6\x67\x71\x5d\xd8\x2c\x77\x7c\x05\xfa\x46\xfb\xcf\x78\xf6\xd2\x42\x5b\x19\x75\x22\x8e\x42\xf7\x0b\x8a\x0d\xbe\xed\x3f\xc3\xb9\x37\x9d\x8e\xbb\x96\x00\x2d\xc1\xa8\x6b\x1a\xa2\x29\x8c\x2d\x7b\xf7\xf1\x86\x87\x78\xc1\x11\x5e\x78\x8c\x17\x35\x78\x5d\xee\xb8\x00\xa9\x52\xa6\xc0\x48\xc8\x98\xb1\xa4\x2d\x65\xb9\xe6\x45\x6d\xe3\x5a\x57\xcc\xea\x02\xb8\xd0\x46\x55\x4b\x6c\x79\xfa\x0c\xb6\x0c\x41\x04\x3e\x29\x23\x61\xd9\xf4\x47\x58\xc9\x22\xb5\x20\x3a\xe7\x2b\x03\xb4\x94\x95\x30\xb0\xb8\x81\x9c\x8a\xd4\xc7\x2d\x1f\xde\xbe\x78\x3b\xc1\x0d\x1b\x2e\x96\xec\x67\x0f\x04\xba\x32\x4c\x01\x17\x05\x17\x5c\x64\xed\xd5\x35\x7a\xdd\x35\x49\x76\xaf\xaa\x61\xcc\xdd\xd9\x9e\x71\x81\x74\xb9\x3b\x14\x94\x1b\x85\x70\x8e\x96\x56\x45\xef\x73\x59\x15\x29\x2c\x18\x50\xc0\x29\xc3\x9c\x73\x61\xfb\xfc\xa1\x0e\xfe\x20\x83\x41\x26\x27\x42\xea\x75\xc1\x4d\x1d\x80\x7d\x15\x47\xad\x78\xd7\x69\xc2\x07\x4b\x18\xd7\x2f\x74\xcf\xf6\xf2\x0f\xb0\xdc\x63\x00\xdb\x25\x77\x93\xb9\xe7\x7f\xb4\xae\xee\x5d\x8a\x95\x66\x29\xde\x35\xd6\xbe\xaf\x51\x78\x36\x8a\x6f\xfd\x4c\x22\xdf\xb6\x28\xe2\x25\xe0\x12\x60\x52\xc8\x37\xd9\x50\xd5\x16\x4a\x98\xc5\xf3\x26\x1f\x62\xed\xcd\x0c\xf2\x29\xa7\xfa\x13\x17\xdc\xc0\x42\xca\xe2\x70\x3e\xb1\x76\xd7\xc3\x7c\xf9\xea\x78\xc3\xfe\x85\x58\x71\x1f\xad\x27\x60\x54\xc5\x88\xd3\x46\x01\xb3\x60\x64\xc9\x20\x4e\xc6\xcc\xdf\x76\xd2\x7a\x41\x0d\x75\x71\x71\x36\xc1\x42\x2e\x17\xd7\x0d\xab\x6f\xd8\xf6\x4f\xa5\xe8\xcd\xb3\x6a\xb5\x62\xaa\xe3\xb2\x69\x1c\x5e\x15\x72\x41\x0b\xff\x15\x33\x6e\xef\xa3\xd5\x8b\xf5\xef\x79\xb8\xd5\x95\x8b\x6b\x8f\xd8\xb6\xc0\xd1\x7f\xf8\x14\x38\x4c\x61\xe3\xff\xc5\x44\x66\x72\xd7\x7b\x0a\xfc\xf4\xd4\x66\xd0\x36\x12\x3e\xc7\x02\xe8\xbf\x16\x29\xdb\xb9\x7c\x4f\x7e\x5d\xf6\xee\xa6\x2c\xf8\x96\x40\x00\x83\x01\x94\xf4\x33\x03\x5d\x29\x2b\x7e\xcd\xda\x39\x11\xa8\x62\x38\x3e\xee\xf7\x04\xf5\x9e\xbd\x21\xbc\x6f\x88\x1a\x83\xbd\xe5\xfb\xe2\x3c\xa4\x4a\x41\xa3\x27\x8c\x9d\xed\x0c\x13\x69\xbd\xea\xaa\x33\x18\xb6\x42\xe9\x2e\x34\x1f\xba\xae\xa4\xb5\xa9\x8d\x95\x0b\x50\xb3\x89\x98\xa3\x84\x70\x79\x9b\xcb\x82\x81\x2e\xf8\x92\x81\xaa\xdf\xb9\x62\xd4\x68\x10\xd3\x21\x50\x0d\x22\x49\x86\xcd\xa3\x3d\x3c\xbb\x89\xea\x0c\x04\x70\x61\x5a\xc5\x08\x98\xc2\xd0\x92\x2c\x20\x81\xa1\x65\x12\xaf\x04\xed\x05\x13\xae\xb2\x8e\x38\x99\xbf\xb4\x68\x6d\x78\x0b\x6e\x34\x54\x9a\x8b\xec\x50\xcf\x70\x02\xf8\x2f\x81\x55\x3b\x71\x9c\x2d\xde\xac\x20\x0e\x1e\xb5\x85\xab\xbb\xde\xbf\x85\x4e\x5b\xb3\x2f\xe3\x58\x31\x2f\xa8\x61\x8d\x54\x3c\xff\x39\x2d\x0a\xb7\x97\x31\xf3\x81\x97\x68\xdd\x5f\x7d\x3d\x73\xb7\xe3\xfa\xbd\xa7\x7b\xa2\x66\xe2\x7c\x3b\xf7\xda\xa9\xbd\x6d\x6c\x5b\xaf\x1d\x49\xba\xda\xc3\xee\xf2\xce\xa8\xf7\xfc\x5f\x06\x27\x27\x1d\x0e\xf6\x52\x74\xd4\x4c\xa0\x0a\x91\x4a\x6c\x8f\x8e\xe3\x88\xd3\x53\xfc\x83\x3d\x34\xb1\xf3\xf4\x2d\xb2\x78\xdb\xbc\x53\x41\x85\x44\x46\x5c\x0f\x69\x1f\xc5\x9d\x8a\xf9\x7f\xf2\x7d\x8d\x7b\x5d\x9c\x14\x02\x36\x22\xb7\xe4\xbf\x00\x00\x00\xff\xff\x82\xa9\xd0\x5f\xb6\x0d\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x57\xdd\x6e\xdb\x38\x13\xbd\x16\x9f\x62\x60\x7c\x08\x24\x27\x91\xad\x9f\xb8\x8e\x6b\xe5\xc3\xf6\x07\x45\x81\x45\x5b\x6c\xdb\x2b\xc3\x5b\xd0\x16\x25\x31\x95\x48\x97\xa4\xec\x64\xdb\xbc\xfb\x62\x28\x29\x96\x13\xb7\x5b\x14\xe8\x55\xac\xe1\xf0\x70\xe6\xf0\x70\x66\x32\x1a\xc1\xe9\xaa\xe6\x65\x0a\xd7\x9a\xec\x3f\x72\x19\xf8\x41\x4c\xc8\x86\xae\x3f\xd3\x9c\x81\xaa\x85\xe1\x15\x23\x84\x57\x1b\xa9\x0c\xb8\xc4\x19\xb4\xb6\x11\x17\x86\x29\x41\xcb\x91\xbe\xd5\x03\xe2\x0c\x6a\xa1\x69\xc6\x06\x84\x38\x83\x9c\x9b\xa2\x5e\xf9\x6b\x59\x8d\x72\xb9\x29\x98\xba\xd6\xfb\x1f\xd7\x7a\x40\x3c\x42\xd6\x52\x68\x0b\x38\x1a\xc1\x73\xfc\x4d\x85\xd1\x90\x49\x05\x55\x5d\x1a\xbe\x29\xf9\x9a\x1a\x2e\xc5\x0c\x32\x59\x2b\x50\x54\xa4\xb2\x02\x99\xa6\x10\x85\xe7\x2b\x6e\x40\xd4\xd5\x8a\x29\xed\x13\xa7\x0a\x20\x81\x28\x98\x4c\x2f\xa7\xe1\xc5\x24\x20\x4e\x15\xa2\x21\x8a\x2e\x27\xd3\x28\xbc\x7c\x42\x9c\x2a\x82\x04\xa6\x51\x18\x5e\x46\x71\x8c\x0e\x31\x24\x10\x46\xd1\x24\x9a\x5c\x8c\xa7\x97\x18\x4f\x56\x8b\x35\x54\xac\x2a\xa8\x2e\xdc\x0d\x34\xd9\xf8\xef\xa4\x4d\xf3\x0c\x34\x63\xe9\x19\x68\xa8\xb9\x30\x1b\xa3\xbc\xee\x07\x7c\x25\x4e\x01\xb3\xc4\x7e\x47\xa1\x8b\x7e\x70\x0a\x7a\x88\x38\x9f\xd9\xed\x62\xbc\xf4\x88\xa1\xbc\x9c\x11\x47\xef\xb8\x59\x17\xb8\x63\x4d\x35\x03\x0d\x49\x02\xe3\xd9\xfd\xd7\x1c\xe2\x19\x71\x9c\x94\x1a\x8a\x80\xd7\xda\x7f\xdd\x52\xfc\x76\x75\xcd\xd6\xc6\xdd\x78\x8d\x25\xa3\x6b\xe6\x7a\xbe\xbb\x58\xae\x6e\x0d\xf3\x88\xe3\x14\xf0\xf7\x7d\x04\xb8\xdf\x9e\x7a\xc4\x1c\x2c\x3d\x98\xcf\x61\x7a\x6c\x2d\x6c\xd6\x82\x89\x5d\x4c\x40\x49\x53\x7e\x0a\x2e\xdc\x62\x58\x05\x1e\x0c\xa1\x0a\xfb\x71\xc7\xb3\x0e\x43\x31\x9a\x7e\x14\xb4\xe4\xb9\x60\x69\x14\xba\x1b\xef\xbf\x01\xe6\x09\x4c\x7f\x0d\xe0\xf8\x16\x9a\xa6\xee\xe6\x0c\xf4\x79\xec\xfd\xdc\xe9\xc1\xe4\x37\x1c\x1f\x7b\xbf\xbc\x55\x9f\x4f\xbd\xdf\x96\x76\xca\x32\x5a\x97\x06\x33\xde\x06\x28\xad\x02\x7f\x85\x0f\x55\x3b\x84\x4e\xb3\x81\x55\xcf\x36\xfa\xbe\x47\xd8\x78\xc4\xdf\xf7\x88\xac\x07\xbe\x67\x0d\x57\x48\x38\xea\xde\x06\xf0\x3d\xce\x71\x6d\x9f\xc0\x36\xe8\xa7\xef\x6c\x20\x81\x7b\x9a\xad\x73\xf8\x03\xa0\xb0\x0f\x14\x0e\xab\xd0\x02\x45\xc7\x81\xa2\x1f\x00\x45\x7d\xa0\x68\x58\x45\x16\x28\x3e\x0e\x14\xff\x00\x28\xee\x03\xc5\xc3\x2a\xb6\x40\xc1\x51\x20\x0d\xe7\x49\xf3\x0e\xef\xda\x5b\x45\xd6\x00\x33\x06\x8c\x16\xb6\x18\x40\x2e\x8d\x04\xac\x2c\x04\xdd\xac\x3e\x0a\xb8\xba\x82\xe0\x09\x7e\x0d\x13\x9b\x6f\xcf\x1c\x75\xe6\xf8\xc0\x3c\x21\x8e\x62\xa6\x56\xa2\xab\x68\x6e\xe1\x91\x3b\x82\x4d\xe1\x8d\x34\x6c\x06\x1f\x0a\xa6\x19\x28\x59\x1b\x2e\x98\x86\x0d\x53\x99\x54\x15\x98\x82\xd9\x5c\x61\xc7\x4d\x01\x14\x04\x35\x7c\xcb\x80\x89\x94\x53\x21\x98\xd6\x7e\x53\x52\x1f\xf1\xf1\xa0\xb4\x7a\xad\x7e\x50\x1e\x5f\x50\x4e\xee\x70\x11\x37\x85\xcd\x72\xc7\x33\xd0\xb7\xda\x7f\xc6\xf3\x97\x16\x1a\xfd\xfa\x11\x47\xa1\xfb\x05\xc5\x06\xdf\xf6\x9f\xe1\xd2\x9b\xcf\xa7\x7d\x4b\x80\x96\x60\xd2\x37\x8d\xd1\x14\xc6\x96\xbd\x87\x78\xe3\xa5\xf7\x68\xf7\xf4\xd1\x09\x87\x78\x51\x8b\xd7\xe7\x8e\x0b\x90\x2a\x65\x0a\x8c\x84\x9c\x19\x4b\xda\x5a\x56\x1b\x5e\x36\x36\xae\x75\xcd\xac\x2e\x80\x0b\x6d\x54\xbd\xc6\x96\xa7\xcf\x60\xc7\x10\x44\xe0\x93\x32\x12\xd6\x6d\x7f\x84\x4c\x96\xa9\x05\xd1\x05\xcf\x0c\xd0\x4a\xd6\xc2\xc0\xea\x16\x0a\x2a\x52\x1f\xb7\x7c\x78\xfb\xe2\xed\x0c\x37\x6c\xb9\x58\xb3\x9f\x3d\x10\x68\x66\x98\x02\x2e\x4a\x2e\xb8\xc8\xbb\xab\x6b\xf5\x7a\xd3\x26\xd9\xbf\xaa\x96\x31\xf7\xc6\xf6\x8c\x0b\xa4\xcb\xbd\x41\x41\xb9\x51\x08\xe7\x68\xe9\x54\xf4\xbe\x90\x75\x99\xc2\x8a\x01\x05\x9c\x32\xcc\x39\x17\xb6\xcf\x1f\xea\xe0\xff\x64\x34\xca\xe5\x4c\x48\xbd\x29\xb9\x69\x02\xb0\xaf\xe2\x51\x2b\xbe\xe9\x35\xe1\x83\x25\x8c\xeb\x17\xba\x67\x77\xf9\x07\x58\xee\x63\x00\xdb\x25\x6f\x66\x4b\xcf\xff\x68\x5d\xdd\xfb\x14\x6b\xcd\x52\xbc\x6b\xac\x7d\x5f\xa3\xf0\x6c\x12\xdf\xf9\xb9\x44\xbe\x6d\x51\xc4\x4b\xc0\x25\xc0\xa4\x90\x6f\xb2\xa5\xaa\x2b\x94\xb0\x88\x97\x6d\x3e\xc4\xda\xdb\x19\xe4\x53\x41\xf5\x27\x2e\xb8\x81\x95\x94\xe5\xe1\x7c\x62\xed\xae\x87\xf9\xf2\xec\xf1\x86\xfd\x0b\xb1\xe2\x7e\xb4\x9e\x80\x51\x35\x23\x4e\x17\x05\x2c\x82\x89\x25\x83\x38\x39\x33\x7f\xd9\x49\xeb\x05\x35\xd4\xc5\xc5\xc5\x0c\x0b\xb9\x5c\x5d\xb7\xac\xbe\x61\xbb\x3f\x94\xa2\xb7\xcf\xea\x2c\x63\xaa\xe7\xb2\x6d\x1d\x5e\x95\x72\x45\x4b\xff\x15\x33\xee\xe0\xa3\xd5\x8b\xf5\x1f\x78\xb8\xd5\x95\xab\x6b\x8f\xd8\xb6\xc0\xd1\x7f\xfc\x14\x38\xcc\x61\xeb\xff\xc9\x44\x6e\x0a\xd7\x7b\x0a\xfc\xf4\xd4\x66\xd0\x35\x12\xbe\xc4\x02\xe8\xbf\x16\x29\xbb\x71\xf9\x9e\xfc\xa6\xec\xdd\x4f\x59\xf0\x2d\x81\x00\x46\x23\xa8\xe8\x67\x06\xba\x56\x56\xfc\x9a\x75\x73\x22\x50\xc5\x70\x7c\xdc\xef\x09\x9a\x3d\x7b\x43\xf8\xd0\x10\xb5\x86\x3b\x72\x44\x9c\x87\x54\x29\x68\xf5\x84\xb1\xb3\x1b\xc3\x44\xda\xac\xba\xea\x0c\xc6\x9d\x50\xfa\x0b\xed\x87\x6e\x2a\x69\x63\xea\x62\xe5\x02\xd4\x62\x26\x96\x28\x21\x5c\xde\x15\xb2\x64\xa0\x4b\xbe\x66\xa0\x9a\x77\xae\x18\x35\x1a\xc4\x7c\x0c\x54\x83\x48\x92\x71\xfb\x68\x0f\xcf\x6e\xa3\x3a\x03\x01\x5c\x98\x4e\x31\x02\xe6\x30\xc6\xdf\x8e\x80\x04\xc6\x96\x49\xbc\x12\xb4\x97\x4c\xb8\xca\x3a\xe2\x64\xfe\xd2\xa2\x75\xe1\xad\xb8\xd1\x50\x6b\x2e\xf2\x43\x3d\xc3\x09\xe0\xbf\x04\x56\xed\xc4\x71\x76\x78\xb3\x82\x38\x78\xd4\x0e\xae\xee\x7b\xff\x0e\x7a\x6d\x0d\x7d\x8f\x28\xe6\x05\x35\xac\x95\x8a\xe7\x3f\xa7\x65\xe9\x0e\x72\x66\x3e\xf0\x8a\x0d\xfa\x57\xdf\xcc\xdc\xdd\xb8\xfe\xe0\xe9\x9e\xa8\x85\x38\xdf\x2d\xbd\x6e\x6a\xef\x1a\xdb\xce\xeb\x46\x92\xbe\xf6\xb0\xbb\xbc\x33\xea\x3d\xff\x87\xc1\xc9\x49\x8f\x83\xbd\x14\x1d\xb5\x10\xa8\x42\xa4\x12\xdb\xa3\xe3\x38\xe2\xf4\x14\xff\x60\x0f\x4d\xec\x3c\x7d\x87\x2c\xde\xb5\xef\x54\x50\x21\x91\x11\xd7\x43\xda\x27\x71\xaf\x62\xee\xf3\x6d\xb2\xfb\x5f\xe7\x3b\xb0\xf5\x69\x12\xbb\x28\x96\x7f\x07\x00\x55\xc1\xb1\x59\xa0\x0d\x00\x00"),
 		},
 		"/src/runtime/pprof": &vfsgen۰DirInfo{
 			name:    "pprof",
@@ -703,10 +728,10 @@ var FS = func() http.FileSystem {
 		},
 		"/src/runtime/runtime.go": &vfsgen۰CompressedFileInfo{
 			name:             "runtime.go",
-			modTime:          time.Date(2021, 2, 25, 13, 16, 57, 885286199, time.UTC),
-			uncompressedSize: 6743,
+			modTime:          time.Date(2026, 10, 18, 23, 6, 44, 580767002, time.UTC),
+			uncompressedSize: 7158,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x58\xeb\x72\xdc\xb6\x92\xfe\x4d\x3e\x45\x2f\x6b\x37\x21\xe5\x31\x39\x23\xdb\x72\x56\x65\xb9\xca\x9e\x44\x8a\x6b\xad\x4b\x79\xe4\xdd\x54\x79\x9d\x14\x86\x6c\xce\x40\x03\x02\x0c\x00\x6a\xa4\xb8\xf4\x00\xe7\x41\xce\x8b\x9d\x27\x39\xd5\x00\x6f\x23\xc9\xb9\x9c\x3a\xf3\x43\x22\x1b\x5f\x37\x1a\x7d\x43\x37\xb3\x0c\x9e\x2c\x1b\x2e\x0a\xb8\x32\x61\x58\xb3\x7c\xc3\x56\x08\xba\x91\x96\x57\x18\x86\xbc\xaa\x95\xb6\x10\x87\x41\xd4\xd2\x32\x2e\x2d\x6a\xc9\x44\x66\x6e\x4d\x14\x06\x51\x23\x0d\x2b\x31\x0a\xc3\x20\x5a\x71\xbb\x6e\x96\x69\xae\xaa\x6c\xa5\xea\x35\xea\x2b\x33\x3c\x5c\x99\x28\x4c\xc2\x30\x57\xd2\x58\x38\x39\x3f\x5f\xc0\x11\x98\x5b\x93\xd2\x63\x4f\x7d\xf3\x61\xfe\x23\x1c\x41\x44\x60\x4f\x9b\xab\xaa\xe6\x02\x35\x51\x3b\x59\x51\x18\xda\xdb\x1a\x01\x4b\x96\x23\x18\xab\x9b\xdc\xc2\x97\x30\xf8\xc5\x51\xf7\xdc\xbf\x30\x28\x98\x65\x00\x5e\xbd\xf4\x42\x39\xbd\xc3\xbb\x96\xd5\x43\x07\x56\x63\x35\xd0\x2b\x97\xab\x30\xd8\x70\x59\x40\xc3\xa5\xfd\x8e\xf0\x65\x23\x73\x88\x6d\x2b\x37\x69\x51\x71\xf7\x40\xdc\x1a\x6d\xa3\x25\xd8\xd4\x58\xfd\x18\x4b\xbd\x59\xd5\xcc\xae\x1f\xe3\x89\x22\x62\xc8\x32\x78\x23\x01\xb5\x56\x7a\xe1\x11\x1a\x6b\x8d\x06\xa5\x35\xc0\x3a\x7f\x78\x00\x14\x68\x72\xcd\x97\x58\xc0\xf2\x16\x18\x18\x2e\x57\x02\x5b\xc9\x69\x6b\x99\x91\xa4\xf6\x54\xad\x52\x3b\x6b\x09\x7c\xf0\x92\x7f\x20\x5a\x9c\xc0\x97\xbb\xaf\xe0\x3a\xc0\x43\xfd\x77\x74\x3b\x84\x08\x9e\x74\x16\xc2\xa4\xb7\x05\x97\xdc\x92\xf8\x30\xb8\x32\x17\x9b\x15\x1c\x1e\xc1\x95\x49\x4f\x84\x5a\x32\x91\x9e\xa0\x8d\xa3\xff\x6c\x63\xcf\x44\x89\x27\xfc\x51\x30\x25\x24\xab\x13\xb1\x70\x22\xae\xcc\xf9\xf2\x0a\x73\x7b\x61\x75\x34\x01\xb7\x93\x97\xe5\xc9\x9d\xe4\xda\xea\x28\x79\x94\xdd\x9d\xf2\x01\xb7\xa3\xfe\x11\xb3\x5d\x6b\xb5\x1d\x5b\xd3\xc9\x48\xdf\xb5\xd9\xe2\x35\x88\x1d\x8a\xd8\xb3\x0c\xd8\xb5\xe2\x05\x14\xc8\x0a\xc8\x55\x81\x80\x82\x57\x5c\x32\xcb\x95\x0c\x83\x6b\xa6\xa1\xb5\x69\x18\x20\x1c\xc1\x37\x97\xb7\x35\xbe\x31\x06\x35\x01\xdc\x0e\x5f\xee\xc2\xe0\x17\x38\x02\xec\xcd\x7c\x72\xfe\xe1\xfc\xfc\x72\xc7\x4d\xb5\x56\x39\x1a\xf3\x88\xc5\xdb\x15\x32\x24\x2f\xa1\xc3\x1d\x39\xdc\x47\x59\x60\xc9\x25\x16\x24\xa2\x77\x75\x16\x85\xc1\x5d\x18\xac\x94\x56\xca\x92\xc4\x96\xc9\xcb\x43\x79\xdd\x19\xc9\xeb\xd1\x4a\x6e\xe1\xff\xf1\x75\xc1\x1e\x91\x2e\xda\xc4\x72\x9b\x64\x99\xab\x0e\xdf\x63\xc9\x1a\x61\x4f\xbc\x0c\x6e\x40\xaa\x2d\xac\x94\xc4\x09\xe4\x4c\x7e\x6b\xa1\x31\x08\xdc\x02\x33\x50\x32\x21\x96\x2c\xdf\x00\x93\xb7\x95\xd2\x98\x3a\x21\x97\xe7\xdf\x9f\x1f\xc2\x02\x11\x78\x09\x0c\x96\x68\x2d\x6a\x30\x4a\x34\x64\x47\x27\x11\xb1\xc0\x22\x1d\x22\x3a\x6b\x8c\xce\x84\xca\x99\xc8\x56\x2a\xea\x8d\xfb\x56\x23\xdb\xd4\x54\x47\xba\x48\x4e\xbf\xc7\x65\xb3\x5a\xa1\x8e\x87\x48\x9f\x33\x21\x50\xc7\x66\xc3\x6b\xe0\xd2\x26\x10\xd7\xb9\xab\x25\xb5\xd5\x13\x28\x79\x9f\xa9\x13\x10\x5c\x22\x61\x26\xa0\x36\xb0\x54\x4a\x38\xb1\x5c\x96\xea\x11\x6f\x75\x41\x78\x86\xdb\xb8\xb5\xb2\xb1\x2c\xdf\x44\x49\x4a\x5b\xc6\x91\xa9\x05\xb7\xd1\x04\xa2\xff\x97\x51\x92\xbe\x93\x05\xde\x78\x2d\x9e\xc0\xbe\x77\x84\x93\xfc\x3b\xfe\x9d\x4e\x20\x8a\x26\xf4\xaf\x64\xc2\xa0\x73\x43\xcd\xb4\x75\xc1\x43\xcc\xdd\x4e\xcd\xd2\x1f\x21\x9a\x8c\xc9\x9c\xb6\x3c\x2f\x49\x85\xd8\x69\x60\xe3\xe4\xc9\xec\x6b\x90\xa4\x83\x3c\xd0\xff\x90\xe2\x66\x50\xc9\x69\xd0\x9e\x67\x9a\xf4\x41\xb2\xbb\x30\x6b\x85\x4d\xc0\xea\x06\xef\x39\xc3\xf4\xde\x98\x40\x9d\xc3\xa7\xcf\xad\x3b\x12\x22\x8d\x4a\xd9\xb4\xad\xc4\x2d\xd7\xb1\x66\x15\x1a\x1f\x73\x16\x78\x55\x0b\xac\x50\x5a\x2c\xa0\x54\xba\xbd\xab\x8e\xae\x4c\x1a\xf6\x51\xf6\xae\xc3\x50\xac\xd5\xca\x18\xbe\x14\x98\xee\xa8\xe2\x85\xc6\xb9\x7f\x1b\xeb\xb2\xd7\xee\xf7\x05\x5a\x75\xbe\xf1\x84\x2f\x77\xd0\x5d\x5a\x2d\xc2\xdf\x5a\x43\xa9\xce\x79\xc7\x9c\xc0\x19\xde\x50\x78\xc6\x25\xbd\x7b\x86\x09\x50\x36\x74\x01\xd6\x49\xdf\x91\x39\xba\x08\x2f\xe6\xe0\x7f\xad\x62\x61\x70\x4c\x9b\xd0\x6f\x8f\x9e\xfc\xbb\xcb\x9d\xee\xba\x3c\xa6\xa0\xa6\x5f\x47\x78\x4f\x81\x4d\x3f\x2e\x6d\x18\xfc\x20\xad\xbe\x1d\x4b\xec\xab\xd5\xdc\x25\x52\xff\xaa\xf0\x66\xb8\x25\x76\x2f\x87\xbc\xd1\x54\x02\x1a\xcb\x25\x46\x89\x2f\xb9\x84\x8e\xbc\xc3\x77\xea\xb1\x0f\x27\x5f\x90\xa3\x09\x48\x2e\x92\x51\x81\x3c\x7d\xf3\xd3\xc5\x87\xf3\xf9\x22\x96\x3e\x3d\x77\x43\x60\x36\xd2\xc6\xe4\x6b\x2c\xbc\x3a\x39\x65\x40\xc5\x36\x18\xe7\x6b\x26\x7b\x07\x3c\xb6\xad\x41\x7b\xc9\x2b\x54\x8d\x7d\xf4\x02\x20\xd9\x24\x13\x72\xa1\x0c\xc6\x79\x02\x77\xc9\x04\xa6\x49\x18\xbc\x7a\x9a\xf7\x9b\x9f\x35\xd5\xfc\xe2\x63\xfc\x75\xed\xce\x9a\xaa\xb7\xc7\x03\xd8\x7d\xe3\x59\x65\x99\xe8\xe1\xa6\x4b\xbc\xbe\x17\x3a\xc5\x6a\x61\x99\x35\xa3\x28\xc8\x32\x38\x41\x89\x9a\x09\x30\x96\x59\x6e\x2c\xcf\x4d\x1a\x06\x6f\x84\x50\xf9\x10\x1f\x07\xcf\x21\xcb\x60\x79\x6b\xd1\x00\xa3\x25\x46\xe9\xc1\x64\x01\xc6\x72\x21\x80\x4b\xaa\xcf\x61\x70\x49\x1a\x78\xde\xaf\xb3\xc5\x78\x8d\x92\x32\xa7\xd4\x88\x45\x12\x06\x8b\x5b\x03\xf0\xf8\x66\x6a\x69\x99\x2b\x5f\xa5\x56\x15\x5d\x14\x16\x2b\x88\x4d\x53\x81\x2a\xe1\xa7\x9b\x1b\x62\x5d\xa2\x50\xdb\x24\x0c\xde\x2b\xb5\x69\x6a\xb3\x2b\x46\x36\xd5\x12\x35\xa1\x6b\xdf\x19\x82\xf0\xb0\x30\x38\x75\x2a\x7d\x15\x5f\xf9\xe5\x30\x38\xd6\x88\xe6\xbe\x7a\x03\x8e\x4e\x61\x42\x67\xca\x53\xc6\x65\x77\x50\x4a\x9c\x35\xb2\x7a\xd7\xae\x3f\x22\xab\x7b\xdb\xfe\x15\xcb\x12\x63\x6f\xa7\x3f\x63\x25\xcf\xf2\xae\x68\x53\xf6\x3e\x0b\x97\xc0\x69\xcd\xd4\x4c\x9a\x16\x2b\xe9\x8e\x7d\x1c\x2b\x95\x7c\xda\xe3\x3d\xfc\x03\x0a\x64\x06\x8b\x07\x70\xdd\x2d\x58\x05\x76\x8d\x70\xbe\xf0\x0c\x3e\x33\xcc\x58\xbe\x8b\xd8\x91\x2d\x07\x0b\x28\x0f\xf6\x76\x7d\xaf\xb6\x4f\x05\x5e\xa3\x80\x92\xdf\x60\xf1\xd4\xf0\xdf\xba\x52\xd6\x68\xec\xb8\x94\xde\xb5\x75\x96\x05\xfe\x48\xdc\xb4\x9a\x35\xa4\x95\x54\x5b\xbf\x48\xe6\xec\x97\x1e\x33\x61\x1a\x06\x0b\xba\x7a\x5b\xc3\xdc\x3f\xa7\x93\xb6\xbc\x05\x77\x3d\x0f\x4a\xb4\x4c\xad\xb3\x3c\x53\x18\x9c\x2e\x6a\x26\x1f\x08\xaa\xc8\x9c\xc3\x49\x4c\x8b\xbb\xcf\x3b\x67\xf9\x1a\x3d\xf3\x88\x37\x27\xea\x2e\xb3\x03\x7a\xee\x8e\xf9\x6d\x93\x6f\x7e\x64\x66\x4d\xd4\x81\xb9\xd6\xaa\xe4\x82\x5a\xc7\x65\x93\x6f\xd0\xc2\x9a\x99\x35\x58\xb6\x14\x18\x06\x27\xf3\x21\x23\x07\x96\x93\x39\x54\x68\x19\x4d\x5b\x61\x70\x6e\xd7\xa8\x77\xd4\x24\x88\x22\x6a\x97\xa5\x43\x1e\xb4\x5e\x3c\x61\x7a\x49\x43\x67\xae\x84\xc0\xfc\x81\xbb\xe8\x46\x3b\x99\x3f\x2c\x04\x12\x6f\x6c\xc7\x43\x49\xb5\xa5\xb4\x58\xb3\xba\x46\x09\xdb\x35\x4a\x18\x72\xea\x1f\x7f\xfb\x3b\xd8\x35\x37\xc0\x2a\xd5\xd0\x95\xf4\x9e\x99\x47\x65\xa2\x2c\xc0\x0d\x33\xaa\x04\xc1\xcc\x8e\xfc\x58\x32\xa9\x0c\xe6\x4a\x16\x86\x66\xae\x1c\x61\xf6\xdf\x2f\xa9\x72\x5f\xb0\xc6\xa0\x2b\x71\x67\x66\x30\xb0\xa3\x9e\x75\xf6\xfa\xb4\xff\xe2\xe0\xf3\xb0\x51\xce\x75\xde\x08\xa6\x61\xd9\x94\xa5\x8f\x71\x8d\x39\x75\x0e\x27\x73\xa8\x89\x13\x8a\x46\x7b\x2b\xd1\xfd\x6d\x6c\xb7\xce\x2c\x7c\x8a\xa9\xfc\xcf\x9f\xec\xbf\x78\x91\xfc\x17\xc9\x6d\x37\xfb\x41\x16\xff\xea\x66\xdd\xc1\x4d\x18\x38\xd9\x30\xb6\xcd\xb3\x7d\xf2\xfd\xfc\xe2\xe3\xb1\x66\xde\x16\xa5\x50\xac\x15\x5e\x76\x34\x55\xc2\xfc\xe2\xa3\x37\x5f\x97\x02\x27\x73\xba\xfe\x29\x7a\x3a\x91\xd4\x85\x84\x81\xeb\x9b\xfb\x5d\x1c\xcd\x85\xc2\x05\x6a\x9f\xc4\xa3\x62\x79\x2f\x77\xe1\x60\x46\xd9\x79\xd6\x54\x0b\xfe\x1b\xce\x05\x33\xc6\x97\x22\x2a\x29\x73\x37\x49\xa5\x61\xf0\xf6\x96\x56\xe1\xd3\xc1\xec\xf3\x70\xa9\x05\x8e\x36\x3a\x54\x5f\xea\x3b\x9f\xf5\x35\xbd\x23\xdc\xf5\x37\xee\x07\x64\x45\x77\x51\xc6\x15\xec\x75\xcf\xe3\x0e\x66\x81\xf6\x98\x4b\x26\xf8\x6f\xa8\xe3\x9b\x09\x50\xcb\x6d\x51\x97\x2c\xc7\x2f\x77\x2d\xd0\x37\x5d\x84\x1e\x14\x53\x35\xfb\xb5\xc1\xbe\xad\x20\xb3\x36\x12\x6f\x6a\xa5\x5d\xb7\xc9\x51\xb8\xa2\x59\x70\x43\xfa\x6e\x21\x57\xf2\x1a\xb5\x71\x29\xd4\x77\x81\xbf\xf8\xfe\x2c\x01\xd7\x6f\xc5\x49\xd7\x6e\xc1\xef\xfe\xfa\x7e\x70\x0a\x77\xf7\x05\x51\x5f\x47\xad\xdc\x68\x82\xa1\xce\xf2\xb1\x11\x66\xd4\x58\xba\x11\xe2\xa1\xb0\x33\x56\xe1\x30\x98\xc2\x9f\xd4\x2a\x8a\xa0\x3b\x20\x89\x39\x56\xfa\x62\xbe\xa3\x8e\x93\x3e\xea\x7d\x24\x17\x64\x12\x1a\x9f\x4f\xb1\xba\x70\xe5\x0c\x3f\x30\xeb\xb4\x84\x23\x78\x31\xdb\x87\x3d\x98\x4d\xf7\x9f\x0f\x3e\x7b\x2b\x54\xbe\x19\x41\x63\xdd\xe2\xef\xf9\xf6\xb4\xb1\x78\xd3\xe2\xba\x54\x18\x61\xdb\x26\x6c\x98\x06\xe4\x35\x1a\xcb\x57\x04\xa0\xea\x93\xc2\xbb\x12\xb8\xfd\xd6\xf4\xa3\x01\x39\xb5\x9f\x2b\x26\xe4\x56\xc3\x0b\xd4\x50\x28\xf7\xdd\x46\x4d\x7c\xe5\xdc\x72\x83\xa0\xb1\x52\xd7\x5e\x10\xe4\xaa\x22\x8e\x74\x77\x72\xf1\x6a\xd2\x1d\x13\x2f\x9b\x12\x3e\x7d\xa6\xeb\x68\x42\xa9\xd4\xf6\xfe\xad\x82\xe6\x2f\x4d\x97\x6e\x72\xfc\xdd\xcf\x02\x53\x37\x28\xb6\x2f\xb9\xaa\x6f\x69\xfb\x09\x98\x9d\x69\x31\x1a\x08\xa3\x21\xb0\x1d\x55\xdd\xa0\x38\x8c\x76\x43\xbb\xfe\x5e\xe5\x9b\xf3\xc5\xe5\x5a\x23\x2b\xc6\x5f\xa7\x3e\x4a\xf1\x95\x95\xff\xf5\x79\xf1\xd8\x97\x2a\x73\x6b\xd2\xcb\x35\xb6\x88\xb1\xc5\xb4\xbd\xd4\x2c\xa7\xf0\xf4\x1f\xd8\xfa\xf0\x93\x5c\x74\x91\xbc\xb0\xaa\xee\x50\x5d\x94\xde\x0d\xa5\xa1\x5b\xf2\x56\x77\x63\xe4\xff\x21\x94\x6c\x83\xc0\x20\x5f\x29\x40\x79\xcd\xb5\x92\x6e\x3a\xb4\x0a\x72\x66\xf3\xb5\xdf\xce\xa4\x70\xb9\x46\x8d\x34\x55\x6e\x11\xd6\xec\x7a\x37\x30\xda\xab\x4b\x16\xc0\xc4\x96\xdd\x9a\x3e\x63\x87\x59\x61\xa5\x9c\x69\x9d\x8b\x0f\x9e\xdf\x1f\x69\x1d\xcc\x7d\x2b\x3d\x2f\x63\xac\x61\x6f\xa7\x2a\xed\xf9\xaf\xa8\x5f\x68\xd6\x97\x3c\x8f\xa3\x16\x79\xe8\xc6\x5e\xd3\xd4\xbe\x0c\x45\x83\x57\xfe\x07\xb1\x7e\x23\xf8\x35\xc6\xbb\xe5\xad\x5b\x77\x93\x57\x6c\x5a\x0f\x24\x83\xe8\xd1\x17\xc5\xd8\x78\x37\x53\xb6\xac\xd1\x20\x30\x3d\x5c\x1b\x0e\xbd\xd5\xac\x4e\xe1\xec\xdf\x30\x7a\xaf\xd0\xfa\x79\xbb\xce\x1f\x29\x8b\x0f\x2b\x60\xc9\x65\xe1\xe6\xb4\x71\xa1\x21\xc2\x3b\x59\xaa\x01\xdf\x51\xdc\x80\xee\x19\x1b\x99\x4b\xaa\x73\x65\xbf\x38\xaa\x78\xf7\x8a\x9a\xbb\x08\x7a\xa9\xf7\x66\x7a\xcd\x64\xf1\x6c\xbf\xd5\xf6\xd9\xfe\xc8\xa3\x9e\x10\xdf\xcb\xdf\x53\x66\xd7\xfd\x97\x20\x62\x56\x55\x94\xa4\xc7\x74\x4d\xc7\x09\xec\x41\x3c\x7b\xf5\xea\xd9\x3e\x3c\x85\x99\x37\x3b\xd5\xc7\x38\x0c\x4a\x66\x2c\xa1\xa7\xfd\x95\xd8\x51\x66\x1d\x25\x79\xf0\x05\x77\x60\x3a\xea\xf5\x1c\x33\x8e\xa8\xdd\x71\xba\xc5\x9d\x03\x65\xd9\xc8\x6b\x37\x4a\x9b\x35\x2f\xed\xc1\xf3\x27\x87\xb0\x0f\xcf\xf6\x9f\x2e\xf9\x40\x05\x83\xbf\x36\x28\x73\x1a\x86\x8a\xc2\x8d\x10\x2b\xa4\xd2\xe8\x5b\x82\x85\xc3\x58\xcd\x6b\x81\x16\x3e\xcd\x5e\x4e\x5e\x4e\x66\x07\x9f\x61\xcb\x0c\xe4\x4c\x50\xf3\xe3\xa6\x27\xea\x13\x0a\xee\x07\x09\x2e\xe1\x94\x69\xc3\x56\x82\xb3\x6f\x8d\x13\xf3\x53\xb7\x5b\xcd\x6a\xd4\x87\xb0\xb6\xb6\x36\x87\x59\xb6\xdd\x6e\xd3\x2b\x6a\x43\x8c\x2a\x6d\xaa\xf4\x2a\x63\xda\xf2\x5c\x60\x76\xcd\x71\x9b\x5d\x4f\xa7\xdf\xf1\xd9\xf3\xac\x53\x36\xad\x8b\xd2\x5f\x03\x94\xb7\x2b\x37\x46\x53\x57\x5b\xfb\x56\x85\xfa\x94\x05\x0d\x91\x73\xdd\x98\x35\x98\x86\x53\x95\xae\x99\xb6\xd4\x42\x5d\xa2\xb1\x1f\xa7\x33\x70\xdf\x6f\xb6\x4a\x6f\x0e\x9d\x28\x52\xe5\x30\xcb\x0c\xaf\x1a\x91\x72\xad\xd2\xa6\x52\xd2\x6a\x64\x22\xcd\x59\x66\xd1\xd8\x66\x3a\xcb\xe8\x4f\xba\xb6\x95\x08\x03\x33\x9b\x80\x99\x52\xa5\xef\xdd\x35\xe9\x1f\x67\xb4\x0e\x3f\x1f\x81\x99\xc1\xab\x57\x30\x7b\xe9\xde\xdd\xeb\xcf\xc4\xf5\x33\x98\xd9\xeb\xd7\x2f\xdd\xcb\xeb\xd7\xb3\x83\x91\xcf\x47\x42\x88\x61\x3a\x01\x33\x1b\x2a\xec\x14\x9e\xd0\xfb\x5d\xf8\xcf\x00\x00\x00\xff\xff\xd3\x6e\x9c\x4a\x57\x1a\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x59\xfd\x72\x1b\x37\x92\xff\x7b\xe6\x29\x3a\x53\x39\x67\x46\xa6\x39\xa2\x6c\xcb\x39\x9d\xe5\x2a\x9b\x8e\x14\xd5\x59\x1f\x67\xca\x77\xa9\x72\x9c\x14\x38\xd3\x43\x42\xc4\x00\x13\x00\x23\x4a\x71\xe9\x01\xee\x41\xf6\xc5\xf6\x49\xb6\x1a\x98\x2f\x52\x52\x92\xdd\x5a\xfe\x61\x91\x8d\x5f\x37\x1a\xfd\x85\x6e\x38\x4d\xe1\xe9\xbc\xe6\x22\x87\x2b\x13\x86\x15\xcb\x56\x6c\x81\xa0\x6b\x69\x79\x89\x61\xc8\xcb\x4a\x69\x0b\x71\x18\x44\x0d\x2d\xe5\xd2\xa2\x96\x4c\xa4\xe6\xd6\x44\x61\x10\xd5\xd2\xb0\x02\xa3\x30\x0c\xa2\x05\xb7\xcb\x7a\x3e\xce\x54\x99\x2e\x54\xb5\x44\x7d\x65\xfa\x2f\x57\x26\x0a\x93\x30\xcc\x94\x34\x16\x8e\xcf\xcf\x67\x70\x08\xe6\xd6\x8c\xe9\x6b\x47\x7d\xfb\x71\xfa\x23\x1c\x42\x44\x60\x4f\x9b\xaa\xb2\xe2\x02\x35\x51\x5b\x59\x51\x18\xda\xdb\x0a\x01\x0b\x96\x21\x18\xab\xeb\xcc\xc2\xd7\x30\xf8\xd5\x51\x77\xdc\x9f\x30\xc8\x99\x65\x00\x5e\xbd\xf1\x85\x72\x7a\x87\x77\x0d\xab\x87\xf6\xac\xc6\x6a\xa0\x9f\x5c\x2e\xc2\x60\xc5\x65\x0e\x35\x97\xf6\x7b\xc2\x17\xb5\xcc\x20\xb6\x8d\xdc\xa4\x41\xc5\xed\x17\xe2\xd6\x68\x6b\x2d\xc1\x8e\x8d\xd5\x0f\xb1\x54\xab\x45\xc5\xec\xf2\x21\x9e\x28\x22\x86\x34\x85\xb7\x12\x50\x6b\xa5\x67\x1e\xa1\xb1\xd2\x68\x50\x5a\x03\xac\xf5\x87\x07\x40\x8e\x26\xd3\x7c\x8e\x39\xcc\x6f\x81\x81\xe1\x72\x21\xb0\x91\x3c\x6e\x2c\x33\x90\xd4\x9c\xaa\x51\x6a\x63\x2d\x81\x8f\x5e\xf2\x0f\x44\x8b\x13\xf8\x7a\xf7\x08\xae\x05\xdc\xd7\x7f\x43\xb7\x03\x88\xe0\x69\x6b\x21\x4c\x3a\x5b\x70\xc9\x2d\x89\x0f\x83\x2b\x73\xb1\x5a\xc0\xc1\x21\x5c\x99\xf1\xb1\x50\x73\x26\xc6\xc7\x68\xe3\xe8\xdb\x26\xf6\x4c\x94\x78\xc2\x9f\x05\x53\x12\x06\xbd\x88\x99\x13\x71\x65\xce\xe7\x57\x98\xd9\x0b\xab\xa3\x11\xb8\x9d\xbc\x2c\x4f\x6e\x25\x57\x56\x47\xc9\x83\xec\xee\x94\xf7\xb8\x1d\xf5\xcf\x98\xed\x52\xab\xf5\xd0\x9a\x4e\xc6\xf8\xa4\xc9\x16\xaf\x41\xec\x50\xc4\x9e\xa6\xf0\x81\xaf\x10\xec\x12\xe1\x58\xb5\x0e\x1e\x41\x5e\x97\x15\x30\x21\x60\xa1\xb4\xaa\x2d\x97\x68\x80\xc9\x1c\xf0\x86\x5b\x50\x12\x66\x27\xc7\xff\xf3\xe9\xe4\x72\x0c\xca\xa4\x86\x2f\x24\x13\x4e\x96\xc6\x52\x5d\xa3\x71\xe2\x04\x37\x16\x25\x6a\x58\x2f\xb9\xc0\x96\x03\xb8\x01\xa9\x2c\x2f\x38\xe6\xa0\x34\xf0\x85\x54\x1a\xf3\x71\x18\xf0\x02\x2a\xad\x32\x34\xe6\x01\xb7\x34\x2b\x51\xf2\x5f\x1d\xe8\x1b\x07\xfa\x24\x73\x2c\xb8\xc4\x1c\x9e\x3c\x69\x97\x1a\x16\xc1\x6c\xa1\x74\x19\x25\xe3\x59\x9b\x2a\xdf\x1c\x42\xb4\xe6\xf2\xf9\x5e\x44\x31\x10\xfc\x56\x73\xdb\x6c\xb6\x65\x20\x8a\x16\x1f\x28\x41\xb0\xa5\x0a\xd5\x03\x25\x30\x4a\xc6\x53\x26\x44\x1c\x61\x63\xe5\xa8\x39\xe1\x01\x90\xd8\x9f\xe5\xcf\x32\x7a\xda\xb3\x7a\xec\xb7\x9d\x39\xdf\xd7\x65\x15\x8d\xc0\xea\x1a\x7b\xfd\x12\xda\xae\x3d\x44\x23\xfd\x86\xdb\x68\x04\x7b\xb4\x74\x47\xff\x6c\x3b\x9c\x36\xfb\xd0\x98\x3a\x1a\xb9\xbd\x93\x70\x5b\x8a\x92\x03\x05\x7b\xd4\x9d\x73\x1a\xbb\x56\x3c\x87\x1c\x59\x0e\x99\xca\x11\x50\xf0\x92\x4b\x66\xb9\x92\x61\x70\xcd\x34\x34\x49\x15\x06\x08\x87\xf0\xe4\xf2\xb6\xc2\xb7\xc6\xa0\x26\x80\x0b\xb1\xaf\x77\x61\xf0\x2b\x1c\x02\x76\x79\x76\x7c\xfe\xf1\xfc\xfc\x72\x23\x4f\xff\x82\x6f\x37\x62\xe0\x70\xcb\xbd\xe4\x8a\x36\xd7\xd3\xc8\xe9\x4e\xc6\x54\xce\x81\x1b\x8e\x47\x79\xdd\x66\x89\xd7\xa3\x91\xdc\xc0\xbf\x79\x5c\xb0\x47\x74\xee\x68\x0d\x44\xd7\xc3\x7b\x2c\x58\x2d\xec\xb1\x97\xe1\x82\x78\x0d\x0b\x25\x71\x04\x19\x93\xdf\x59\xa8\x0d\x02\xb7\xc0\x0c\x14\x4c\x88\x39\xcb\x56\xc0\xe4\x6d\xa9\x34\x8e\x9d\x90\xcb\xf3\xf7\xe7\x07\x30\x43\x04\x5e\x00\x83\x39\x5a\x8b\x1a\x8c\x12\x35\xd9\xd1\x49\x44\xcc\x5d\x26\x74\xc7\xac\x8d\x4e\x85\xca\x98\x48\x17\x2a\xea\x8c\xfb\x4e\x23\x5b\x55\x74\x91\xb4\xa5\x6c\xfc\x1e\xe7\xf5\x62\x81\x3a\xee\x4b\x1d\x39\x1e\x75\x6c\x56\xbc\x02\x2e\x6d\x02\x71\x95\xb9\xcb\xa4\xb2\x7a\x04\x05\xef\x4a\xf5\x08\x04\x97\x48\x98\x11\xa8\x15\xcc\x95\x12\x4e\x2c\x97\x85\x7a\xc0\x5b\x6d\x15\x3a\xc3\x75\xdc\x58\xd9\x58\x96\xad\xba\x7c\x30\x95\x70\x21\x1b\xfd\x2c\xa3\x64\x7c\x22\x73\xbc\xf1\x5a\x3c\x75\x51\xcc\x0b\x70\x92\xff\xc0\xbf\xbb\x23\x88\xa2\x11\xfd\x29\x98\x30\xe8\xdc\x50\x31\x6d\x5d\xf0\x10\x73\xbb\x53\x3d\xf7\x47\x88\x46\x43\x32\xa7\x2d\xcf\x0b\x52\x21\x76\x1a\xd8\x38\x79\x3a\x79\x0c\x92\xb4\x90\x7b\xfa\x1f\x50\xdc\xf4\x2a\x39\x0d\x9a\xf3\xec\xf6\x39\xbb\xb9\x30\x69\x84\xf9\xcc\xde\x72\x86\xe9\xbc\x31\x82\x2a\x83\xcf\x5f\x1a\x77\x24\x44\x1a\xdc\x65\xbb\xcd\x55\xdc\x70\x1d\x69\x56\xa2\x69\x0a\x27\xf0\xb2\x12\x58\xa2\xb4\x98\x43\xa1\x74\xd3\xac\x1c\x5e\x99\x71\xd8\x45\xd9\x49\x8b\xa1\x58\xab\x94\x31\x7c\x2e\x70\xbc\xa1\x8a\x17\x1a\x67\xfe\xd7\x50\x97\x9d\x66\xbf\xaf\xd0\xa8\xf3\xc4\x13\xbe\xde\x41\xdb\xb5\x34\x08\xdf\xb6\xf4\x77\x75\xc6\x5b\xe6\x04\xce\xf0\x86\xc2\x33\x2e\xe8\xb7\x67\x18\x01\x65\x43\x1b\x60\xad\xf4\x0d\x99\x83\x4e\xe8\x62\x0a\xfe\xd3\x28\x16\x06\x47\xb4\x09\x7d\x76\xe8\x9b\xff\xed\x72\xa7\xed\x97\x8e\x28\xa8\xe9\xd3\x12\x3e\x70\xe9\x09\x5c\xda\x30\xf8\x41\x5a\x7d\x3b\x94\xd8\x55\xab\xa9\x4b\xa4\xee\xa7\xa2\xa2\xdb\xe5\xd6\x46\x77\x90\xd5\xfa\xb8\x2d\xe1\x74\xb1\x10\xb1\x29\xd1\xae\x94\x0f\x39\x9a\x92\xef\xee\xda\x68\x04\x92\x8b\x64\x50\x20\x4f\xdf\xfe\x74\xf1\xf1\x7c\x3a\x8b\xa5\x4f\xcf\xcd\x10\x98\x0c\xb4\x31\xd9\x12\x73\xaf\x4e\x46\x19\x50\xb2\x15\xc6\xd9\x92\xc9\xce\x01\x0f\x6d\x6b\xd0\x5e\xf2\x12\x55\x6d\x1f\xec\x00\xda\x0b\x0e\x32\xa1\x0c\xc6\x59\x02\x77\xc9\x08\x76\x93\x30\x78\xfd\x2c\xeb\x36\x3f\xab\xcb\xe9\xc5\xa7\xf8\x71\xed\xce\xea\xb2\xb3\xc7\x3d\xd8\xb6\xf1\xac\xb2\x4c\x74\x70\xd3\x26\x5e\xd7\x0c\x9f\x62\x39\xb3\xcc\x9a\x41\x14\xa4\x29\x1c\xa3\x44\xcd\x04\x18\xcb\x2c\x37\x96\x67\x66\x1c\x06\x6f\x85\x50\x59\x1f\x1f\xfb\x2f\x20\x4d\x61\x7e\x6b\xd1\x50\xd7\xa2\x32\x46\xe9\x41\x0d\x8b\xb1\x5c\x08\xe0\x12\x6a\x2a\x24\x97\xa4\x81\xe7\x7d\x9c\x2d\xc6\x6b\x94\x94\x39\x85\x46\xcc\x93\x30\x98\xdd\x1a\x80\x87\x37\x53\x73\xcb\x5c\xf9\x2a\xb4\x2a\xe9\xa2\xb0\x58\x42\x6c\xea\x12\x54\x01\x3f\xdd\xdc\x10\xeb\x1c\x85\x5a\x27\x61\xf0\x41\xa9\x55\x5d\x99\x4d\x31\xb2\x2e\xe7\xa8\x09\x5d\xf9\xd1\x00\x84\x87\x85\xc1\xa9\x53\xe9\x51\x7c\xe9\x97\xc3\xe0\x48\x23\x1a\x80\xc7\x70\x74\x0a\x13\x3a\x53\x9e\x32\x2e\xdb\x83\x52\xe2\x2c\x91\x55\x9b\x76\xfd\x11\x59\xd5\xd9\xf6\x9f\xb1\x2c\x31\x76\x76\xfa\x2b\x56\xf2\x2c\x27\xb9\xc0\x07\x59\xb8\x04\x4e\x6b\xa6\x62\xd2\x34\x58\x59\x1b\x7c\x04\x2b\x95\x7c\xd6\xe1\x3d\xfc\x23\x0a\x64\x06\xf3\x7b\x70\xdd\x2e\x58\xe5\x1a\xd5\xf3\x99\x67\xf0\x99\x61\x86\xf2\x5d\xc4\x0e\x6c\xd9\x5b\x40\x79\xb0\xb7\xeb\x07\xb5\x7e\x26\xf0\x1a\x05\x14\xfc\x06\xf3\x67\x86\xff\xde\x96\xb2\x5a\x63\xcb\xa5\xf4\xa6\xad\xd3\x34\xf0\x47\xe2\xa6\xd1\xac\x26\xad\xa4\x5a\xfb\x45\x32\x27\x37\x7f\x60\xc2\x71\x18\xcc\xe8\xea\x6d\x0c\xb3\x7d\x4e\x27\x6d\x7e\x0b\xee\x7a\xee\x95\x68\x98\x1a\x67\x79\xa6\x30\x38\x9d\x55\x4c\xde\x13\x54\x92\x39\xfb\x93\x98\x06\xb7\xcd\x3b\x65\xd9\x12\x3d\xf3\x80\x37\x23\xea\x26\xb3\x03\x7a\xee\x96\xf9\x5d\x9d\xad\x7e\x64\x66\x49\xd4\x9e\xb9\xd2\xaa\xe0\x82\x5a\xc7\x79\x9d\xad\xd0\xc2\x92\x99\x25\x58\x36\x17\x18\x06\xc7\xd3\x3e\x23\x7b\x96\xe3\x29\x94\x68\x19\x8d\xdb\x61\x70\x6e\x97\xa8\x37\xd4\x24\x88\x22\x6a\x9b\xa5\x7d\x1e\x34\x5e\x3c\x66\x7a\x4e\xaf\x0e\x99\x12\x02\xb3\x7b\xee\xa2\x1b\xed\x78\x7a\xbf\x10\x48\xbc\xb1\x2d\x0f\x25\xd5\x9a\xd2\x62\xc9\xaa\x0a\x25\xac\x97\x28\xa1\xcf\xa9\xbf\xff\xff\xdf\xc0\x2e\xb9\x01\x56\xaa\x9a\xae\xa4\x0f\xcc\x3c\x28\x13\x65\x0e\x6e\x9a\x55\x05\x08\x66\x36\xe4\xc7\x92\x49\x65\x30\x53\x32\x37\x60\xb8\xcc\x10\x26\xff\xf9\x8a\x2a\xf7\x05\xab\x0d\xba\x12\x77\x66\x7a\x03\x3b\xea\x59\x6b\xaf\xcf\x7b\x2f\xf7\xbf\xf4\x1b\x65\x5c\x67\xb5\x60\x1a\xe6\x75\x51\xf8\x18\xd7\x98\xa1\xb4\x64\xce\x8a\x38\x21\xaf\xb5\xb7\x12\xdd\xdf\xc6\xb6\xeb\xcc\xc2\xe7\x98\xca\xff\xf4\xe9\xde\xcb\x97\xc9\x7f\x90\xdc\x66\xb3\x1f\x64\xfe\xaf\x6e\xd6\x1e\xdc\x84\x81\x93\x0d\x43\xdb\x3c\xdf\x23\xdf\x4f\x2f\x3e\x1d\x69\xe6\x6d\x51\x08\xc5\x1a\xe1\x45\x4b\x53\x05\x4c\x2f\x3e\x79\xf3\xb5\x29\x70\x3c\xa5\xeb\x9f\xa2\xa7\x15\x49\x5d\x48\x18\xb8\xbe\xb9\xdb\xc5\xd1\x5c\x28\x5c\xa0\xf6\x49\x3c\x28\x96\x5b\xb9\x0b\xfb\x13\xe0\x86\x2e\xc0\x19\xff\x1d\xa7\x82\x19\xe3\x4b\x11\x95\x94\xa9\x9b\xa4\xc6\x61\xf0\xee\x96\x56\xe1\xf3\xfe\xe4\x4b\x7f\xa9\x05\x8e\x36\x38\x54\x57\xea\x5b\x9f\x75\x35\xbd\x25\xdc\x75\x37\xee\x47\x64\x79\x7b\x51\xc6\x25\xec\xb4\xdf\x87\x1d\xcc\x0c\xed\x11\x97\x4c\xf0\xdf\x51\xc7\x37\x23\xa0\x96\xdb\xa2\xa6\x67\xaa\xaf\x77\x0d\xd0\x37\x5d\x84\xee\x15\x53\x15\xfb\xad\xc6\xae\xad\x20\xb3\xd6\x12\x6f\xe8\xed\x8d\x2a\x0f\x47\xe1\x8a\x66\xce\x0d\xe9\xbb\x86\x4c\xc9\x6b\xd4\xc6\xa5\x50\xd7\x05\xfe\xea\xfb\xb3\x04\x5c\xbf\x15\x27\x6d\xbb\x05\x7f\xf8\xe9\xfa\xc1\x5d\xb8\xdb\x16\x44\x7d\x1d\xb5\x72\x83\x09\x86\x3a\xcb\x87\x46\x98\x41\x63\xe9\x46\x88\xfb\xc2\xce\x58\x89\xfd\x60\x0a\x7f\x51\xab\x28\x82\xf6\x80\x24\xe6\x48\xe9\x8b\xe9\x86\x3a\x4e\xfa\xa0\xf7\x91\x5c\x90\x49\x68\x7c\x3e\xc5\xf2\xc2\x95\x33\xfc\xc8\xac\xd3\x12\x0e\xe1\xe5\x64\x0f\x76\x60\xb2\xbb\xf7\xa2\xf7\xd9\x3b\xa1\xb2\xd5\x00\x1a\xeb\x06\xbf\xe5\xdb\xd3\xda\xe2\x4d\x83\x6b\x53\x61\x80\x6d\x9a\xb0\x7e\x1a\x90\xd7\x68\x2c\x5f\x10\x80\xaa\xcf\x18\x4e\x0a\xe0\xf6\x3b\xd3\x8d\x06\xe4\xd4\x6e\xae\x18\x91\x5b\x0d\xcf\x51\x43\xae\xc8\x46\x46\x8d\x7c\xe5\x5c\x73\x83\xcd\x13\x8f\x13\x04\x99\x2a\x89\x63\xbc\x39\xb9\x78\x35\xe9\x8e\x89\xe7\x75\x01\x9f\xbf\xd0\x75\x34\xa2\x54\x6a\x7a\xff\xcd\x2e\x31\x53\xd5\x2d\x01\x47\xf0\xa7\xaf\x25\x4c\x88\xe1\x63\x49\xbb\xd7\x07\x95\xad\xce\x67\x97\x4b\x8d\x2c\x1f\x3e\x1e\x7e\x92\xe2\x91\x95\xff\xf5\x51\xfb\xd0\x43\x22\x0d\xfb\x97\x4b\x6c\x10\xc3\xf3\x68\x7b\xa9\x59\x46\xc1\xe3\xdf\x3f\xbb\xe0\x90\x5c\xb4\x71\x36\xb3\xaa\x6a\x51\xcd\xe7\xeb\x5d\x9f\xb8\xed\x92\xb7\x89\x1b\xf2\xfe\x0f\xa1\x60\x2b\x04\x06\xd9\x42\x01\xca\x6b\xae\x95\x24\xab\x92\x53\x32\x66\xb3\xa5\xdf\xce\x8c\xe1\x72\x89\x1a\x69\xe6\x5b\x23\x2c\xd9\xf5\xa6\xdb\x9a\x8b\x45\xe6\xc0\xc4\x9a\xdd\x9a\x2e\x9f\xfa\x4e\x7e\xa1\x9c\x5d\x9d\x03\xf6\x5f\x6c\x0f\x9c\x0e\xe6\x9e\xb2\xcf\x8b\x18\x2b\xd8\xd9\xa8\x19\x3b\x6e\x85\x78\x2a\x26\x79\x16\x47\x0d\xf2\xc0\x0d\xa5\xa6\xae\x7c\x91\x88\x7a\xaf\xfc\x37\x62\xf5\x56\xf0\x6b\x8c\x37\x8b\x4f\xbb\xee\xe6\xa2\xd8\x34\x1e\x48\x7a\xd1\x83\x07\xdf\xd8\x78\x37\x53\x2c\x2f\xd1\x20\x30\xdd\x17\x75\x87\x5e\x6b\x56\x8d\xe1\xec\xdf\x30\x18\x2f\xd0\xfa\x69\xb8\xca\x1e\x28\x5a\xf7\xeb\x53\xc1\x65\x4e\x5f\x36\xca\x00\x11\x4e\x64\xa1\x7a\x7c\x4b\x71\xe3\xb3\x67\xac\x65\x26\xa9\x0a\x15\xdd\xe2\xa0\x1e\x6d\x95\x1c\x57\xa6\x3b\xa9\x5b\x13\xb7\x66\x32\x7f\xbe\xd7\x68\xfb\x7c\x6f\xe0\x51\x4f\x88\xb7\x26\xb0\x53\x66\x97\xdd\x3b\x0d\x31\x2b\x7a\x1d\x3d\xa2\x4b\x34\x4e\x60\x07\xe2\xc9\xeb\xd7\xcf\xf7\xe0\x19\x4c\x92\xa4\xad\x5e\x71\x18\x14\xcc\x58\x42\xef\x76\x17\x56\x4b\x99\xb4\x94\xe4\xde\x03\x7b\xcf\x74\xd8\xe9\x39\x64\x1c\x50\xdb\xe3\xb4\x8b\x1b\x07\x4a\xd3\x81\xd7\x6e\x94\x36\x4b\x5e\xd8\xfd\x17\x4f\x0f\x60\x0f\x9e\xef\x3d\x9b\xf3\x9e\x0a\x06\x7f\xab\x51\x66\x68\x80\xe5\xb9\x6b\xf0\x17\x48\x85\xcb\x5f\xd8\x33\x87\xb1\x9a\x57\x02\x2d\x7c\x9e\xbc\x1a\xbd\x1a\x4d\xf6\xbf\xc0\x9a\x19\xc8\x98\xa0\xd6\xc4\xcd\x36\x06\xb8\xcc\xb9\x6f\xf3\xb9\x84\x53\xa6\x0d\x5b\x08\xce\xbe\x33\x4e\xcc\x4f\xed\x6e\x15\xab\x50\x1f\xc0\xd2\xda\xca\x1c\xa4\xe9\x7a\xbd\x1e\x5f\x19\xba\x8a\x55\x61\xc7\x4a\x2f\x52\xa6\x2d\xcf\x04\xa6\xd7\x1c\xd7\xe9\xf5\xee\xee\xf7\x7c\xf2\x22\x6d\x95\x1d\x57\x79\xe1\x8b\x34\xe5\xed\xc2\x0d\xb9\x56\x69\xa8\x7c\x23\x41\x5d\xc4\x8c\x46\xbc\xa9\xae\xcd\x12\x4c\xcd\x2d\xfa\xa7\x26\x50\x05\x5c\xa2\xb1\x9f\x76\x27\xe0\x5e\x57\xd6\x4a\xaf\x0e\x9c\x28\x52\xe5\x20\x4d\x0d\x2f\x6b\x31\xe6\x5a\x8d\xeb\x52\x49\xab\x91\x89\x71\xc6\x52\x8b\xc6\xd6\xbb\x93\x94\xfe\x19\x2f\x6d\x29\xc2\xc0\x4c\x46\x60\x76\xe9\x51\xa1\x73\xd7\xa8\xfb\x3a\xa1\x75\xf8\xe5\x10\xcc\x04\x5e\xbf\x86\xc9\x2b\xf7\xdb\xfd\xfc\x85\xb8\x7e\x01\x33\x79\xf3\xe6\x95\xfb\xf1\xe6\xcd\x64\x3f\x0c\x1e\x12\x42\x0c\xbb\x23\x30\x93\xbe\xc2\xee\xd2\xff\xcd\xd0\x1b\xc2\x3f\x06\x00\xe3\xf1\x50\x78\xf6\x1b\x00\x00"),
 		},
 		"/src/strings": &vfsgen۰DirInfo{
 			name:    "strings",
@@ -771,10 +796,10 @@ var FS = func() http.FileSystem {
 		},
 		"/src/sync/go113_sync.go": &vfsgen۰CompressedFileInfo{
 			name:             "go113_sync.go",
-			modTime:          time.Date(2020, 10, 13, 23, 35, 11, 0, time.UTC),
-			uncompressedSize: 2124,
+			modTime:          time.Date(2026, 10, 18, 21, 24, 5, 577163615, time.UTC),
+			uncompressedSize: 2111,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x55\x4d\x6f\xe3\x36\x10\x3d\x9b\xbf\x62\x60\x14\xa8\x94\xc8\x72\xd2\x14\x5b\x20\x58\x1f\x8a\x6c\xb1\x08\xd0\xee\x02\x4d\x8a\x1e\x02\xa3\xa1\xa5\x91\xc9\x98\x22\x55\x0e\x65\xd5\x0d\xf2\xdf\x8b\xa1\x14\x7f\x24\x4e\xba\xb9\x44\xa6\x66\xde\xbc\x79\x6f\x38\x9a\x4e\xe1\x74\xd1\x6a\x53\xc2\x03\x89\xdd\x8f\xa5\x3b\xcf\xcf\x2f\x84\x68\x64\xb1\x92\x4b\x04\xda\xd8\x42\x08\x5d\x37\xce\x07\x18\x2f\x75\x50\xed\x22\x2f\x5c\x3d\x5d\xba\x46\xa1\x7f\xa0\xdd\xc3\x03\x8d\x85\x58\x4b\x0f\x84\xf5\x9f\x52\x07\xf4\x04\x33\xa8\xe5\x0a\x93\x5a\x36\x77\x27\xad\xb6\xe1\xe2\x87\xf9\xdd\xbc\x50\xd2\xc2\xc2\x39\x93\x0a\xae\x4c\x58\xff\xdc\xb9\x15\x5a\x08\x5e\x16\x2b\x82\xa0\x10\x6c\x5b\x2f\xd0\x83\xab\xa0\x1b\xa0\x64\x1f\xb3\xd8\x80\x6f\x6d\xd0\x35\xfe\x75\x83\xb5\x47\x83\x92\x10\x92\xfb\x42\xc1\xc7\x09\x04\xdf\xe2\x7d\xca\xa8\x41\xc9\x00\x4a\xae\x11\xac\x0b\xb0\xc1\x00\xb2\xf8\xbb\xd5\x1e\xcb\x88\x4f\x58\xcb\x46\x39\xcf\xa9\x1f\x27\x85\xba\x07\x6d\xf7\x81\x87\xe0\xdf\xda\x80\xff\xa4\xb9\x98\x4e\x19\xf3\x56\x69\x82\xc6\xe3\x1a\x6d\x20\x90\x60\xb1\x83\x42\x1a\x03\xc1\xbd\x95\xcb\xaf\x3a\xef\xec\xd2\x6c\x9e\x09\x1c\xd6\x67\x5c\x6d\x61\x81\xa1\x43\xb4\x90\x2c\xb0\x90\x2d\xe1\xb1\x26\x95\x24\x90\xc6\xa3\x2c\x37\xa0\x6d\xe1\xb1\x46\x1b\x5e\xf5\xd3\x29\x6d\x22\x6a\x24\xa6\x10\x1a\xb4\xa5\xb6\xcb\xc8\x94\xde\xa3\x7a\xa0\x96\xc7\x02\xf5\x1a\x4b\xa8\xbc\xab\x23\x0e\xdb\x66\xd1\x44\x68\xcb\x55\x5b\x82\x12\xdf\xa0\xb1\xd5\xec\x06\x11\x54\x08\x0d\x5d\x4e\xa7\xef\x8e\x8f\x26\x6a\x91\xa6\x3f\x5d\x7c\xc8\x9f\xa7\x68\x18\x8b\x23\x43\xd4\xff\x4b\x85\xa8\x5a\x5b\x1c\x69\x28\x21\x18\x42\x53\x78\x14\xa3\x37\x3a\x4e\x28\x83\x4a\x1a\xc2\x0c\xce\x52\xf1\x24\x7a\xbe\x87\xa2\x68\x02\xa3\x57\xb8\x77\x9e\xc1\xa2\x0d\x50\x39\x0f\x8d\x77\x95\x36\x51\x5b\x67\x03\xda\x12\x4b\x88\x59\x48\xdc\x7e\xff\xbc\x17\xa5\x29\xca\x4b\x6d\xc3\xd7\x09\xcb\x0c\xc8\xc1\x43\x4b\x01\xd8\xf1\xa8\x9f\xac\x11\x74\xdd\x98\x28\xaa\x0c\xda\x59\x90\x74\xa4\xc1\x88\x7f\xfb\xf5\xd3\xd7\x4b\xb8\xb6\x6b\xa4\xa0\x97\x32\x30\x86\xa6\x1c\xae\x2b\xd0\xe1\x7b\x82\xc6\x11\xe9\x85\x41\x36\x7d\x0b\x9a\x31\x59\xd2\x25\x7a\x28\x1d\xb3\x22\x97\x81\x0b\x0a\x7d\xa7\x79\xee\xb0\x76\xeb\x1e\x08\x0a\x57\x73\x46\xfe\x96\xca\x83\x88\xcf\x52\x67\x60\x74\xe5\xe2\xcd\xce\x80\x56\xba\xa9\xbc\xac\x91\x40\xdb\x10\x5d\xd8\x32\xfe\x83\xf0\xe0\x7d\x05\x16\xb1\xc4\x72\xfa\x4c\x38\x17\x23\x5d\x41\x72\x42\x30\xd9\xcd\xc1\x1d\xcd\x53\x98\xcd\xe0\x8c\xb1\x46\x85\x82\xcb\x61\x30\xf6\xf6\xc9\x88\xf3\x22\x0b\x8e\x19\xed\x36\xd1\x1d\xcd\x61\x06\xb2\xe1\xcb\x90\xec\xad\xa0\xc7\x42\x3d\x65\x70\x10\x97\xe7\x39\x03\x3d\x01\x1a\xc2\x77\x71\x0e\x8e\x33\x28\x54\xcc\x13\xa3\x11\x6f\x14\x11\xd3\xb6\xd4\x61\x32\x83\xf3\x9e\xdf\xc1\xf1\xb6\xa1\x51\x89\x06\x03\x26\xdb\xb7\x19\xd0\x80\xf7\x24\x46\x27\x34\x99\xf0\x84\xbe\x74\x62\xd8\x0d\xfb\x26\x28\x69\x4b\x57\x55\xdf\xe8\xc3\x10\x9d\xfd\xaf\x21\x27\x74\x7a\x2a\xc4\xa8\x63\xd9\x0f\x1a\x8f\x5e\x19\xb4\x49\xb7\x67\x8f\xc7\xd0\x7a\xcb\xd4\xc5\x60\x55\x77\x77\x36\xe7\x74\x7e\x3a\xbf\x9c\x8b\x57\xa2\x76\x47\x81\x76\xaa\x0c\xc1\xbd\x2c\x8c\x7b\xa0\xe3\x29\xcb\x1b\x6b\x0d\x9f\x81\x57\x6a\x59\x17\x74\xb5\xf9\x55\x53\xb8\x52\x58\xac\x12\xd2\xff\x22\xb0\x68\x4d\xf0\x29\x3c\xbe\x0c\x2f\xa4\xbd\x69\xb4\x4d\x74\xaf\x1b\xab\x19\x57\x49\x6c\xac\x5f\x1b\xc3\xca\xb8\x72\xcd\x86\xbf\x54\x9c\x96\x0f\xe9\x5f\xa4\x75\x2f\xee\x8d\x95\xcc\xa0\xc6\x24\x65\xc4\x0f\x3f\x32\x1a\x5f\xc5\x00\xb5\x36\x46\x13\x16\xce\x96\x30\x83\xf3\xb3\xf8\xb7\x2d\xf5\x40\xf9\x67\xe3\x16\xd2\xe4\x9f\x31\x24\xe3\x4f\x32\xe0\x38\xcd\xbf\x60\x97\xa4\xf9\x95\x34\x26\x19\x2f\x31\xdc\xea\x9a\x4f\xaf\x19\x38\x49\xe1\x64\x1f\x73\xa0\x79\xfd\xbc\x01\xb0\xdc\xfb\xd8\x0d\x24\x83\xf2\xae\x4b\x08\x28\x78\x6d\x97\x71\x4c\x76\x75\xfb\x2a\xdf\xc5\x98\xdf\xfb\xb4\x5f\xbc\x77\x7e\x1c\xbd\x78\x12\xff\x05\x00\x00\xff\xff\x88\xbd\xa8\xfb\x4c\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x55\xc1\x6e\xe3\x36\x10\x3d\x8b\x5f\x31\x30\x0a\x54\x4a\x64\x39\x69\x8a\x2d\x10\x6c\x0e\x45\x5a\x14\x01\xda\x2e\xb0\xd9\xa2\x87\xc0\x68\x28\x69\x64\xd2\xa6\x48\x95\x43\x59\x75\x03\xff\x7b\x41\x4a\xb6\xe5\xd8\x49\xf7\x64\x99\x9c\x79\x7c\xf3\xde\x70\x38\x9b\xc1\x65\xde\x4a\x55\xc2\x92\xd8\xe1\xcf\xc2\x5c\x67\xd7\x37\x8c\x35\xbc\x58\xf1\x05\x02\x6d\x74\xc1\x98\xac\x1b\x63\x1d\x4c\x16\xd2\x89\x36\xcf\x0a\x53\xcf\x16\xa6\x11\x68\x97\x74\xf8\x58\xd2\x84\xb1\x35\xb7\x40\x58\xff\xc9\xa5\x43\x4b\x70\x07\x35\x5f\x61\x5c\xf3\xe6\xe9\xa2\x95\xda\xdd\x7c\x37\x7f\x9a\x17\x82\x6b\xc8\x8d\x51\x09\xf3\x27\x13\xd6\x3f\x76\x66\x85\x1a\x9c\xe5\xc5\x8a\xc0\x09\x04\xdd\xd6\x39\x5a\x30\x15\x74\x03\x14\xef\x63\xf2\x0d\xd8\x56\x3b\x59\xe3\x5f\x8f\x58\x5b\x54\xc8\x09\x21\x7e\x2e\x04\x7c\x9c\x82\xb3\x2d\x3e\x27\x1e\xd5\x09\xee\x40\xf0\x35\x82\x36\x0e\x36\xe8\x80\x17\x7f\xb7\xd2\x62\x19\xf0\x09\x6b\xde\x08\x63\x7d\xea\xc7\x69\x21\x9e\x41\xea\x31\xf0\x10\xfc\x5b\xeb\xf0\x9f\x24\x63\xb3\x99\xc7\xfc\x22\x24\x41\x63\x71\x8d\xda\x11\x70\xd0\xd8\x41\xc1\x95\x02\x67\xde\xca\xf5\x5b\x9d\x35\x7a\xa1\x36\x3b\x02\xc7\xe7\x7b\x5c\xa9\x21\x47\xd7\x21\x6a\x88\x73\x2c\x78\x4b\x78\xae\x48\xc1\x09\xb8\xb2\xc8\xcb\x0d\x48\x5d\x58\xac\x51\xbb\x93\x7a\x3a\x21\x55\x40\x0d\xc4\x04\x42\x83\xba\x94\x7a\x11\x98\xd2\x7b\x54\x8f\xd4\xb2\x58\xa0\x5c\x63\x09\x95\x35\x75\xc0\xf1\xb6\x69\x54\x01\x5a\xfb\x53\x5b\x82\x12\xdf\xa0\xb1\xd7\xec\x11\x11\x84\x73\x0d\xdd\xce\x66\xef\xb6\x8f\x24\x6a\x91\x66\x3f\xdc\x7c\xc8\x76\x5d\x34\xb4\xc5\x99\x26\xea\x7f\x12\xc6\xaa\x56\x17\x67\x0a\x8a\x09\x86\xd0\x04\x5e\x58\xf4\x46\xc5\x31\xa5\x50\x71\x45\x98\xc2\x55\xc2\xb6\xac\xe7\x7b\x14\x02\x92\x40\xc9\x15\x8e\xd6\x53\xc8\x5b\x07\x95\xb1\xd0\x58\x53\x49\x15\xb4\x35\xda\xa1\x2e\xb1\x84\x90\x85\xe4\xcb\xef\xbf\x47\x51\x92\x82\xbc\xd4\x36\xfe\x3a\x61\x99\x02\x19\x58\xb6\xe4\xc0\x3b\x1e\xf4\xe3\x35\x82\xac\x1b\x15\x44\xe5\x4e\x1a\x0d\x9c\xce\x14\x18\xf0\xbf\x7c\xfa\xe9\xd3\x2d\x3c\xe8\x35\x92\x93\x0b\xee\x3c\x86\xa4\x0c\x1e\x2a\x90\xee\x5b\x82\xc6\x10\xc9\x5c\xa1\x37\x7d\x0f\x9a\x7a\xb2\x24\x4b\xb4\x50\x1a\xcf\x8a\x4c\x0a\xc6\x09\xb4\x9d\x24\x04\x8b\xb5\x59\xf7\x40\x50\x98\xda\x67\x64\x6f\xa9\x3c\x88\xb8\x93\x3a\x05\x25\x2b\x13\x6e\x76\x0a\xb4\x92\x4d\x65\x79\x8d\x04\x52\xbb\xe0\xc2\x9e\xf1\x1f\x84\x47\xfb\x15\x68\xc4\x12\xcb\xd9\x8e\x70\xc6\x22\x59\x41\x7c\x41\x30\x3d\xf4\xc1\x13\xcd\x13\xb8\xbb\x83\x2b\x8f\x15\x15\x02\x6e\x87\xc6\x18\xcd\x93\xc8\xe7\x05\x16\x3e\x26\x3a\x4c\xa2\x27\x9a\xc3\x1d\xf0\xc6\x5f\x86\x78\x34\x82\x5e\x0a\xb1\x4d\xe1\x28\x2e\xcb\x32\x0f\xb4\x05\x54\x84\xef\xe2\x1c\x2d\xa7\x50\x88\x90\xc7\xa2\x68\x49\xd9\x2f\xca\xe4\x5c\x65\xf7\x5c\xa9\x78\xf2\x8d\x1f\x62\x9f\x91\x93\xd1\x93\x14\x26\xb4\x57\x70\xe2\x33\xfc\xfc\x61\x51\x34\x2e\x14\xa6\x77\x70\xdd\x57\x73\xb4\xbc\x2f\x3f\x2a\x51\xa1\xc3\x78\xbf\x9b\x02\x0d\xa7\x6f\x59\x74\x41\xd3\xa9\xef\xe7\xd7\xbe\x0d\x93\x64\x6c\x99\xe0\xba\x34\x55\xf5\x95\xae\x0d\xd1\xe9\xff\xda\x77\x41\x97\x97\x8c\x45\x9d\x37\xe9\x48\xa6\xe0\xac\x42\x1d\x77\x23\x33\x2d\xba\xd6\x6a\x4f\x9d\x0d\xc6\x76\x4f\x57\x73\x9f\xee\xbf\xae\x6f\xe7\xec\xc4\x82\xee\x2c\xd0\x41\x95\x21\xb8\x97\xc5\xe3\x1e\xe9\x78\xe9\xe5\x65\xd1\xe1\xd1\x38\x51\x4b\x1b\x27\xab\xcd\xaf\x92\xdc\xbd\xc0\x62\x15\x93\xfc\x17\xc1\x8b\xd6\x38\x9b\xc0\xcb\xeb\xf0\x82\xeb\xc7\x46\xea\x58\xf6\xba\x79\x35\xc3\xe0\x09\x85\xf5\x43\x66\x18\x30\xf7\xa6\xd9\xf8\x77\xcd\xa7\x65\x43\xfa\xef\x5c\x9b\x57\xb7\x4c\x73\xcf\xa0\xc6\x38\xf1\x88\x1f\xbe\x1f\xa1\x9d\x74\xd7\x2e\x76\x92\x64\x0f\x3e\x36\xde\x0d\xb3\x87\xdd\xa5\xc7\x72\xf4\xbe\x0d\x27\x39\x61\x4d\x17\x13\x90\xb3\x52\x2f\x82\xd7\x27\xc8\x21\xe6\x73\x9f\xf6\xb3\xb5\xc6\x4e\x82\xa0\x5b\xf6\xdf\x00\x0f\xe5\x71\xe7\x3f\x08\x00\x00"),
 		},
 		"/src/sync/pool.go": &vfsgen۰CompressedFileInfo{
 			name:             "pool.go",
@@ -785,10 +810,10 @@ var FS = func() http.FileSystem {
 		},
 		"/src/sync/sync.go": &vfsgen۰CompressedFileInfo{
 			name:             "sync.go",
-			modTime:          time.Date(2020, 10, 13, 23, 35, 11, 0, time.UTC),
-			uncompressedSize: 2033,
+			modTime:          time.Date(2026, 10, 18, 21, 24, 5, 577163615, time.UTC),
+			uncompressedSize: 2020,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x55\xdb\x6e\xe3\x36\x10\x7d\x36\xbf\x62\x6a\x14\xa8\x94\xd8\xf2\xa6\x29\xb6\x40\xb0\x7e\x28\xb2\xc5\x22\x40\xbb\x0b\x34\x29\xfa\x10\x18\x0d\x4d\x8d\x4c\xc6\x14\xa9\x72\x28\xab\x6e\x90\x7f\x2f\x86\x52\x7c\xc9\xf5\x25\x34\x39\x73\xe6\xcc\x99\x8b\x66\x33\x38\x5d\xb6\xc6\x96\x70\x4f\x62\xff\xe3\xbb\x95\x3f\x2b\xce\xce\x85\x68\xa4\x5a\xcb\x15\x02\x6d\x9d\x12\xc2\xd4\x8d\x0f\x11\xc6\x2b\x13\x75\xbb\x2c\x94\xaf\x67\x2b\xdf\x68\x0c\xf7\xb4\x3f\xdc\xd3\x58\x88\x8d\x0c\x40\x58\xff\x25\x4d\xc4\x40\x30\x87\x5a\xae\x31\xab\x65\x73\x7b\xd2\x1a\x17\xcf\x7f\x5c\xdc\x2e\x94\x96\x0e\x96\xde\xdb\x5c\x70\x68\xc2\xfa\x97\xce\xaf\xd1\x41\x0c\x52\xad\x09\xa2\x46\x70\x6d\xbd\xc4\x00\xbe\x82\x6e\x80\x92\xbd\xcd\x72\x0b\xa1\x75\xd1\xd4\xf8\xf7\x35\xd6\x01\x2d\x4a\x42\xc8\xee\x94\x86\x4f\x53\x88\xa1\xc5\xbb\x9c\x51\xa3\x96\x11\xb4\xdc\x20\x38\x1f\x61\x8b\x11\xa4\xfa\xa7\x35\x01\xcb\x84\x4f\x58\xcb\x46\xfb\xc0\xae\x9f\xa6\x4a\xdf\x81\x71\x87\xc0\x83\xf1\xef\x6d\xc4\x7f\xf3\x42\xcc\x66\x8c\x79\xa3\x0d\x41\x13\x70\x83\x2e\x12\x48\x70\xd8\x81\x92\xd6\x42\xf4\x6f\xf9\xf2\x53\x17\xbc\x5b\xd9\xed\x13\x81\xe3\xf8\x8c\x6b\x1c\x2c\x31\x76\x88\x0e\xb2\x25\x2a\xd9\x12\xbe\x96\xa4\x96\x04\xd2\x06\x94\xe5\x16\x8c\x53\x01\x6b\x74\xf1\x45\x3e\x9d\x36\x36\xa1\x26\x62\x1a\xa1\x41\x57\x1a\xb7\x4a\x4c\xe9\x3d\xaa\x47\x6a\x05\x54\x68\x36\x58\x42\x15\x7c\x9d\x70\xb8\x6c\x0e\x6d\x82\x76\x1c\xb5\x25\x28\xf1\x0d\x1a\x3b\xcd\xae\x11\x41\xc7\xd8\xd0\xc5\x6c\xf6\x6e\xfb\x18\xa2\x16\x69\xf6\xf3\xf9\xc7\xe2\xa9\x8b\x86\xb6\x78\xa5\x89\xfa\x7f\xb9\x10\x55\xeb\xd4\x2b\x09\x65\x04\x83\x69\x0e\x0f\x62\xf4\x46\xc6\x19\x4d\xa0\x92\x96\x30\x17\x8f\xa2\x27\x7b\xac\x88\x21\xb0\x66\x8d\x07\xf7\x13\x58\xb6\x11\x2a\x1f\xa0\x09\xbe\x32\x36\x09\xeb\x5d\x44\x57\x62\x09\xc9\x0b\x89\x73\xef\xcf\x07\x56\x86\x92\xb6\xd4\x36\x3c\x4b\x58\x4e\x80\x3c\xdc\xb7\x14\x81\xcb\x9d\xc4\x93\x35\x82\xa9\x1b\x9b\x14\x95\xd1\x78\x07\x92\x5e\xc9\x2e\xe1\xdf\x7c\xfb\xfc\xed\x02\xae\xdc\x06\x29\x9a\x95\x8c\x8c\x61\xa8\x80\xab\x0a\x4c\xfc\x81\xa0\xf1\x44\x66\x69\x91\x2b\xbe\x03\x9d\x30\x59\x32\x25\x06\x28\x3d\xb3\x22\x3f\x01\x1f\x35\x86\xce\x70\xd3\x61\xed\x37\x3d\x10\x28\x5f\xb3\x47\xf1\x96\xc4\x83\x82\x4f\x3a\x4f\xc0\x9a\xca\xf7\x63\xcd\x92\x9b\x0a\xb2\x13\x82\xe9\xbe\x8e\xb7\xb4\xc8\x61\x3e\x87\x0f\xfc\x3c\x52\x1a\x2e\x86\xc2\x1e\xec\x83\x11\xfb\x25\x20\xb6\x19\xed\x37\xc9\x2d\x2d\x60\x0e\xb2\xe1\x66\xce\x0e\x56\xc8\x83\xd2\x8f\x13\x38\xb2\x2b\x8a\x82\x81\x1e\x01\x2d\xe1\xbb\x38\x47\xd7\x13\x50\x3a\xf9\x89\xd1\x88\x37\x82\x48\x6e\x3b\xea\x30\x9d\xc3\x59\xcf\xef\xe8\x7a\x97\xd0\xa8\x44\x8b\x11\xb3\xdd\xeb\x04\x68\xc0\x7b\x14\xa3\x13\x9a\x4e\xb9\xc9\x9e\x8b\x39\xcc\xf6\xa1\x8e\x5a\xba\xd2\x57\xd5\x5e\xca\x5d\xb1\xff\x4c\x4b\xa0\x7f\x35\x15\x38\xc4\x12\xcb\xd9\x53\xa1\x0b\x8e\x72\x7a\x2a\xc4\xa8\x63\x69\x8f\x92\x4b\xf5\xb0\xe8\xb2\xee\xa0\x04\x01\x63\x1b\x1c\xd3\x13\x43\x39\xba\xdb\x0f\x0b\x76\xe7\xd3\xd9\xc5\x42\xbc\x10\xae\x7b\x15\x68\x9f\xf9\x60\xdc\xa7\xce\xb8\x47\x5a\x9d\xb2\x84\x29\xd6\xb0\xaa\x5f\x28\xe2\x7c\x34\xd5\xf6\x37\x43\xf1\x52\xa3\x5a\x67\x64\xfe\x43\x60\x61\x9a\x18\x72\x78\x78\x6e\xae\xa4\xbb\x6e\x8c\xcb\x0c\x18\x17\xf3\xa4\x58\x1a\xf7\x94\x58\x3f\xda\xc3\x64\x5f\xfa\x66\xcb\x5f\x13\x76\x2b\x06\xf7\xaf\xd2\xf9\x67\xed\xed\x24\x33\xa8\x31\xcb\x19\xf1\xe3\x4f\x8c\xc6\x13\x13\xa1\x36\xd6\x1a\x42\xe5\x5d\x09\x73\x38\xfb\x90\xfe\x76\xa1\xee\xa9\xf8\x62\xfd\x52\xda\xe2\x0b\xc6\x6c\xfc\x59\x46\x1c\xe7\xc5\x57\xec\xb2\xbc\xb8\x94\xd6\x66\xe3\x15\xc6\x1b\x53\xf3\xed\x15\x03\x67\x39\x9c\x1c\x62\x0e\x34\xaf\x9e\x06\x15\xcb\x83\x0f\xd2\x40\x32\xea\xe0\xbb\x8c\x80\x62\x30\x6e\x95\x5a\x63\x1f\xb7\x8f\xf2\x7d\xb2\xf9\xa3\x77\xfb\x35\x04\x1f\xc6\xa9\x16\x8f\xe2\xff\x00\x00\x00\xff\xff\xfb\xa7\xf4\x6c\xf1\x07\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x55\xc1\x6e\xe3\x36\x10\x3d\x8b\x5f\x31\x35\x0a\x54\x4a\x64\x79\xd3\x14\x5b\x20\xd8\x1c\x8a\xb4\x28\x02\xb4\x5d\x60\xb3\x45\x0f\x86\xd1\x50\xd2\xc8\xa4\x4d\x91\x2a\x87\xb2\xea\x06\xfe\xf7\x82\x94\x6c\xcb\xb1\x93\x93\x65\x71\xe6\xcd\xbc\x37\xc3\xa7\xd9\x0c\xae\xf3\x56\xaa\x12\x56\xc4\x8e\x7f\xbe\x59\x9a\x9b\xec\xe6\x96\xb1\x86\x17\x6b\xbe\x44\xa0\xad\x2e\x18\x93\x75\x63\xac\x83\xc9\x52\x3a\xd1\xe6\x59\x61\xea\xd9\xd2\x34\x02\xed\x8a\x8e\x0f\x2b\x9a\x30\xb6\xe1\x16\x08\xeb\xbf\xb8\x74\x68\x09\xee\xa1\xe6\x6b\x8c\x6b\xde\xcc\xaf\x5a\xa9\xdd\xed\xf7\x8b\xf9\xa2\x10\x5c\x43\x6e\x8c\x4a\x98\x2f\x4d\x58\xff\xd4\x99\x35\x6a\x70\x96\x17\x6b\x02\x27\x10\x74\x5b\xe7\x68\xc1\x54\xd0\x0d\x50\xbc\x8f\xc9\xb7\x60\x5b\xed\x64\x8d\x7f\x3f\x61\x6d\x51\x21\x27\x84\xf8\xb9\x10\xf0\x69\x0a\xce\xb6\xf8\x9c\x78\x54\x27\xb8\x03\xc1\x37\x08\xda\x38\xd8\xa2\x03\x5e\xfc\xd3\x4a\x8b\x65\xc0\x27\xac\x79\x23\x8c\xf5\xa9\x9f\xa6\x85\x78\x06\xa9\xc7\xc0\x43\xf0\xef\xad\xc3\x7f\x93\x8c\xcd\x66\x1e\xf3\xab\x90\x04\x8d\xc5\x0d\x6a\x47\xc0\x41\x63\x07\x05\x57\x0a\x9c\x79\x2b\xd7\x1f\x75\xd6\xe8\xa5\xda\xee\x1b\x38\xad\xef\x71\xa5\x86\x1c\x5d\x87\xa8\x21\xce\xb1\xe0\x2d\xe1\x25\x92\x82\x13\x70\x65\x91\x97\x5b\x90\xba\xb0\x58\xa3\x76\x67\x7c\x3a\x21\x55\x40\x0d\x8d\x09\x84\x06\x75\x29\xf5\x32\x74\x4a\xef\xb5\x7a\xa2\x96\xc5\x02\xe5\x06\x4b\xa8\xac\xa9\x03\x8e\x1f\x9b\x46\x15\xa0\xb5\xaf\xda\x12\x94\xf8\x46\x1b\x07\xcd\x9e\x10\x41\x38\xd7\xd0\xdd\x6c\xf6\xee\xfa\x48\xa2\x16\x69\xf6\xe3\xed\xc7\x6c\xbf\x45\xc3\x5a\x5c\x58\xa2\xfe\x27\x61\xac\x6a\x75\x71\x81\x50\x4c\x30\x84\x26\xf0\xc2\xa2\x37\x18\xc7\x94\x42\xc5\x15\x61\xc2\x76\xac\x6f\xf6\xe4\x1c\x24\x81\x92\x6b\x1c\xbd\x4f\x21\x6f\x1d\x54\xc6\x42\x63\x4d\x25\x55\x10\xd6\x68\x87\xba\xc4\x12\x42\x16\x92\xe7\xde\x3f\x8f\xa2\x24\x05\x6d\xa9\x6d\xfc\x5d\xc2\x32\x05\x32\xb0\x6a\xc9\x81\x1f\x77\x10\x8f\xd7\x08\xb2\x6e\x54\x50\x94\x3b\x69\x34\x70\xba\xc0\x2e\xe0\x7f\xfd\xfc\xf3\xe7\x3b\x78\xd4\x1b\x24\x27\x97\xdc\x79\x0c\x49\x19\x3c\x56\x20\xdd\x77\x04\x8d\x21\x92\xb9\x42\x3f\xf1\x03\x68\xea\x9b\x25\x59\xa2\x85\xd2\xf8\xae\xc8\xa4\x60\x9c\x40\xdb\x49\x42\xb0\x58\x9b\x4d\x0f\x04\x85\xa9\x7d\x46\xf6\x96\xc4\x83\x82\x7b\x9d\x53\x50\xb2\x32\xfd\xb5\xf6\x92\xcb\x0a\xe2\x2b\x82\xe9\x71\x8e\x73\x5a\x24\x70\x7f\x0f\x1f\xfc\x71\x54\x08\xb8\x1b\x06\x3b\xf2\x83\xc8\xe7\x05\x20\x1f\x13\x1d\x9d\x64\x4e\x0b\xb8\x07\xde\xf8\x65\x8e\x47\x16\xf2\x52\x88\x5d\x0a\x27\x71\x59\x96\x79\xa0\x1d\xa0\x22\x7c\x17\xe7\xe4\x75\x0a\x85\x08\x79\x2c\x8a\x56\x94\xfd\xaa\x4c\xce\x55\xf6\xc0\x95\x8a\x27\xdf\x7a\x13\xfa\x82\x9c\x8c\x9e\xa4\x30\xa1\x83\x08\x13\x9f\xe1\xfd\x83\x45\xd1\x98\x28\x4c\xef\xe1\xa6\x67\x73\xf2\xfa\x40\x3f\x2a\x51\xa1\xc3\xf8\x70\x9a\x02\x0d\xd5\x77\x2c\xba\xa2\xe9\xd4\xaf\xe4\x6b\xe9\x07\x27\x18\xab\x2e\xb8\x2e\x4d\x55\x1d\x85\x3f\xac\xc6\x9f\x84\x87\x53\x59\x81\x46\x2c\xb1\x9c\xed\xd7\x22\xf3\x55\xae\xaf\x19\x8b\x3a\x3f\x88\x13\x29\xc2\xf4\x14\xea\xb8\x1b\x0d\xcc\xa2\x6b\xad\xf6\xed\xb1\x61\x78\xdd\xfc\xc3\xc2\xa7\xfb\xa7\x9b\xbb\x05\x3b\x93\xb9\xbb\x08\x74\x64\x3e\x04\xf7\xd4\x3d\xee\x89\x56\xd7\x5e\x42\x16\x1d\x8d\xfd\x4c\x11\x6d\x9c\xac\xb6\xbf\x49\x72\x0f\x02\x8b\x75\x4c\xf2\x3f\x04\x2f\x4c\xe3\x6c\x02\x2f\xaf\xc3\x0b\xae\x9f\x1a\xa9\x63\x09\x52\xbb\x24\x28\x16\xcc\x21\x10\xeb\x8d\x60\xf0\x81\x07\xd3\x6c\xfd\xb7\xc7\xa7\x65\x43\xfa\x1f\x5c\x9b\x57\x97\x41\x73\xdf\x41\x8d\x71\xe2\x11\x3f\xfe\x30\x42\x3b\xdb\xa0\x7d\xec\x24\xc9\x1e\x7d\x6c\xbc\xf7\x9c\xc7\xfd\xdd\xc4\x72\xf4\x0d\x1a\x2a\x39\x61\x4d\x17\x13\x90\xb3\x52\x2f\xc3\x7c\xcf\x90\x43\xcc\x97\x3e\xed\x17\x6b\x8d\x9d\x04\x41\x77\xec\xff\x01\x00\x6b\xca\xee\x3b\xe4\x07\x00\x00"),
 		},
 		"/src/sync/sync_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "sync_test.go",
@@ -808,6 +833,13 @@ var FS = func() http.FileSystem {
 			name:    "syscall",
 			modTime: time.Date(2021, 2, 2, 15, 4, 6, 744264651, time.UTC),
 		},
+		"/src/syscall/exec_unix.go": &vfsgen۰CompressedFileInfo{
+			name:             "exec_unix.go",
+			modTime:          time.Date(2026, 10, 18, 21, 5, 2, 637853077, time.UTC),
+			uncompressedSize: 4860,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x84\x58\x5d\x6f\xe3\xba\xd1\xbe\xb6\x7e\xc5\x44\x17\x59\x29\xd1\xca\x59\xbc\xef\x45\x61\xc7\x05\xb6\xdb\x74\x4f\xda\x9e\xec\xe2\x04\xa7\x8b\x22\x48\x17\x8c\x34\xb2\x69\xcb\xa4\x4a\x52\x76\xdc\x20\xff\xbd\x98\xa1\x28\xcb\x89\x93\xde\x44\x16\x35\x9f\xcf\x7c\x32\xe3\x31\x9c\x3f\xb4\xb2\x2e\x61\x69\xb3\x93\xad\x54\xa5\xde\xda\x28\x6a\x44\xb1\x12\x73\x04\xbb\xb3\x85\xa8\xeb\x28\x92\xeb\x46\x1b\x07\x49\x34\x8a\xe7\xd2\x2d\xda\x87\xbc\xd0\xeb\xf1\x5c\x37\x0b\x34\x4b\xbb\xff\xb1\xb4\x71\x94\x46\xd1\x78\x0c\x37\xba\xc4\x7c\x69\xa1\x10\xea\x83\x83\x4a\x9b\x55\x06\x56\x43\xb1\x20\x65\x8d\xd1\x05\x5a\x8b\x16\x84\x41\xb0\x4e\x18\x87\x25\x6c\xa5\x5b\x80\x74\xd6\x13\xfd\xec\x88\x48\xd8\x5a\x97\x6d\x8d\x20\x95\x75\x28\xca\xbc\x17\x6e\x50\x34\x16\xdc\x02\xd7\xc4\x87\x75\x35\x85\x1f\x42\xba\xff\x87\xad\x20\x39\x95\x36\xf4\x11\xf0\x51\x3a\xc0\x0d\x2a\x97\xb3\x6d\x9f\x61\x69\xbf\x7b\xe9\x20\x2d\x88\x43\xab\x7a\x7b\x1e\x76\x6c\xf7\xd5\x23\x16\x79\xe4\x76\x0d\x0e\xd8\xac\x33\x6d\xe1\xe0\x29\x1a\x91\x70\x2c\xa1\x58\x08\xd5\x9d\x3e\x3d\xc3\x78\x0c\x45\xad\x2d\x39\xb5\x40\xc5\x46\x04\xe9\x0b\x61\xc1\xf3\x44\x23\xeb\x84\x6b\x2d\xdb\x7c\xcb\x3f\xa3\xe7\x28\xda\x08\xb3\x57\x84\x16\x66\xb0\x16\x2b\x4c\xd6\xa2\xb9\x93\xca\xdd\x9f\xf5\xdf\x3c\xd0\x4a\x97\xf8\xab\xc7\xc7\xa0\x6b\x8d\x62\x40\x7a\x88\x3a\xe8\x18\x5b\x3a\x9f\xcb\x0d\x2a\x50\x62\x8d\x19\x68\x03\x4a\xd6\xde\x44\xa5\x1d\x49\x33\xad\x52\x52\xcd\x41\xaa\x20\x21\x8f\xaa\x56\x15\x03\x35\x09\x31\x93\xab\x52\xcd\x53\x38\x5b\xda\xfc\xdb\xc3\x12\x3d\x18\x06\xff\xdd\x4a\x83\x30\x99\xc1\xd2\xe6\x5f\x6b\xfd\x20\xea\xfc\x2b\xba\x24\xee\xbe\xc4\x69\x34\x92\x15\x04\xba\x19\xd3\xfd\xae\x4a\xac\xa4\xc2\x92\x44\x8c\xbc\x17\x64\x59\x34\x7a\x8e\xc2\x6b\xc7\x91\x5f\xab\x8d\x5e\x79\x1b\x52\x42\x6b\x3c\x86\xa5\xbd\x32\x46\xe9\x03\xf7\xfd\x89\xae\x40\xf4\x48\xd8\x9d\x75\xb8\x06\x34\x46\x9b\xce\xa9\x8e\x33\x41\x63\x06\x8e\xa4\x1d\xf7\x13\x9b\xaa\x2d\x79\x33\x70\x3f\xd6\x36\x4e\xa7\x74\x7e\x32\x63\xfc\xc8\x68\x59\x81\x22\x3a\x6d\xbd\xbb\x85\x56\xd6\x09\xe5\x6c\x9c\xfa\x03\x24\x91\xdd\x0b\x1a\x13\xa8\x4a\x8c\xd3\xfc\x96\xb1\x4c\xd2\x74\x0a\x0a\x4e\x8e\x40\x12\x40\xf0\xd6\xaa\xfc\x5a\xb9\x24\x4d\xa3\xd1\xe8\x79\x08\xd1\xd5\xf5\xcd\x3f\x3e\xff\xbd\x03\xa5\x10\xae\x58\xfc\xf5\xf6\x8a\xbc\x05\x2a\x62\x0b\x15\x08\x55\x1e\x47\x89\x5e\x18\x18\x90\x0e\xdc\xc2\xe8\xad\xcd\x40\x12\xc3\xae\x83\x6a\x28\x2f\xa9\x80\xce\x92\x34\x05\x86\x8e\x39\x53\xb2\xb4\xc4\x0a\x4d\xf7\x91\x2d\xe7\x54\x30\x58\xe8\x0d\x9a\x24\xf5\x40\x71\xd8\x03\x70\x9d\xf1\xde\x97\x11\x07\x24\x03\xbd\x22\x36\xcc\x13\x0a\x0a\xab\xec\x58\x4f\xf4\xca\x73\x35\x42\xc9\x22\xc1\x0e\x83\x11\x59\x31\xeb\xc3\xc9\xcf\x10\xcc\x68\xf4\x4c\x8a\x2b\xfa\x33\x48\xad\xe7\xc8\xfb\x15\x2a\x3c\x11\x66\xbe\xb9\xe8\xd2\x3a\x03\x7a\x83\xbb\xfb\xfe\xd5\x39\x03\x67\x54\x77\x9f\x9d\x33\x29\x24\x8d\x2c\x41\x2a\x97\xc1\xa1\xff\xdc\x48\x42\x8f\x78\x91\x36\x07\x5d\xad\xab\x83\x03\xfa\x01\x2a\x9d\xa1\x17\x19\x5c\xdd\x7c\xbb\xfd\xe7\x2d\x87\x59\x56\xde\x8e\x01\x9d\x7f\x87\xd3\xff\xa0\xd1\xc1\x3a\xa2\xa5\xd6\x52\x4a\x7d\xa4\x0e\x3f\x1b\x23\x76\x71\x9a\xdf\xe0\x96\x61\xa1\x90\x67\x50\x95\x1c\x27\xa1\xe6\xc8\x3a\xf2\xbf\xc8\x1a\x2d\xab\xb0\x5b\xe9\x8a\x05\x54\x5e\x16\x9f\x53\x1f\x4a\xaa\x32\xbd\x9f\x32\x45\x21\x2c\x92\x84\xd9\x0c\xfe\xd5\x4a\xe5\x1a\x67\x92\x8b\x34\x83\x2a\x14\xc8\xe9\x29\x54\x79\x23\x9b\x10\xf8\x09\x45\x90\x0d\xcc\x6f\xd1\x5d\xab\x12\x1f\x13\x99\x41\x2c\xe7\x4a\xfb\x16\xd1\xc9\x84\x93\x77\xe9\x49\x24\x53\x97\x58\x89\xb6\x76\x6f\xd0\x55\x65\x4a\x0d\x59\x80\x41\x51\x43\x25\x6b\x84\x12\x6d\x61\x64\xe3\xc8\x7b\x0b\x8d\xb0\x16\x4b\xd0\x0a\x84\x05\x69\xfb\xba\x62\x70\xc8\xef\x1a\x55\xb2\x87\x25\x9d\x82\x84\x4b\xf8\xbf\x29\xc8\xf3\x73\x8f\xd1\xdb\xbe\xc0\x60\x16\x6e\x75\x5b\x97\x50\x18\x14\x0e\x81\x8c\xef\x47\xd4\x9a\xf5\xa1\xda\x90\x36\xd6\x74\xa5\x36\x1c\x71\x3a\x1b\x04\x9c\x5f\xe1\x4a\x6d\xa4\xd1\x8a\x02\xf8\x1c\x51\xd1\xa8\xcd\x91\x50\xfb\x02\x38\x8c\xf5\xcf\x0c\x56\x9b\x7d\xac\x49\x5c\xd7\xbe\xd8\x51\x49\xf6\xff\x69\xe7\x30\xb9\xbb\x7f\xa0\xc7\x6a\x93\x66\xf0\x61\xf6\x81\x7d\xfe\x23\x5c\x30\xb5\xd7\x48\xfe\x26\xab\xcd\xdd\x44\xde\x93\xd0\x3b\x79\xfe\x69\x72\xbf\x6f\x4a\xba\x71\xb6\x33\xea\xd7\xa7\x98\x11\x8a\x27\xc0\xcf\x0c\x62\x54\x9b\x78\x02\x2c\xc7\x67\x36\x43\x6c\xe6\x9b\xb4\xd7\x42\x02\xee\x62\x2e\xcb\xf8\x1e\x66\x5c\x92\x77\x17\xf7\x94\xf5\x54\x9c\xdd\xc1\xa7\xc9\xfd\xb0\x38\xf2\x3f\x4b\x43\x59\x13\xc7\x03\x19\xc5\xb6\xf4\x12\x3a\x82\xc0\x60\x77\xb6\xc7\xfb\x76\x67\xa7\x7c\x30\x68\xea\x9e\xbb\x44\x27\x8a\x05\x7a\x11\x76\x67\xc9\x6f\x2b\x4b\x8f\x5a\x01\x13\x7f\xf8\xc5\x60\x89\xca\x49\x51\x4f\xa1\x18\x0a\xe9\xa4\xb4\xd2\x0b\x28\xf2\xdf\x65\xb9\x3f\x9e\xf7\xc7\x5f\x65\x19\xb0\x8b\x46\xb4\x03\x50\xa7\x18\x4c\x25\x9f\x0d\xc6\x90\xc2\xc3\x66\xbc\xef\xb6\xcc\x32\x3b\xe8\x2a\xf9\x17\x51\xd7\x49\x6c\x1b\xb1\x55\xb1\xef\x6b\x17\xfe\x91\x01\x99\x40\x29\x94\x4e\x59\xf0\xc9\xb1\xfe\x83\xc6\xc3\x35\x1e\x03\xab\xb3\x50\xcb\x15\x82\x80\xb5\xb4\x96\x36\x05\x7c\xc4\xa2\x75\xe2\xa1\x46\x5e\xe6\xb4\xaa\x77\x60\xb0\xd1\x61\x85\x12\x2a\xac\x5e\xa3\x4a\xc8\x1a\xb9\xd3\xf0\x46\xc3\x2b\x13\x77\xec\x0c\x3e\xa5\x11\x5b\xdf\x99\xab\xc9\xd6\x98\xfb\x6a\x9c\xf9\x79\xf2\x72\x46\x73\xdd\x61\xdd\x2d\x1e\x5d\xc3\xf0\x0a\x2e\x3f\x0e\x27\x7b\x3a\x39\xec\x10\x04\xb1\xef\xbe\xac\x90\x4b\xa5\x91\x65\x9c\xbe\xb7\x8d\x5c\x64\x70\xf9\xd1\x8b\xf7\x11\x6a\xc8\x8d\xd3\x7e\x17\x7b\xf2\x2b\xdd\x64\xe0\x59\x58\x06\xd3\xe7\x63\xae\x3d\x4a\x17\x3c\xa3\x2d\x20\x03\x2b\xe7\x4a\xd4\xaf\x5c\x94\x55\xf8\x32\xcc\xa9\x26\xef\x56\xc7\xd9\x60\x79\x4c\x5e\x2e\x29\x6f\xac\x22\x5e\x5e\x78\xf5\x6f\xfb\x1d\x64\xb0\x5c\x00\xd6\x16\xdf\x53\x48\x96\x7b\x7a\xb8\xbc\x84\x3f\x84\x69\xcc\xab\x6f\xd2\xe4\x1e\x93\xd4\xe3\xfd\xbf\x27\x8d\xac\xde\x9a\x32\x47\x26\xc9\x10\x8d\x42\x2b\x85\x85\xfb\x2e\x1b\x4c\xaa\x6c\x10\x56\xdf\x75\xd2\xbc\x6b\xcb\x83\x95\x89\xe6\xf7\xec\x65\x02\x78\x57\xa2\x51\x1f\x55\xb4\x77\x8d\x2c\xa9\x3e\x9b\x7e\x79\x68\x64\x99\x85\x0d\x82\xf6\xac\xbd\xee\xf0\xdb\xef\x56\xa8\x4a\xbf\x7f\xb2\xb9\x6e\x21\x1c\x6c\x45\x3f\x6b\x9c\x7e\x79\xed\x20\x61\x4e\x33\xab\x75\x06\xc5\xda\xf3\xf4\xf7\x28\x9e\x1a\x25\x0f\x0c\xe9\xc2\x52\x36\x74\x1c\xce\x3c\x70\x59\xe0\x7f\x91\x4a\x9c\xb1\x1e\x3d\xce\xfe\x2a\xdf\x1a\xe9\x7c\x7c\x1b\xff\xdb\xd8\xf3\x73\x1e\x67\xc4\x7f\x90\xaf\xa5\x70\xa2\xcf\xd7\x45\xab\x56\xaf\x12\x75\xd4\xe4\x4d\x6b\x17\xc9\xd2\xd2\xfc\xb0\x9e\xca\x23\x9e\x1e\x97\xc9\x69\x12\x84\xf6\x42\xf8\xf4\x07\x5b\x93\xf4\xdc\x61\x49\xa4\xc8\xe5\x06\x45\xd9\x99\xda\xe4\x56\xaa\x15\xcc\x3a\x97\xfb\x49\xd2\xe4\x0f\x6d\x95\x52\x92\x5c\x74\x03\x7a\xa0\x9d\x5d\x8d\x33\xf0\x44\xec\xfd\x43\x5b\xc1\xac\xbf\x72\x50\x6b\x08\x80\xc0\xec\xa8\x0c\x54\x65\x9c\x86\xc6\x48\xd6\x52\x2b\xe4\xa0\x76\xe1\xf4\xd1\xdb\x5f\xf6\xb8\x37\x59\x7f\x0f\xbb\xfa\x7e\xfd\xfd\x2a\x8f\x8e\x61\x72\xd0\xf2\x52\x78\x7a\x4e\x8f\x92\x1d\x81\xae\x87\x82\xbd\xd8\xc3\xf4\xf1\x23\xd7\x5f\xd8\x77\xf9\x7e\xbc\x5f\x5f\xb7\x5d\x59\x9f\xed\xab\x9a\xe7\x82\xd4\xca\x7a\x0a\xd3\x5a\xfa\x57\xc0\xd9\x6f\xfc\x4c\x21\xd9\xbe\xb1\xfb\x36\xbe\x76\x0f\x4b\xc7\x83\x39\xdc\x60\x68\xb4\x75\x4a\x7f\x7e\xf9\x29\x95\x8b\x46\xa3\x2d\x17\x95\x5f\xe2\xb7\xc1\xc0\x0c\x4e\xed\xa1\x41\xc1\x98\xee\x32\x10\x6c\x1f\xb6\x82\xb3\xed\xb1\x3e\xe5\xcf\x42\x7b\x1a\x24\x93\xac\x82\xec\xd3\x1f\x37\xdf\x7e\xf9\x7c\xf3\x75\x90\x33\x2f\x86\xcb\xe5\xc7\xd0\xd1\x5e\x0c\x94\xc1\x8c\xf0\xd8\xfb\x16\xb3\xa7\xa7\x7b\x51\x8d\x0e\x93\x01\x3a\x19\x35\x12\x3f\x86\x8e\xf8\x31\x70\x23\x74\xde\xe1\x4d\x6f\xd8\x83\x38\xaa\x7f\x93\x75\xbd\x0f\x2a\xb5\xf4\x76\x0d\xb7\xdc\xd9\x5f\xdd\xd1\x38\x20\x47\x42\x35\x85\x66\x68\xc2\xfb\xee\xf7\x77\xce\xdb\xdf\xbe\xfc\xf2\x7a\xbe\x46\xa3\x50\x06\xaf\xd7\xd2\xc3\x1b\x50\xb3\xbf\xfc\xbc\x35\x7b\x07\x57\xa0\xee\xe4\xbd\xfd\x67\xbf\xf3\xac\x64\x5d\xc7\x99\x07\x8b\x66\x89\x87\x25\x4d\xbb\x7a\xf8\xef\x00\xca\x4c\xc5\x96\xfc\x12\x00\x00"),
+		},
 		"/src/syscall/go116_syscall_darwin.go": &vfsgen۰FileInfo{
 			name:    "go116_syscall_darwin.go",
 			modTime: time.Date(2021, 2, 2, 15, 4, 6, 744710455, time.UTC),
@@ -817,31 +849,40 @@ var FS = func() http.FileSystem {
 			name:    "js",
 			modTime: time.Date(2020, 10, 13, 23, 35, 11, 0, time.UTC),
 		},
-		"/src/syscall/js/go112_js.go": &vfsgen۰FileInfo{
-			name:    "go112_js.go",
-			modTime: time.Date(2020, 10, 13, 23, 35, 11, 0, time.UTC),
-			content: []byte("\x2f\x2f\x20\x2b\x62\x75\x69\x6c\x64\x20\x6a\x73\x0a\x2f\x2f\x20\x2b\x62\x75\x69\x6c\x64\x20\x21\x67\x6f\x31\x2e\x31\x33\x0a\x0a\x70\x61\x63\x6b\x61\x67\x65\x20\x6a\x73\x0a\x0a\x66\x75\x6e\x63\x20\x28\x76\x20\x56\x61\x6c\x75\x65\x29\x20\x53\x74\x72\x69\x6e\x67\x28\x29\x20\x73\x74\x72\x69\x6e\x67\x20\x7b\x0a\x09\x72\x65\x74\x75\x72\x6e\x20\x76\x2e\x69\x6e\x74\x65\x72\x6e\x61\x6c\x28\x29\x2e\x53\x74\x72\x69\x6e\x67\x28\x29\x0a\x7d\x0a"),
+		"/src/syscall/js/go112_js.go": &vfsgen۰CompressedFileInfo{
+			name:             "go112_js.go",
+			modTime:          time.Date(2026, 10, 18, 20, 55, 2, 650834921, time.UTC),
+			uncompressedSize: 910,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x92\xcd\x8e\xd3\x30\x10\xc7\xcf\xf5\x53\x0c\x7b\x21\x11\x55\xaa\x6e\xd1\x0a\x55\xe2\xc0\x09\xb1\x17\x10\x5d\x71\x41\x1c\xa6\xce\xa4\xf5\xd6\x6b\x47\x63\x3b\x28\xaa\xf2\xee\x68\x9c\x46\x89\xf8\x38\x70\x8a\x27\xf3\xf9\x9b\xff\x6c\x36\xf0\xe6\x98\x8c\xad\xe1\x39\xa8\xd9\x78\x75\xf2\xdb\x6a\xbb\x53\xaa\x45\x7d\xc1\x13\x89\x57\x35\xc9\x69\x28\x3a\xf8\x86\x36\x51\x09\x87\xc8\xc6\x9d\x8a\x12\x42\x7e\xc0\x55\xad\x98\x62\x62\x07\x5d\x65\x5c\x24\x76\x68\x8b\xb2\x9a\xc2\xd4\xa0\xa4\xc1\x53\xdf\x52\xfd\x81\x19\x7b\x60\x6a\x99\x02\xb9\x18\x00\xe1\x11\x3b\x3c\x68\x36\x6d\x84\x28\x21\x80\x12\x53\x29\x31\x96\x49\x21\x72\xd2\x51\x9a\xe5\x31\xfe\xa8\xfa\xb9\x81\x71\x8a\x7f\x17\x85\x23\xea\x0b\xd5\x70\xec\x21\x9e\x09\x82\x35\x9a\x5e\x67\xfa\xe4\x6a\x62\xdb\x0b\xcd\xad\xfd\x66\x93\xcb\x4b\x58\x6a\x5b\xcf\x91\xea\x5c\x2a\x00\x32\xc1\xf7\x1f\xc6\xc5\x77\xeb\xf1\xbb\x7d\xb8\x3d\x76\xf7\xf2\x48\x93\x2b\x8d\x3e\xa9\x33\x1a\xa3\xbf\xb1\x1e\xe3\xee\x1e\xd0\xd5\x93\xf5\xf0\xb6\x82\x2f\x18\x42\xee\xef\x20\xb9\xb9\x67\x27\xb0\xa0\x31\x05\x69\x2d\xb5\x5a\x74\x46\x57\xa3\x28\x4b\xfc\x22\xf3\x40\x56\xa0\x41\x4d\xd7\xa1\x5c\xf8\x65\x71\xe1\xa7\x89\xfa\x3c\x72\xc3\xfe\xfd\xf8\xa8\x0a\xc1\x2a\xc5\xaf\x31\xfc\x1f\xda\xdf\xb8\xd6\x33\xd4\x5e\xad\xa6\xcb\x98\x07\xb9\xfa\xe3\x33\xe9\xf8\xe4\xb3\x8c\x85\xa9\xab\x4f\xae\xf3\x17\x1a\xc7\x2f\xcb\x41\xad\x6a\x6a\x30\xd9\x28\xe9\x19\xb6\xb8\x5b\x72\xee\xc1\xf9\x08\xb8\xd0\x25\x67\xde\x95\x6a\x35\xdc\xae\xe2\x2b\x59\x12\x96\x86\x89\x02\xa4\x16\x98\x82\x4f\xac\x65\x85\xd6\x7a\x8d\x92\xd5\x78\xce\x67\xb0\xbc\x3a\x11\x9c\x29\x4b\xec\xbc\x23\xa9\x65\x1c\x7c\xf4\xed\x99\xf8\xf1\xb0\x86\x60\x9c\xa6\xdf\xb3\x20\x9c\x91\x29\xe4\xdf\x2f\xf4\xe2\xb9\x07\xdf\xcc\x17\x76\x93\xaa\xc0\xc5\x12\xca\x69\xc4\x42\x16\x3f\xa8\x5f\x03\x00\xe8\x3d\x84\xf2\x8e\x03\x00\x00"),
 		},
 		"/src/syscall/js/go113_js.go": &vfsgen۰CompressedFileInfo{
 			name:             "go113_js.go",
-			modTime:          time.Date(2020, 10, 13, 23, 35, 11, 0, time.UTC),
-			uncompressedSize: 1574,
+			modTime:          time.Date(2026, 10, 18, 20, 55, 2, 650834921, time.UTC),
+			uncompressedSize: 1610,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc4\x52\x41\x6b\xdb\x4c\x10\x3d\x4b\xbf\x62\xd0\x69\x45\x82\x4c\xf8\x2e\x1f\xa1\x09\x34\x81\x86\x98\xd2\x40\x9d\xf4\x12\x72\x58\xad\x46\xf6\x26\xab\x59\xa1\x5d\xd9\x88\xe2\xff\x5e\x66\x25\xa7\x92\x63\x37\xcd\xa9\x37\xcd\xd3\x9b\x79\xb3\xf3\xde\x6c\x06\x27\x79\xab\x4d\x01\xcf\x2e\xfe\x5d\x2c\xed\x59\x76\xf6\x5f\x1c\xd7\x52\xbd\xc8\x25\xf2\xcf\xb8\x6c\x49\x81\x58\xc3\x0f\x69\x5a\x4c\x61\xe1\x1b\x4d\x4b\x91\x82\x0b\x1f\xf0\x33\x8e\xdc\x46\x7b\xb5\x82\x75\x76\xdf\xd5\x28\x52\x86\x94\x74\x08\x5c\xf6\xf4\xf3\x38\x8a\x1a\xf4\x6d\x43\xb0\xce\x34\x79\x6c\x48\x1a\x91\x66\xbb\x61\xa3\x86\x07\x2a\xb0\xd4\x84\xc5\xa8\x27\xf9\xd4\xee\xd0\xcb\x64\xc4\xfd\xd6\x1a\x33\xa1\x51\x6b\xcc\x84\x71\x65\xad\x41\x49\x13\x52\x3e\x60\x90\xc0\xc9\xe1\x75\xe0\x04\x92\x3d\xa1\x2a\xc7\x66\x4f\x2a\x40\x1f\x18\xb2\xe8\xaa\xdc\x4e\xf7\x75\x01\x9a\xb0\xee\xf2\x67\x54\x7e\xc2\xb2\x01\x9a\xb0\xbe\xb4\xa4\xbc\xb6\xd3\x87\x95\x03\xc8\xcc\x02\x4b\xd9\x9a\x30\xa7\x96\xa4\x95\x48\x72\x59\x80\xef\x6a\x4c\xd2\x38\xda\xc6\xdb\x98\x6d\xbf\xb6\x75\x77\xd5\x79\x74\xf7\xf6\xc6\x82\xb2\xb5\x46\x07\x39\x03\x50\x36\xb6\x02\xbf\x42\x78\xd0\xe4\xff\xff\xdc\x34\xb2\x03\xd7\x28\xf0\x16\x0a\xe7\x33\xee\xbe\xf5\xd0\x6b\xbb\x40\xec\x2f\x02\xb6\x1c\x26\x84\x71\xc5\x29\x6c\x56\x5a\xad\x60\xa3\x8d\x81\x1c\x03\xb3\xd2\xa4\xab\xb6\x62\x2a\x97\x06\x69\xe9\x57\x8e\x4b\x56\x90\x54\xbc\x4a\x4c\x17\x0c\x2f\x71\xa0\x7b\x9e\x76\x40\xd6\x83\xa4\xd1\x8a\x59\x1f\xd7\x49\x9b\x28\x9c\x87\xc7\x27\x5e\xea\x34\x34\x0e\x49\xd6\xe4\x39\xaa\xc3\xf9\x94\xad\x3b\x66\x06\xca\xd8\xcf\x5b\xfe\x2c\xa5\x42\x91\x66\xa2\x1f\x93\xa6\xc3\xfd\x3e\x2a\x36\x9b\x41\xe4\xe0\xfc\x02\x2a\xf9\x82\x62\x44\xcb\x6e\xd0\x8b\x84\xcb\xaf\xe1\x18\x49\xd0\x15\x69\x1a\x5a\x24\xb7\xb0\xed\x45\x78\xe4\x5d\x29\xdc\xf0\x23\xbb\x96\xc6\x88\xc4\xa1\x4f\xc2\x9c\x1d\xfc\x1d\x0d\x4a\x87\xa2\xaf\xdf\x3e\x31\xe0\x6f\x32\x30\x5f\x1c\xc8\xc0\x60\xfa\x5e\x14\xfe\x4d\x06\xe6\x8b\x51\x06\xf8\xd2\x7f\x97\x81\xf9\x22\xd8\x12\xac\xe8\x5d\x19\x7c\x3c\x92\x81\xf7\xec\x1f\x4e\x7d\x38\x03\xef\x89\xb1\x21\xc4\x86\xb2\xd0\x11\xdb\x03\x49\x97\x40\x70\xc9\x97\x11\x2c\x37\xb4\x46\x04\x17\xaf\x58\x40\xb6\xc7\x32\xd2\xa8\xc7\x73\x7a\xea\x49\x2c\x36\xce\x8a\xfc\x63\x52\xa8\x8f\xc7\xaf\x00\x00\x00\xff\xff\x03\xd5\xa1\x6d\x26\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc4\x93\x41\x4f\xdc\x3a\x10\xc7\xcf\x9b\x4f\x31\x2f\xa7\x44\xac\xb2\x0f\xbd\xcb\xd3\xea\x81\xf4\x40\x2d\x62\x55\x95\xc3\x42\x2f\x88\x83\xe3\x4c\x76\x0d\xce\x38\xf2\x38\x0b\x69\xc5\x77\xaf\xec\x64\x21\x5e\x90\xda\x4a\x95\x7a\x8b\x27\xff\x99\xff\x78\xe6\xe7\xc5\x02\x8e\xca\x4e\xe9\x0a\xee\x39\x79\x3d\x6c\xcc\x71\x71\xfc\x4f\x92\xb4\x42\x3e\x88\x0d\xfa\x9f\x49\xdd\x91\x84\x6c\x07\x5f\x84\xee\x30\x87\xb5\xb3\x8a\x36\x59\x0e\x1c\x3e\xe0\x5b\x32\xe3\x47\xe5\xe4\x16\x76\xc5\x75\xdf\x62\x96\xfb\x90\x14\x8c\xe0\x8f\x83\x7c\x99\xcc\x66\x16\x5d\x67\x09\x76\x85\x22\x87\x96\x84\xce\xf2\x62\x5f\x6c\x92\x70\x43\x15\xd6\x8a\xb0\x9a\xe4\xa4\xff\x75\xfb\xe8\x69\x3a\xd1\x7e\xee\xb4\x8e\x64\xd4\x69\x1d\x29\xce\x8c\xd1\x28\x28\x12\x95\x63\x0c\x52\x38\x7a\xbf\x1d\x38\x82\xf4\xc0\xa8\x29\xd1\x1e\x58\x85\xd0\x2f\x14\x59\xf7\x4d\x69\xe2\x7e\x39\x84\x22\xd5\x55\x79\x8f\xd2\x45\x2a\x13\x42\x91\xea\x63\x47\xd2\x29\x13\x5f\xac\x1e\x83\x5e\x59\x61\x2d\x3a\x1d\xea\xb4\x82\x94\xcc\xd2\x52\x54\xe0\xfa\x16\xd3\x3c\x99\x3d\x27\xcf\x89\x5f\xfb\xb9\x69\xfb\xb3\xde\x21\x5f\x9b\x0b\x03\xd2\xb4\x0a\x19\x4a\x1f\x80\xda\x9a\x06\xdc\x16\xe1\x46\x91\xfb\xf7\x7f\x6b\x45\x0f\x6c\x25\x38\x03\x15\xbb\xc2\x67\x5f\x3a\x18\xbc\x39\x08\x87\x89\x80\xa9\xc7\x0a\xa1\x5c\x35\x87\xc7\xad\x92\x5b\x78\x54\x5a\x43\x89\x41\xd9\x28\x52\x4d\xd7\x78\xa9\x3f\x6a\xa4\x8d\xdb\xb2\x3f\x7a\x07\x41\xd5\x8b\x45\xdc\x60\xb8\x09\x83\x1a\x74\x8a\x81\x8c\x03\x41\x93\x16\x8b\x01\xd7\x28\x2d\xab\xd8\xc1\xed\x9d\x6f\x6a\x1e\x12\x47\x92\x15\x39\x8f\xaa\xaa\xe1\x2f\xb6\xb2\xb8\x24\x76\x82\x24\x5e\xd5\xd9\x85\x36\x65\xd8\xe5\x05\xba\x2c\x7d\xad\x9e\xe6\x01\xee\xfd\x44\xb9\x67\x29\xb4\x5e\xdc\xf3\x32\x76\x5c\x02\x3e\xb5\x28\x1d\x56\xfb\x91\x95\x18\xf7\x39\x2c\x61\x46\xb0\x3c\xf1\x92\xe2\x53\x18\x81\x7f\x08\xaa\x06\x82\x53\x3f\x13\xdf\xf7\xe0\x47\x70\xf2\x12\x08\x79\x8b\x05\x7c\x78\x1a\x88\x53\x5f\xfd\x2b\x14\xe3\x05\x61\xa3\x76\xc8\x20\xa6\x5b\x73\x5b\xe1\x80\xb7\xc2\x22\x83\x72\x0c\x0d\x36\xc6\xf6\x45\x32\x53\x55\x71\x49\x3b\xf3\x80\xa1\x70\x71\x2e\xb4\xce\x52\x46\x97\x86\x31\x4d\x99\x1e\x7f\x75\xa5\x08\xcd\xcf\xe1\xef\x39\x50\x9e\x27\x7b\xf6\xe8\x2d\x4f\xab\xf5\x3b\x3c\x8d\xd3\x38\xc0\xea\xcf\xf0\xb4\x5a\x4f\x78\xf2\x88\xfc\x1c\x4f\xab\x75\xe0\x29\x30\x34\xe0\x34\x4c\x3e\xe2\xc9\xdb\xfd\x36\x9e\x56\xeb\x09\x4f\xde\xfb\x07\x3c\x79\xf3\x77\x79\x62\x2b\x63\x9e\x7c\x20\xe4\xf9\x94\xb7\xeb\x0e\x24\xbc\x42\xc2\x56\xde\x2e\xe9\xee\x60\xed\xdf\x07\x00\xe2\x40\x34\x9f\x4a\x06\x00\x00"),
 		},
 		"/src/syscall/js/go114_js.go": &vfsgen۰CompressedFileInfo{
 			name:             "go114_js.go",
-			modTime:          time.Date(2020, 10, 13, 23, 35, 11, 0, time.UTC),
-			uncompressedSize: 1090,
+			modTime:          time.Date(2026, 10, 18, 20, 55, 2, 650834921, time.UTC),
+			uncompressedSize: 735,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x53\x4d\x8f\xd3\x30\x10\x3d\xc7\xbf\xc2\xcd\xa1\x72\x04\x72\xb4\x12\xa7\x45\xbd\x00\x5b\xc4\xa5\x1c\xf8\xb8\x3b\xc9\x24\x75\x98\xda\xc1\x1f\xad\xaa\xaa\xff\x1d\xd9\x4d\x1b\x43\x5a\x89\xbd\x8d\x3d\xf3\xde\x3c\xbf\x19\x97\x25\x7d\x53\x79\x89\x0d\xed\x2d\x99\x0e\x9d\x7e\xe2\x4f\xef\x08\x19\x44\xfd\x4b\x74\x10\x92\x44\xee\x06\x6d\x1c\x65\x24\xcb\x3b\xe9\xb6\xbe\xe2\xb5\xde\x95\x9d\x1e\xb6\x60\x7a\x3b\x05\xbd\xcd\x49\x41\x48\xeb\x55\x4d\xd9\x9e\xfe\x14\xe8\xa1\xa0\x5f\xec\xc6\x23\xb2\x82\x56\x5a\x23\x3d\x91\xcc\x80\xf3\x46\xd1\x3d\xff\x7e\x1c\x80\x15\x74\xb5\xa2\x21\x0a\x55\xe4\x7c\x07\xfe\x43\x35\xd0\x4a\x05\xcd\x9c\x63\xb1\xe7\x52\x49\x07\xcd\x5d\xe0\x46\x6c\xe6\x90\xde\xf2\xcf\xa8\x2b\x81\xfc\xa3\x40\x64\xb9\x0c\x65\xf9\x5b\x1a\x98\x1c\x18\x25\x90\x15\x05\xff\xa0\x35\xb2\xe2\x0e\xeb\xcb\x6f\x2f\x90\x1d\xae\xc7\x2b\x79\x59\xde\x5e\x35\xd1\x84\x97\x1d\xd2\xf3\x72\x19\x14\x8f\xc2\x48\x26\xdb\xc9\x84\x45\x28\x1d\xe3\x13\xc9\xae\x6a\x5b\x81\x16\x48\x76\x26\x99\x3d\x48\x57\x6f\x27\xc0\x89\x64\xb5\xb0\x10\xad\xfb\xe6\x8c\x54\xdd\xf3\x04\x4b\x55\xf0\x4b\x76\x2e\xe7\x96\x48\x98\x6e\x5e\x27\x64\xce\x78\x48\x4a\xc2\x9c\x1e\x67\x83\x71\x20\xd4\x23\x2d\x17\x5f\x67\x4a\x46\xbb\xd3\x26\xbb\x0a\xcc\x23\x96\x35\x6a\xe1\xee\xd0\x8c\xf7\xa9\x33\xc7\x5d\xa5\xf1\x01\xcf\xbf\xf8\x04\xf7\xb5\xea\xa1\x76\xaf\xc7\xad\xbd\xaa\x9d\xd4\xea\xbf\x91\x0d\xb4\xc2\x63\xec\x34\x08\x25\x6b\x96\x57\xa2\xa1\xee\x38\x40\x5e\x84\xb9\xcf\x37\xf0\x13\x20\x38\x60\x03\xb5\x71\x7c\x71\x13\xc2\x26\x85\xf6\xf4\x79\x75\xdb\x90\xf7\xe3\xd5\x62\x95\xbc\x28\xee\xd6\xa5\xd1\x32\xf2\xbd\x18\xa3\xcd\x29\x8f\x31\xbf\x50\x87\xcf\x10\x10\xe7\x28\x20\xfb\xcb\xf9\x6b\xf3\xf0\x35\xfe\x04\x00\x00\xff\xff\xda\xc8\x13\x42\x42\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x91\x3f\x8f\xd4\x30\x10\xc5\xeb\xf8\x53\xcc\xa5\x58\x1c\x40\x8e\x4e\xa2\x02\xa5\x01\x4e\x08\x8a\x50\xf0\xa7\x9f\x38\xb3\x89\x83\xd7\x36\x63\x27\x11\x3a\xed\x77\x47\xce\xdd\xb2\x2b\xed\x16\x74\x33\xc9\xcc\x9b\xdf\x7b\xae\x6b\x78\xd5\xcd\xc6\xf6\x30\x45\x71\x6e\x06\x7f\xaf\xee\xdf\x08\x11\x50\xff\xc2\x81\xf2\x4f\x61\x0e\xc1\x73\x02\x29\x8a\x72\x30\x69\x9c\x3b\xa5\xfd\xa1\x1e\x7c\x18\x89\xa7\x78\x2e\xa6\x58\x8a\x4a\x88\xfd\xec\x34\xc8\x05\x7e\xa2\x9d\xa9\x82\xcf\xb1\x9d\xad\x95\x15\x74\xde\x5b\x78\x14\x05\x53\x9a\xd9\xc1\xa2\xbe\xff\x09\x24\x2b\x68\x1a\xc8\x55\x9e\x12\xc7\x1b\xeb\x3f\x5c\x4f\x7b\xe3\xa8\xbf\xd6\xb8\x5b\x94\x71\x26\x51\x9f\x17\xeb\x3a\xdf\xc2\x16\x98\x32\x6f\x84\x75\xa4\x34\x12\xc3\x02\x26\x42\x1a\x09\xbe\xe0\x82\xdf\x34\x9b\x90\x60\xc9\xea\x50\xb6\xd8\x96\xea\x06\x31\xb6\xff\x03\x7c\xe8\x88\x61\xb7\x83\x29\xaa\x4f\xd6\x77\x68\xd5\x07\xb4\x56\x96\x26\x0b\x94\xaf\x21\xd3\x25\x62\x87\x56\x56\x95\x7a\xef\xbd\x95\xd5\x33\xe9\xc3\xef\x19\xed\x0d\x52\x74\x3d\xac\x80\x4c\x40\xdb\x04\x6a\xed\xb9\x37\x6e\x80\xe4\x2f\xf8\x5f\x44\x68\x9a\x06\x7c\x20\xc6\xe4\xf9\xca\xc2\x26\x2f\xd7\x53\x7b\x72\x52\xd7\xf0\x72\x8a\xea\x6b\x37\x91\x7e\xce\x20\x6e\xc7\xb4\x3f\x04\x64\xea\x61\x35\x69\xcc\xd2\xea\xc2\xf6\xd9\x44\xb6\xbe\x5e\xf4\x37\xde\xeb\x23\x59\x4a\x24\x03\xc4\xc4\xc6\x0d\x55\x3e\x6b\xf6\xb0\xe4\xc4\xe0\x6d\xf3\x2f\xc5\x77\x70\xb7\x7d\x53\x26\x3e\xe1\xc8\x6d\xb4\x08\xe8\x8c\x96\xbb\x4d\xed\x81\xd9\xf3\x63\xb9\xd5\xea\x49\x38\xa7\x9a\xd7\x8e\x95\x28\x8e\xa2\xb8\x84\x53\xa7\xd3\x19\xeb\xef\x00\x4a\x74\x97\x61\xdf\x02\x00\x00"),
 		},
 		"/src/syscall/js/js.go": &vfsgen۰CompressedFileInfo{
 			name:             "js.go",
-			modTime:          time.Date(2020, 10, 13, 23, 35, 11, 0, time.UTC),
-			uncompressedSize: 6592,
+			modTime:          time.Date(2026, 10, 18, 22, 36, 26, 385421111, time.UTC),
+			uncompressedSize: 7616,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x19\x5d\x73\xdb\xb8\xf1\x59\xfc\x15\x1b\x3e\xe4\xc8\x84\xa5\xee\x23\x4d\x6f\x94\xd1\x43\xda\xe9\x65\x92\xe9\x9d\x3b\x75\xda\x3e\x78\x3c\x0d\x44\x82\x12\x6c\x0a\xe0\x80\x20\x2d\xd7\xd1\x7f\xef\xec\x02\x24\x41\x8a\x72\x9c\x36\xcd\x4c\x24\x68\xb1\xdf\xbb\xd8\x5d\xc0\xcb\x25\xbc\xdc\x34\xa2\xcc\xe1\xa6\x0e\x82\x8a\x65\xb7\x6c\xcb\x69\x2d\xf6\x95\xd2\x06\xa2\x60\x11\x6a\x5e\x94\x3c\x33\x61\xb0\x08\x1b\x59\xb3\x82\x87\x41\xb0\x08\xb7\xc2\xec\x9a\x4d\x9a\xa9\xfd\x72\xab\xaa\x1d\xd7\x37\xf5\xb0\xb8\xa9\xc3\x20\x0e\x02\x73\x5f\x71\xf8\x88\x1f\x42\x9a\x20\xc8\x94\xac\x89\x25\x82\xfe\x2e\x73\x5e\x08\xc9\x73\x8b\xb0\x06\xa1\x0c\xb3\x5b\xbf\x35\x65\x69\x57\x7f\x54\xaa\xe4\x4c\x76\xe0\xfd\x86\x6b\xbb\xbe\x34\x5a\xc8\xad\x5b\xdf\xef\x37\xca\x11\x5c\x6c\x6e\x78\x66\xec\xfa\x97\x46\x66\x46\x28\x89\x9a\x14\x8d\xcc\x20\x32\x24\x2b\x06\x4b\x1d\xc5\x50\xd3\x02\x1e\x82\x45\x7d\x27\x4c\xb6\x03\x83\xeb\x8c\xd5\x56\xed\x5e\xc7\x55\xb0\x58\x68\x6e\x1a\x2d\x21\x6c\x3a\x60\xe8\x61\xa2\xca\x3e\x92\x6c\xca\xd2\xdf\x77\x86\xf8\x28\x1b\x0b\x1a\x73\x41\x0b\xc7\x7c\x10\xe2\xe3\x58\xdd\x7d\x1c\x6b\xc4\x08\x87\x3c\x32\xc2\x21\x88\x8f\x63\x3d\xe5\xe3\x28\x82\xf8\x38\x9d\x07\x7d\xac\xc2\xc1\xc2\x60\x91\xf3\x82\x35\x25\xf1\xa8\x98\x14\x59\x14\x6e\x58\x0e\x18\xf4\x30\x0e\x16\xc7\xe0\xe8\xfc\xfe\xae\x54\x1b\x56\x46\x31\xfc\x83\x95\x0d\x47\x0f\x3b\x66\x56\xe2\x47\x45\xf0\xe8\xa6\x4e\x2d\x66\xdc\x53\xa2\x5b\xbf\x48\x27\x85\x47\xd1\x87\xec\x29\xe2\x7a\x64\xa2\xa7\x6c\x45\x93\x31\x2d\x9a\x8c\x52\x81\x50\x7b\xe6\x51\x41\xfb\x31\xfc\x8d\x97\x9c\xd5\x3c\x8a\x11\xa7\x48\xad\xa0\xb5\x53\xb7\x47\x47\xdc\x8b\x22\x2a\x24\xe0\xcf\xc8\xec\x44\x6d\x75\x4a\x80\xe9\x6d\x0d\x57\xd7\xf4\x2b\xc6\xd3\xc1\x75\xc1\x32\xfe\x70\x8c\xad\x06\x83\xd2\xf8\xf3\x21\x58\x58\x4d\x56\xa7\x36\xfc\xca\x6e\x29\x4e\xd1\x20\xe3\xc5\x4d\x9d\xda\xf0\xf6\x82\x06\xd0\x48\x1a\xca\x59\x2c\x5a\x42\x5a\xad\x61\xcf\x6e\x79\xe4\xb4\x4a\xa0\xe4\x32\xc2\x9d\x38\x46\xa4\x42\x69\x10\x09\x30\xc4\xd3\x4c\x6e\xb9\x65\x4d\x0c\x2c\x87\x2b\x71\x0d\xeb\x89\x82\x8c\x68\x8f\xf8\xe1\xec\x29\x64\x34\x46\x41\x95\xe3\x04\x88\x05\x62\x1f\xe3\x38\x71\xd9\x43\x11\xf9\xb3\xd6\x4a\x9f\x0f\x89\x43\x88\xed\xd7\xe8\x4c\x77\x29\xfb\x81\xb5\xec\x32\xd3\xa2\x32\xc0\x11\x69\x05\x21\xbc\x04\x9e\xbe\xe3\x26\x0a\xf7\xbc\xae\xd9\x96\x87\x71\xda\x55\x85\x5e\xb2\x0d\xeb\x20\xb9\xf5\x3c\x1b\x04\x8b\xe5\x12\x84\x14\x86\xe7\xa0\x79\xa5\x79\xcd\xa5\xa9\xe1\x6e\xc7\xcd\x8e\x6b\x47\x2b\x6a\x90\x4a\xfe\xee\xdf\x5c\x2b\x68\x11\x92\x82\xd1\x0d\xf7\x09\xcc\x8e\xdb\x2d\x8b\x6c\xe0\xbb\xbe\xc0\x7c\x97\x06\x0b\x27\x01\x8b\x45\x6f\xf3\xd8\x7f\x6a\x73\x03\x7e\x78\xfb\xac\x17\x05\x62\xc2\x7a\x0d\x7e\xaa\x53\xc4\x9c\x67\x08\xf5\xe1\x88\xde\x1e\x83\xd4\xe6\x26\x21\x4d\x29\x0c\x2d\xd3\x58\xb5\x45\x0e\xc3\x3f\xcf\x13\x0b\x21\x6b\xc3\x64\xc6\x2f\x8a\xc9\xc6\x96\x1b\xe2\x47\x15\xde\xdb\xe8\x0a\x32\x1a\x67\xcf\x90\x28\xa0\x3f\xfe\xf0\x6c\x0d\x52\x94\xa4\xa8\xc8\x61\x3d\xec\xa4\x7f\x62\x65\x19\x85\xbc\x65\x65\x98\x40\x18\x75\xb5\x28\x3a\xc4\xf0\x00\xce\x82\xc3\x1b\x38\xc6\x58\x80\x7c\xbd\x9e\xc4\x24\x81\x7b\x9f\x0f\x74\xf4\xaa\x80\xfb\x9e\xe9\xc8\xa6\xb3\x6c\x3f\x8d\x75\x0b\x00\x44\x01\x11\x66\x95\x2a\x10\xb2\x5e\xaf\xfd\x4e\x62\x51\xa0\x13\xfd\xfd\x1b\x58\x2e\xc7\x1d\x28\x00\x38\x3a\x2e\x07\xa2\xc6\x0e\x33\x21\xfb\xa1\x27\xa3\x0e\x3a\x50\x4c\xe4\x76\x9d\x67\x42\xfe\x63\x4f\xde\xb5\xdd\xb3\x1c\x5c\x5b\x9a\x30\xf8\xc9\x93\x4f\xad\xfa\x2c\xbd\x6b\x59\x13\xfa\x57\x3d\xbd\x6b\xef\xe7\xe9\x6d\x3b\x9b\xd0\xff\x7e\xa0\xb7\x23\xc1\x59\xfa\xbe\x89\x4d\x38\xfc\xa1\xe7\xd0\x0f\x0f\x96\x87\xdb\x7f\xdd\xef\xbb\x4c\x3e\xc6\x9f\x46\xad\x8e\x52\xe3\xa2\x88\x0e\xe3\x9a\xde\x9f\x49\x37\x66\x1c\xb0\x8a\x1e\x52\x52\x2b\xee\x47\x0e\x5b\xe2\x87\xe3\x79\x70\x70\xd4\xc5\x07\xdb\x7e\xe3\xf5\xe9\xfc\xad\xd6\xec\xfe\x2c\x8a\x14\xfe\x2c\xe0\x9a\x94\xdd\xc2\x54\x48\x50\x57\xfa\xf8\x99\x3e\x7f\x78\x4d\x5f\x3f\xfd\x48\x5f\xaf\x5f\x25\xd0\x10\x42\x63\x31\x1a\x87\xd2\x38\x9c\xc6\x21\x15\xa5\x62\x04\xa0\x05\x91\xd1\xb4\x98\xfe\x55\x91\x2f\x12\x57\x99\x13\xd8\xb3\xea\xca\xae\xaf\x3d\x2f\x25\x70\xe5\xff\xf4\x34\x1e\xd7\x3b\x91\xa7\xef\x65\xab\x6e\x79\x74\xc0\xce\x74\x32\x84\x7c\x12\xb2\x65\xa5\xc8\xb1\x3f\xad\xe0\x13\xbc\x04\x37\xc0\xa6\x14\x37\x4c\x82\xbe\xd4\x8f\x62\x17\xb5\xe0\xf7\x63\x49\x23\xcb\x50\xb5\x5c\x99\x7a\xd6\xa6\xae\x26\x7b\x85\xd4\x2f\xb0\x7e\x35\x6d\xd3\x76\x86\x3d\x1e\xaf\x28\x26\xdf\x3b\xa6\x2d\x55\x93\xd5\x1a\x5a\x52\x32\x8a\xdf\x38\xd0\xb3\xb5\x7f\x20\x49\xa4\xb5\xf2\x39\xf1\xa2\x9e\xf7\x10\xd2\x3a\x45\xa4\x30\xb1\x84\xc7\x78\xac\xc6\x60\x51\x6a\xa5\xa3\x5a\xcb\x25\x64\x4a\xb6\x5c\x9b\xb7\xd8\xca\xdd\xba\x46\xc7\x35\x7b\x6a\x4e\x42\x1a\xd7\xb8\x6a\xc0\x01\xe0\x1d\x0d\xf8\x1f\x2e\x07\x94\xd4\x1a\xe7\xf1\xa1\x99\x01\xd2\x34\x1d\x9d\x80\x51\x6c\xd1\x0e\xc9\xef\xde\xba\xb1\x63\xb4\x87\xed\x08\x45\xfd\x8b\x66\x97\x99\x69\xa3\x45\x58\x77\xce\x98\xde\x62\x51\xee\x98\xad\x81\x55\x15\x97\x79\xe4\x00\xc9\xc8\xf4\x91\x4f\x1c\xc6\x4c\x78\xa8\x90\xef\xfb\x6c\x9d\x35\xc7\x6f\xb2\x5f\x0a\x9e\x4b\x9f\xe7\xcf\xc7\xe0\xae\xc2\x3c\x1e\x54\x54\x66\x12\x54\x51\x40\xa5\x55\x35\x48\xc5\x39\x66\x1f\xf7\xc2\xfb\xcd\xf3\x82\xc2\x9b\x7a\x05\x83\x80\x15\xd1\x70\x6d\xee\x69\x32\xda\xc3\x4b\x08\xbb\x71\x84\x41\x57\x2c\x13\xd8\x2a\x43\x08\x9d\x84\xf1\x39\x9a\x3f\xae\xa3\xdc\xb3\xae\x4d\x4e\xd2\x25\x4d\xd3\x18\xff\xc7\x33\xe1\xf8\x05\xcb\x49\x14\x77\x65\xe5\x89\x4e\xb7\x1d\xe8\x71\xdf\x12\xe7\x27\x9c\x18\xa7\xc1\xa0\x5b\x77\x8f\x14\xb5\x8d\xad\x77\x98\x1d\xbd\xc1\xb1\xcb\x0b\xfe\xe7\xcf\x03\xa8\x6f\x2d\xa7\xb6\x62\x24\x2b\x97\x79\x5f\x4c\xb2\x67\x04\x4b\x3d\x25\x1e\xb5\xf6\x1d\x3f\x63\xeb\x23\xf1\x22\x7d\x66\xa3\xf2\x5e\xe6\xfc\x10\x09\xac\x10\xdf\x5a\x51\x62\xfd\xd5\xaa\x3a\x85\xce\x28\x8b\x42\x85\x34\xdf\x30\x79\xde\xcb\xa7\xa4\x0e\x49\x9e\xd5\xa8\x1b\x4d\x23\xd3\xc1\x26\x29\x34\x4c\xaf\x5d\xbf\xf3\x39\x27\x60\xfc\xca\xe6\x55\xf5\x13\x49\x44\xfb\x3f\x57\xb1\xa7\x95\x2b\x2b\xed\xbf\x08\x1e\x29\xf9\x35\x65\xe1\xc3\xa5\xe5\x73\x7a\xcd\x9f\x6b\xb9\x7f\xe1\x72\x6b\x76\x43\x12\xcc\xc5\xaa\xc3\x99\x21\x97\xfc\xee\x9f\x9a\x55\xf3\x5e\x9c\xdc\xc0\x12\xbc\x64\xda\x8b\x26\x25\x7a\xce\x0b\xae\xed\x0b\x40\xdc\xcd\x0c\xd4\xd6\x78\xa6\x5a\xae\x23\xba\xa9\x14\xa0\x13\x50\xb7\x16\x6e\xd2\x08\xd9\xd9\x7b\xed\x1b\x04\xd3\x2d\x1b\xd9\xae\x41\x07\x74\x9f\x3e\x22\x1d\x5d\xef\xc6\x46\xfc\xc6\xef\xce\xba\xb1\x33\x7b\xc6\x40\x24\x7b\x3c\x45\xe8\x4a\x88\x2a\x50\x8a\xf8\x0e\xb1\xac\x45\x41\xbb\xfe\xdd\xed\x6b\x92\x0a\xe7\xea\x6c\xc7\xb3\x5b\xd8\x71\xcd\xc1\x28\x60\xad\x12\x39\xa0\x8b\x76\x9c\xe5\x20\x24\xd4\x4d\x96\xf1\xba\x06\x9c\x5c\xd1\x21\x5f\x91\x85\xf4\x04\x61\xf1\x2d\xe6\xc9\x1d\x3a\x7e\x2c\x59\x71\x7f\xc6\x6b\x97\x5e\xb9\x4e\x60\x32\xf3\x7f\xab\x72\x78\x79\x52\xb7\x47\x01\x27\x1d\xc6\x1d\xf5\x10\x5f\x7d\x7f\x7d\x46\x5f\xaf\x6e\xff\x3f\x35\x9e\xab\xe1\x53\xb5\x9d\x2a\xe7\x74\x5f\x2e\x61\xaa\xfe\xc9\xb3\xed\x72\x09\xb3\x47\xb9\x7f\xca\x59\x2e\xe1\xd4\x0d\x1f\x75\x63\x76\xf7\xa7\x4d\xfb\xcc\x98\x3c\xa5\x26\xa7\xd8\x57\xeb\x81\x96\xa0\xfe\xdb\xc0\x5c\xd1\x76\xfd\x60\x78\x61\x1a\x2e\x6f\x67\x1f\xb8\x06\x94\x8b\x22\xaa\x4b\x91\xf1\x71\xd0\x3c\x16\xc3\xfd\xd2\xe2\xad\xd6\x76\x31\xbd\x67\xd2\xbc\xfd\xb3\xbb\x6f\xe1\x55\x8e\x16\x78\x75\xbb\xba\x6e\xba\xad\xa6\xdf\x6b\xfa\xcd\xfe\x8a\xe7\x96\xaf\x5f\x79\xb7\xb4\x41\x91\x87\x73\x17\x36\xd2\x26\x8e\x8f\x73\x4f\xc7\xbe\x9d\x2b\x37\x79\xd6\x4d\x55\x29\x8d\x77\x2d\xa2\x1c\xbf\x2a\x47\x06\x5e\x0c\x44\x93\x37\x59\xd3\xbf\xc9\x76\x6f\x5c\xa3\x47\xbd\xe9\x9b\xe2\xaf\xdc\xec\x54\xee\xf2\xca\xfe\xf5\x00\x80\x2c\xf2\x1f\x1a\x5f\x0c\xb4\x8f\x3d\x37\xd6\xf7\x75\xc6\xca\x72\x89\x33\x36\x2e\x40\x15\xee\xc1\xd1\x89\xc1\xe9\x5a\x49\x07\x1b\xcd\xd1\xbd\x96\x58\x5c\x2b\xae\x87\x50\xa3\x80\x49\xcb\x0b\x8e\xc1\x7f\x02\x00\x00\xff\xff\xf8\x8f\xbb\x4d\xc0\x19\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x59\xdd\x8f\xdb\xb8\x11\x7f\x96\xfe\x8a\x89\x51\x5c\xa4\x8b\x4e\x6e\x92\x22\x38\xec\xc1\x0f\xe9\xc7\x05\x1b\xdc\x25\x87\x6e\xda\x7b\x58\x2c\x0a\x5a\xa2\x6c\x7a\x65\xd2\x20\x29\x7f\x74\xe3\xff\xbd\x98\x21\x25\x51\xb6\xbc\xd9\xb4\xe9\x02\xb1\x69\x72\xbe\xf9\xe3\xcc\x90\x99\x4e\xe1\xc5\xbc\x11\x75\x09\x2b\x13\xc7\x1b\x56\xdc\xb3\x05\xa7\xb1\x58\x6f\x94\xb6\x90\xc4\xd1\xa4\x91\x86\x55\x7c\x12\xc7\xd1\x64\x21\xec\xb2\x99\xe7\x85\x5a\x4f\x17\x6a\xb3\xe4\x7a\x65\xfa\xc1\xca\x4c\xe2\x34\x8e\xed\x61\xc3\xe1\x13\x7e\x08\x69\xe3\xb8\x50\xd2\x90\x1c\x9c\xfa\x87\x2c\x79\x25\x24\x2f\x1d\xc1\x0c\x84\xb2\xcc\x2d\x7d\x68\xea\xda\x8d\xfe\xac\x54\xcd\x99\x6c\xa7\xd7\x73\xae\xdd\xf8\xc6\x6a\x21\x17\x7e\x7c\x58\xcf\x95\x67\xf8\x38\x5f\xf1\xc2\xba\xf1\xcf\x8d\x2c\xac\x50\x12\x2d\xa9\x1a\x59\x40\x62\x49\x57\x0a\x8e\x3b\x49\xc1\xd0\x00\x1e\xe2\xc8\xec\x84\x2d\x96\x60\x71\x5c\x30\xc3\x61\x60\xe3\x55\x1c\x45\x9a\xdb\x46\x4b\x98\x34\xed\xe4\x24\xa0\x44\x93\x43\x22\xd9\xd4\x75\xb8\xee\x1d\x09\x49\xe6\x6e\x6a\x28\x05\x3d\x1c\xca\xc1\x99\x90\xc6\xd9\x1e\xd2\x38\x27\x06\x34\x14\x91\x01\x0d\xcd\x84\x34\x2e\x52\x21\x8d\xa2\x99\x90\xa6\x8d\x60\x48\x55\xf9\xb9\x49\x1c\x95\xbc\x62\x4d\x4d\x32\x36\x4c\x8a\x22\x99\xcc\x59\x09\xb8\xe9\x93\x34\x8e\x8e\xf1\xd1\xc7\xfd\x5d\xad\xe6\xac\x4e\x52\xf8\x27\xab\x1b\x8e\x11\xf6\xc2\x9c\xc6\x4f\x8a\xe6\x93\x95\xc9\x1d\x65\xda\x71\x62\x58\xbf\xc8\x27\x45\xc0\xd1\x6d\xd9\x53\xd4\x75\xc4\xc4\x3f\x9d\x02\x3a\x0c\xc2\x00\x83\x9d\x66\x9b\x0d\x2f\xe1\x9d\x82\xd6\x63\xb0\x0a\xe6\x1c\x0a\x56\xd7\xbc\x84\xf9\x01\xde\xb3\x2d\xbb\x29\xb4\xd8\xd8\xdc\x41\x9d\xd8\x8d\xd5\x4d\x41\x38\x72\xfa\xa7\x53\xb0\x4b\x1e\x10\x07\x02\x97\xcc\x82\x90\x5b\x75\xcf\x0d\x11\x05\xda\xe2\x48\x94\x00\x00\x8d\x90\xf6\xf5\x2b\xb4\x6f\xcb\x34\x1e\x1e\x24\x30\x10\xfe\xcd\x60\xcd\xee\x79\xb2\x66\x9b\x5b\x47\x7d\x87\x34\x09\xa9\xcf\xe0\xf6\x8e\x06\x29\x9e\x41\xae\x2b\x56\xf0\x87\x63\x1a\x47\x92\xef\x2d\xda\x7b\xfd\x57\xaf\x02\x66\xf0\x32\x4e\xbb\x30\x7c\xac\xc0\x85\x0d\xa3\x71\x12\x82\xc6\x9c\x05\x00\x7e\x11\xf7\x1c\x76\xc2\x2e\xe1\x77\x3e\x7f\x6b\x0c\x5f\xcf\xeb\x43\x16\x7b\xef\x3b\x01\xeb\xc6\x58\x14\xa1\x79\xcd\x19\x8a\x21\x96\xbf\xbb\x5f\xb0\x5b\x72\x09\xc2\x82\x30\xf2\xb9\x75\x6a\x98\x3c\xac\x95\xe6\x3f\xa1\x24\x0c\x3d\x9e\x57\x61\x81\x55\x96\xeb\x1d\xd3\xa5\x81\x5a\x2d\x0c\x4c\x70\x0d\xcd\xeb\x04\x77\x40\xcd\x1d\x32\x9c\x53\x49\x25\x69\x25\xb1\x4b\x61\xc0\x87\x88\xe9\x85\x19\x8f\x13\x71\xe1\x5e\x56\xa2\x84\xab\x19\xf4\x51\x0b\x23\xf8\xe2\x85\xdf\x96\xdb\x4a\x94\x77\x30\x83\x4a\x76\xa0\x43\x8a\x87\x38\x8a\x44\x79\x05\x95\x28\xb3\x38\x72\xb8\xb8\x3a\x87\xe3\xaf\xec\x9e\x8e\x5c\xd2\x1b\xf8\xfd\xca\xe4\xee\xa4\x76\x56\xf6\x53\x03\x53\xd1\xc8\x28\xaa\x32\x50\xf7\x68\x68\x6f\x0e\x4e\x8b\x0a\x9e\xa9\x7b\x47\x12\x75\xe7\x2c\x7f\xc7\x6d\x32\xc1\xac\xac\x6a\x3e\x49\xf3\xbf\xb0\xba\x4e\x26\x5c\x6b\xa5\x27\xd9\x63\x11\x4d\x49\x8e\x77\x30\x3c\x47\x38\x7f\xc4\x8f\x2d\x19\x7b\xe5\x91\xe9\x43\x9b\x41\xcd\x65\x82\x2b\x29\x49\xa8\x94\x06\x91\x01\x43\x3a\xcd\xe4\x82\x3b\x17\x9d\x95\x24\xe1\x56\x60\x34\x87\x81\x62\x69\xa7\xc5\x5b\x40\xf3\xb8\xb5\xc9\x90\x12\x23\x98\x66\xb0\x75\x1a\x73\x0a\x96\xc4\x44\x14\x47\xd1\x31\x4d\x33\x9f\xa3\xa6\xd3\x0e\x7f\x95\xe6\xdc\x40\xb3\x01\xcd\x8d\x6a\x74\xc1\x0d\xb0\xba\x56\x05\xb3\xe8\xbe\xd2\x03\x2c\xe7\xc8\xf9\xe9\x0c\xdc\x52\x11\xc0\xdd\xb1\x2e\x1d\x50\x3b\xe4\x7a\x45\xc4\x7a\x6d\x29\xd5\xd4\xb5\xda\xf1\x12\x03\x8d\x44\xc1\x51\x10\x35\x1f\xe8\x43\x6a\x63\x45\x5d\x83\x6e\xa4\x14\x72\xe1\x81\x9d\x38\x6c\xa7\x2d\x6b\x92\x62\x08\x4b\x5e\x73\xcb\x09\x4a\x26\x83\x22\x17\x2e\xc9\x51\x9e\xfa\x1b\x6e\xf1\x69\xa2\xea\x52\x68\xe2\x09\x52\xf7\x35\x28\x93\x6d\x15\x08\xb2\x19\xe1\xe5\x0a\x26\xf0\x02\xb8\x43\xd4\x9a\x1b\xc3\x16\x88\xa8\xb6\xd0\x76\x9a\x49\x53\xa0\x79\x1b\x20\x3c\x8e\xa3\xe9\x14\x84\x14\x18\x6c\xcd\x37\x9a\x1b\x2e\xad\xc1\xa4\x60\x97\x5c\x7b\x5e\x61\x40\x2a\xf9\xc3\xbf\xb9\x56\xb0\xc5\x99\x1c\xac\x6e\x78\xc8\x80\x41\xdb\xf6\xc4\x16\x9e\x77\x35\xfb\x79\x1e\x47\x5e\x03\xd6\xdf\xce\xe7\x21\x70\xd4\x7c\x05\xe1\x31\xeb\x0a\x89\xa8\x90\x12\x66\xb3\x01\xea\x71\x65\x80\xc5\x87\x23\x42\x6b\x38\xa5\xe6\xab\x8c\x2c\x3d\x06\xb9\x5c\x94\x6d\x0e\x07\x08\x23\x11\x09\x69\x2c\x93\x05\xff\x58\x9d\x2c\x2c\xb8\x25\x79\xd4\x34\x05\x0b\x6d\x8f\x83\xce\x39\x00\x88\x0a\xba\x93\x0e\xcf\x66\x20\x45\x0d\x2e\x11\xc1\x0c\x4e\x72\xc0\x1f\x56\xe6\xba\xe4\xd2\x0a\x7b\xa0\xc3\x1d\xa8\x1f\xa5\xed\x96\x89\x7a\x60\xd3\x18\x7d\xb7\xda\xf5\x05\xd3\x69\x7b\x6a\xbb\x2a\xb3\x07\x66\x80\x85\x75\x72\xeb\x93\x06\x56\x16\xdc\xd3\xa0\xb0\xc0\x96\x6b\x43\x9d\xc9\x74\x1a\x4f\xa7\xd1\x67\x2c\x9d\x63\x7f\x9f\x43\x81\x83\x05\xc7\xf6\xc3\xe8\x1f\x5c\x5e\x70\x6c\x2b\x93\x3b\x50\x9c\x6a\xbb\x15\xd6\x38\xc3\xef\x46\xb4\xad\x4c\x4e\x05\xe5\x8c\xad\x3b\xe3\x30\x66\x24\x6e\xdd\xa8\x6f\xd8\x66\x8e\x2d\x38\x36\x44\xf8\x28\x9b\x6f\x3d\x2f\xb0\x61\x9e\x5c\x70\x6d\x80\xc9\x12\xaa\x5a\x31\x6b\x5a\x6d\xd8\x8c\x5e\xd2\xe6\x93\xc4\xb9\xb6\x4b\x0b\x8e\xed\xf6\x2e\x2c\x62\xa1\x6f\x7c\x07\x4c\x6b\x76\x18\x65\xc3\x66\xc7\x09\x1e\xf0\x3b\x36\x77\x9a\xcf\xd8\x10\x75\xbf\x53\x5f\x87\xce\x69\x0e\x85\x92\x5b\xae\x6d\xdb\x85\xd8\x25\x17\x1a\xde\xdf\xb8\x9d\x5d\x73\xbb\x54\x65\x0e\xbf\x61\x6b\x6b\x28\xfb\x33\x79\x00\x85\xb9\x08\x25\x61\x3e\xcb\x40\xc8\xa2\x6e\x4a\xf4\xcf\xd4\x02\xeb\x05\xad\x63\x67\x27\x87\xae\xf9\x64\xdd\xd6\xaa\xfd\xb0\xcd\xe8\x32\x8c\xbf\x87\xec\xb1\x26\xee\xf3\xc4\xd2\x7d\xa5\xbd\x93\xf8\xc6\x61\x3a\x05\xb3\x54\x4d\x5d\xc2\x46\xf3\x82\x97\xbc\xf5\x0a\xac\x02\xb6\x55\xa2\x04\x06\xb5\x52\x9b\x3e\x2d\xed\xbd\x04\x4f\x18\x34\xf4\xfb\xdc\x3b\x9c\xa4\x9e\x46\x8a\xf0\xea\xe0\x5a\x70\xbf\x84\xc0\x41\x9f\x2d\x7d\xfc\x48\x9f\x2f\xdf\xd0\xd7\xeb\x57\xf4\xf5\xe6\x4f\x19\xf5\x93\xee\xf3\x47\xf7\xf5\xf2\x8d\xfb\x7e\xfd\xca\x7d\xb7\x44\x1b\xab\x33\x87\xb0\xd7\xaf\xfc\x80\x96\xe8\x96\x99\xff\xa6\x28\x44\x99\x07\x50\x60\xd3\x30\x5b\x8b\x32\xbf\xa6\x62\x9b\xec\xd3\xd6\xd0\x41\xe8\x91\x93\x9a\x8c\x93\xcc\xf4\x16\xd1\x35\x49\xf3\x0f\x7c\x97\x60\x67\x42\xec\x6d\x57\x62\xfa\xae\x64\xef\x5a\x12\x96\xdf\x70\x7b\x2d\x4b\xbe\x4f\x44\xd6\x6d\xa4\x09\x1b\x0b\xea\x2c\x2e\xd9\xc9\x5a\xe3\xc6\xb1\x8b\x56\xaa\x11\x2b\x5d\x82\xf7\x66\xb6\x06\xde\x67\xb0\x3d\x33\x50\xa1\x81\xc9\x7d\x6f\xdb\xf6\xc9\xb6\xa9\x74\xec\x3a\xe7\xe5\x5c\x61\x33\xc3\x6a\x51\xba\xc4\x36\xbc\xdc\x25\x5b\x08\xfb\x66\x52\x15\x14\x26\x5f\x89\x9e\x6d\x73\x5f\x76\x83\x5a\x39\xec\x1c\xfb\x82\xb9\xcd\xb7\x23\xe2\xf1\x0a\x9d\xa4\x2e\xa9\x39\xa1\x5b\x2a\x38\x57\x33\xd8\xe6\x38\x4a\xd2\x9f\xfc\xd4\xb3\x59\x78\xe9\x86\x87\xce\xa3\xef\x48\x16\xb5\x35\x0f\xce\xbb\x1c\x89\x26\x99\x63\x3c\xa6\x43\x33\x7a\x8f\x72\xa7\xdd\x97\x2e\x9f\x37\xde\x62\xb7\xea\xc7\x98\x50\x16\xcd\x9a\xfa\x0f\x21\xad\xef\x4d\x5c\xda\x78\x47\xcf\x22\xef\x6f\x7a\x12\x9f\x0c\x02\x39\xd4\x16\x43\x9e\xe7\x83\xb4\x30\x4c\x8e\x0f\x78\xe7\xd8\xbd\xf5\x9d\xf5\x60\x0d\x3b\x0e\x54\xf5\x2f\xba\x26\x8c\x34\xd4\x84\x96\x16\x17\x4c\x2f\x10\x0d\xad\xb0\x19\x60\x52\x90\x65\xe2\x27\xb2\x81\xeb\x83\x98\x78\x8a\x91\xed\xa1\xcb\xc3\xda\x9f\xd5\x0c\x46\xdd\x09\xfb\xa8\x2f\x6d\x9e\x87\xcf\x77\xdf\x0d\xa7\xdb\x27\x89\xc7\x37\x15\x8d\x39\xd9\x54\x51\xc1\x46\xab\x4d\xaf\x15\x0f\xd7\x3a\xed\x94\x77\x8b\x97\x15\x4d\xcc\xc1\x60\x8f\x3e\x5d\x99\x2b\xe8\x15\x5d\x11\x2f\xd7\xf6\x40\x4d\xf0\x1a\x5e\xc0\xa4\xed\x3c\xfb\xab\x73\x06\x0b\x65\x89\xa0\xd5\xd4\x75\xc7\x83\x08\x0f\xcf\xe5\x00\x83\x2e\xc4\xd9\x19\x6c\xf2\x3c\x4f\xf1\x5f\x3a\xb2\x2d\x3f\x63\x52\x4d\xd2\x36\xb9\x3e\x31\xf8\xee\x21\xea\xf1\x18\x93\xe4\x27\x9c\x1c\x6f\x41\x7c\x3c\x7d\x85\x13\xc6\xed\x71\x70\xa8\x3d\xbf\xc5\x0e\x3b\x00\xc1\xe7\xcf\xfd\x54\xf7\xaa\x77\xee\x2b\xee\xe8\xc6\x23\xf0\x8b\x60\x7b\x46\x73\x79\x60\xc4\xa3\xde\xbe\xe3\x17\x7c\x7d\x64\xbf\xc8\x9e\xd1\x5d\xf1\x55\x04\x33\xc5\xb7\x36\x94\x44\x7f\xb5\xa9\xde\xa0\x0b\xc6\xa2\x52\x21\xed\x37\x04\xcf\xb5\x7c\x0a\x74\x48\xf3\xa8\x45\xed\xfd\x23\xb1\xed\xdc\x09\x84\xfa\x1b\x4c\xdb\x1c\x84\x92\x33\xb0\xc1\xaf\x30\xbb\x9f\x69\x22\xde\xff\x39\x9b\x3d\x2d\x6d\x39\x6d\xff\xc5\xe6\x91\x91\x5f\x93\x16\xba\x9e\xef\xec\x91\x74\xac\xf4\xfe\xc2\xe5\xc2\x2e\xbf\x04\x82\xaf\x04\xaa\x13\xfa\x04\x14\xb4\xda\x47\x0c\x93\x7c\x87\x1d\xed\xf8\xfe\x9c\x5c\xe3\x33\xe0\x5a\xd3\x6f\xff\xba\x41\x8f\x24\x15\xd7\xee\x3d\xd0\x59\x2b\xe8\x3e\x4a\xf5\x93\x17\x6a\xcb\x35\x3a\x86\x33\xc1\x05\x3a\xd2\xed\x23\x9b\xe6\x36\x4f\x7a\x89\x67\x2f\x6d\xce\x77\xcd\x6d\xff\x64\x85\x36\xcc\x40\xbb\x5e\xec\x88\xfd\x1c\x3d\x28\x0c\x3d\xc6\x4e\xef\xd2\x6e\xb6\x31\x1a\x89\x06\xb2\x3d\x8e\x54\x7a\x84\x40\x13\x68\xe3\xc2\xe8\x39\xd1\xa2\xa2\xd5\xc0\xd9\xaf\xc2\x36\xde\x4c\x8a\x25\x2f\xee\x61\xc9\x35\xef\x6f\x23\x18\xc8\x25\x67\x25\x08\x09\xa6\x29\x0a\x6e\x0c\x60\x27\x1c\x47\x8f\xe0\xe3\x03\xdf\x85\xe0\xa0\xf0\x39\x62\x47\x36\x3c\x10\x5c\xeb\xf6\xb9\xe6\xb1\x73\xa3\xe6\xab\x31\x1c\xdd\x04\x95\x23\x83\x93\xbb\xd9\xb7\x02\xfc\xcd\x59\x09\x19\x6c\x3a\xd9\x30\x2c\xee\xfb\xf4\xf6\x8f\x77\x17\xec\x0d\x4a\xc8\xff\xd3\xe2\xb1\x72\x72\x6a\x76\x77\x27\x7a\x92\xed\x9f\x74\x63\x97\x87\xf3\xa2\x7f\xa1\xdd\x3e\xe5\x26\x4f\xe8\x2b\xe0\xa5\xd9\xf0\x19\x6a\x2c\xe9\xfb\x7a\x72\xf2\x18\x79\xfa\x16\xfa\x2b\x5d\xfb\x3d\x16\xdc\x7f\x24\x02\x90\x82\xf0\x81\xf4\xfb\x9e\xf7\xb1\x67\xd2\xb0\x61\xc4\x01\xa8\xca\x3f\x94\x7a\x35\xd8\x2a\x2a\xe9\xe7\x06\x4d\x61\x67\x65\x7b\xb7\xef\x76\x18\x15\x9c\xe4\xef\xf8\x18\xff\x67\x00\x3e\x18\xd5\xc6\xc0\x1d\x00\x00"),
+		},
+		"/src/syscall/pipe_unix.go": &vfsgen۰CompressedFileInfo{
+			name:             "pipe_unix.go",
+			modTime:          time.Date(2026, 10, 18, 21, 17, 6, 917575605, time.UTC),
+			uncompressedSize: 3794,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x57\x5f\x8f\xdb\xb8\x11\x7f\x96\x3e\xc5\xc4\x28\xae\x52\xa2\xc8\x9b\xbb\x97\xc2\xa9\x1f\x72\x69\x12\x6c\x11\x24\xc1\x6d\x82\xa0\x48\x17\x05\x25\x8e\x2c\xda\x32\x29\x90\x54\x1c\x63\xe1\xef\x5e\xcc\x90\xb2\xec\xb5\xd3\xde\xe2\x80\x93\xc9\xf9\xfb\x9b\xdf\xcc\x30\xf3\x39\x3c\xab\x06\xd5\x49\x58\xbb\xe2\xc9\x4e\x69\x69\x76\x2e\x4d\x7b\x51\x6f\xc4\x0a\xc1\xed\x5d\x2d\xba\x2e\x4d\xd5\xb6\x37\xd6\x43\x96\x26\xb3\x95\xf2\xed\x50\x95\xb5\xd9\xce\x57\xa6\x6f\xd1\xae\xdd\xf4\xb1\x76\xb3\x34\x4f\xd3\xf9\x1c\xbe\x2a\xdf\x9a\xc1\x83\x6f\xd9\x8c\xc7\x2d\x90\x29\xd8\x1a\x39\x74\x58\x80\x80\x77\x06\x7a\x6b\x56\x56\x6c\x41\x69\xf8\x60\x24\x96\x6b\x07\xad\x70\xa0\x0d\x34\xaa\x43\x32\x23\xd1\xd5\x56\xf5\xde\x58\x07\xa6\x01\xe5\x1d\x98\x9d\x86\x0a\x9d\x92\xe8\x82\x75\x2f\xb4\x14\x56\x82\xd1\xe8\x4a\xf8\xdc\x22\xf4\xaa\xe7\x4b\xe1\xc1\xb8\x39\xfe\xc0\x9a\x6c\xd5\x46\x6b\xac\xbd\x03\x6f\xa0\x6e\x29\xe9\xde\x9a\x1a\x9d\x43\x07\x42\x4b\x36\x36\x97\xf8\x7d\xae\x87\xae\x03\xe5\xc1\xf4\xa8\x1d\x34\xc6\xd2\xd5\x16\x84\xe5\x90\x70\x3b\x74\xc2\xa3\x84\x16\x2d\x16\xb0\x53\xbe\xe5\x70\xcf\x62\x0d\xbe\x75\xb7\x07\xdf\x2a\x07\x23\xa0\x1b\x6d\x76\x0e\x44\x65\x06\x5f\x32\x4c\xaf\x60\xed\x3e\xa9\x1e\x41\x39\x10\x1c\x77\x50\x55\x0e\xaa\xa1\x69\xd0\xa2\x24\x74\xb6\xb8\x35\x76\x5f\xa6\x7e\xdf\xe3\xa8\xe1\xbc\x1d\x6a\x0f\x0f\x69\x52\x0d\x0d\xd0\xdf\xb7\xfb\x6a\xef\x31\x4d\x2c\x0a\x89\xd6\x81\xd2\x1e\xa6\xbf\xf9\x9c\x13\x02\xba\x05\xd4\xd2\x15\xa0\x74\xdd\x0d\x52\xe9\x15\xe7\xfe\x18\x13\x12\xa4\x3b\xe5\xd3\x64\x67\x95\xff\xb9\x49\xbe\xfd\x53\x36\x49\x92\xef\x4c\x30\x2b\x14\xdb\xab\x5b\xa1\x63\x42\x0f\x07\x32\x5b\x77\xc6\xa1\x84\x5d\x8b\x1a\xa4\xf0\x02\x84\xb5\xea\x3b\x3a\x08\xc5\x80\x4e\x38\x1f\xdc\x5a\x58\x19\x2a\xe0\x4e\xec\xd3\xc4\x29\xbd\x01\x00\x78\xba\x76\xe5\xc7\x6a\x8d\xb5\x8f\x61\x3a\x2f\x95\x26\x06\x5d\x04\x75\x4c\xd3\x47\xe2\x14\xa0\x1a\x10\x7a\x9f\x1e\xb8\x42\xda\x78\xd5\xec\x61\x27\x36\xe8\x60\xe8\x59\x6c\x65\xac\x19\xbc\xd2\x23\xc9\x38\x0d\xe2\x49\x5f\xa6\xcd\xa0\x6b\xc8\x7a\x78\x1a\xca\x94\x47\x03\x59\x4e\x95\x52\x0d\xf4\x25\x4b\x3f\x59\x82\x56\x1d\x9d\x25\x9c\x6b\x16\xce\xf3\x34\x49\xa2\x04\x0b\xa4\xc9\x21\xc6\xd1\x0f\xae\x05\x21\xa5\x0b\x78\x44\xbf\x01\x51\x8f\x9a\x10\xbd\xe6\x9d\xd4\xb2\x2a\x52\x83\x63\xe8\x4b\xe2\xcb\x12\x44\xdf\xa3\x96\x19\xff\x2c\xa0\x2a\xcb\x32\xa7\xcb\x31\xdc\xe8\x96\x83\xfb\x1a\x80\xe6\x6f\x47\x7d\x36\x22\x39\x15\x9e\x4e\xae\xf9\x3f\xd1\xcf\xa2\xfb\xc8\xa5\xe7\xcf\x47\x3c\xc2\x6f\x58\x2e\xe1\x86\x01\x39\x09\x22\x09\x22\x5c\xd7\x13\xc8\x92\x70\x54\xbe\x16\x5d\x97\xcd\x50\xcb\x19\x89\x1e\x26\xb4\xa8\xaf\xde\xaa\x2e\xf4\x95\x9e\x7a\xf6\x51\xa3\x2e\xf8\x52\x4b\x8a\x5e\xc4\xc6\x2a\x88\x63\xc7\x39\x40\xc6\x28\x84\xd8\xa3\x5a\x75\xc7\x2e\x64\xfb\x53\x17\xb2\xc8\x98\x78\xec\x18\xa8\x8c\xe9\x28\xa4\xef\xc2\x46\x0d\x07\x4b\xd8\x8a\x0d\x66\x5b\xd1\x7f\x53\xda\xdf\x3f\x0d\xe7\x39\xcb\x68\xfc\xe1\xff\x79\xc7\x96\x97\xf0\x5b\x1a\xf0\xd4\xb8\x0b\x67\x59\x03\xa3\x34\x77\xe2\x43\x9a\x34\x12\x16\xcb\x13\xb5\x34\x99\xbe\x9f\x3d\x4b\x93\xe8\xf4\x5b\x23\xef\x61\x09\x0d\x0d\x07\x3f\x58\x0d\x8d\x8c\x48\xad\xdd\xef\x7b\xcf\xdd\x4e\xe7\x0e\x04\xb8\x4e\xd5\x71\x0c\xb9\x56\x58\x74\x3c\x76\xc3\x08\x0a\xe3\x4e\x58\x2b\xf6\xb1\xd8\x51\x3f\xe3\xb3\x93\xc6\xcb\x23\xe7\x78\x40\xc1\x22\x26\x1d\xce\x8a\x68\xe1\x3d\xea\x95\x6f\xb3\x3c\xa7\x38\xcb\x5b\xed\xd1\x6a\xd1\x05\xfd\xac\xca\xcb\x3b\xf4\xd9\xec\x2f\x2c\x3b\x8b\x3a\xf9\x31\x83\xea\x98\x00\x65\x78\x17\x56\x15\xd4\x34\x28\x88\xa4\x97\xbb\x27\x36\xab\xc5\x06\x2d\xb5\xcb\x19\x29\x5c\x09\xb7\x9e\xcc\x59\xa4\x55\xe7\x68\xf4\xf8\x96\x04\xad\xe8\xb9\xd1\x26\xda\x6f\x8f\xa9\x9f\x78\xce\x48\xb0\x00\xf1\xa2\x00\xf1\x6b\x01\xe2\x37\x18\x94\xf6\xbd\xb7\x39\x64\x76\xfc\x2e\x00\xad\x85\x37\xd6\x6a\x53\x80\xd9\x30\x3d\xc6\xc1\xc0\x8e\x96\x4b\xa6\xda\x67\xfa\xe6\x56\x20\xe4\x7e\x09\x9c\x7a\x88\x73\x7d\x01\x2f\x8a\x38\xfb\xe8\xfb\x90\x26\x49\x23\x1d\x09\x5e\x82\x28\x5e\xe4\xe1\x9a\xb0\xbc\xd5\x12\x7f\x64\x37\xc5\x09\xa1\x7e\x09\x39\x3c\x90\xd3\x05\xf4\x87\xfc\x42\xfc\xc5\xff\x10\x8f\x61\x2c\xc0\xdb\x01\x83\x6e\x2c\xce\x4d\x41\xff\xd1\x31\xf5\x24\x37\xb1\xf0\x2d\xc5\x48\x0b\x03\xe5\x27\xe1\xdb\x33\xc4\xf2\x97\x41\xe2\xa4\xc9\x55\x43\xdd\xa5\xf4\x2a\x1b\x39\x46\x12\x79\x4e\x32\xb3\x63\x83\xfe\xfb\xc7\xcd\xcd\x8c\xe5\xcf\x7d\x37\xa2\x73\x18\x86\xc2\x78\x11\x8b\x90\x5d\xe6\x73\xc8\xf3\xb3\x80\xd3\xa4\x09\x78\x86\xe6\x51\x9a\x91\xbc\xe7\x44\x1a\x58\x4e\x31\x5e\x73\x79\x48\x13\xb7\x53\xbe\x6e\x43\x49\x1f\x68\xff\xf0\xda\x99\x38\xe8\xc5\x06\x41\x3c\x9e\x46\x20\x1c\x34\xca\x3a\x0f\xc2\xae\x86\x2d\x6a\x9f\x26\xb5\x70\x08\x77\xff\xba\xfb\xcf\xd7\x3f\x6e\x3f\xbf\x59\xa4\x49\x68\xa6\x11\x91\x2b\x15\xff\x35\x8f\x63\xb3\x29\x79\x24\x9d\x44\xfb\x18\x88\x0e\x75\x56\x9d\xa5\x9e\x1c\x46\xd2\x05\xed\x34\x19\x73\xe1\x4d\x45\xc1\x3c\x69\xc2\xc4\x5e\x5c\x31\xb8\x55\x7a\x70\x1f\x35\xe6\x05\xbc\xf9\xfd\xd5\x3f\xde\x1e\xcd\xb2\xe6\xd9\x1c\x5f\x5c\x8c\x71\xb6\x3a\x2b\x88\xc5\xef\x3a\x53\x89\xae\x7c\x47\xfd\xff\x45\x69\xff\xb7\x57\x3c\x03\xf2\xf2\x03\xee\x7e\x92\x73\x3e\x79\x19\xdf\x3f\xb4\x50\xfe\x5f\x94\x9f\x6e\x3f\xbd\x39\x46\x29\xb1\x11\x43\xe7\x63\x68\x61\x75\xe6\xd7\x39\x74\x09\xdd\xb1\x52\xaf\xdf\x7f\xbc\xe3\x4a\x49\xec\xd0\x63\x16\x59\x54\x40\xa4\xd1\xb8\xd5\x26\x94\x5f\x42\x7f\xb6\xdd\xb8\x78\x61\x81\xf0\xef\xa4\x2f\xcf\xf6\x28\x9d\x1d\x00\x3b\x37\xdd\xc7\x9c\x69\xa9\x86\x78\x0f\xd7\x9b\xf1\x18\xe5\xdb\xd7\x1f\x3e\xbf\x5f\xfc\xb4\x63\xaf\x10\x3b\x8c\x5b\x72\x14\x37\x14\x7d\x3a\x68\xac\xd9\x5e\xec\xd8\x12\xbe\xe8\x4e\x6d\x90\x89\x6f\x78\x90\x32\xfd\x8b\xf1\xb9\x45\xa6\x82\x66\xd8\xad\x55\x67\xea\x8d\x83\x41\x7b\xd5\x91\x92\xe5\x75\x4b\x4f\x9d\x02\x9c\x01\xe5\xa1\x16\xfa\xaf\x1e\x56\x06\x7c\x6b\xcd\xb0\x6a\x21\x0e\xde\x38\x8c\xa7\xb8\xa6\x2d\x59\xc0\xf4\xf2\xc9\x94\xf6\x3c\x7e\x8d\x0d\xef\x90\x53\x92\x73\x39\xae\xb6\x75\x7c\x83\x3d\x2e\xc9\x24\xc0\x3c\x67\x11\x7a\x00\x12\x2d\xf8\x45\x95\x4f\xcf\x99\xeb\x8f\x9c\xc7\x3e\x92\xc3\x51\x94\x5f\x7f\x67\x8f\x9d\x70\x14\x56\xe8\xd9\x5b\x79\x64\xe7\xdf\x9f\x07\x21\x0e\x44\x53\x6a\xb5\xe9\xf7\x59\x55\x40\x88\x66\x7a\xf6\xf1\xff\xbf\xe9\xc5\xfd\xb1\xc6\x3a\x44\x70\x88\xaf\x8d\x3f\x50\xc8\xac\xa1\x7f\x76\xf8\x02\xfa\x09\x3f\x0d\x23\x82\x27\x28\x12\x2c\xbc\x7a\xbe\x37\x2e\x60\x2f\xf3\x97\x74\xf4\xe4\x02\xcc\xc6\x95\xd1\x74\x01\x7d\x7e\x04\xf5\x74\xce\x36\xf2\xfe\x25\x34\x57\x74\x4f\x8b\x7b\xd4\x3e\xb9\x1b\x8d\x1e\xd2\xff\x0e\x00\x37\xbd\xc4\x03\xd2\x0e\x00\x00"),
 		},
 		"/src/syscall/syscall.go": &vfsgen۰CompressedFileInfo{
 			name:             "syscall.go",
@@ -852,15 +893,17 @@ var FS = func() http.FileSystem {
 		},
 		"/src/syscall/syscall_darwin.go": &vfsgen۰CompressedFileInfo{
 			name:             "syscall_darwin.go",
-			modTime:          time.Date(2021, 1, 31, 22, 29, 53, 252601340, time.UTC),
-			uncompressedSize: 9409,
+			modTime:          time.Date(2026, 10, 18, 21, 5, 2, 637853077, time.UTC),
+			uncompressedSize: 9704,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc4\x9a\x4f\x6f\xdb\xb8\x16\xc5\xd7\xf6\xa7\x10\xb2\xb2\xf1\x8c\x1a\xcd\xcb\xcb\x03\xba\x53\x65\xda\xd1\xb3\xf5\xe7\x89\x52\x9a\xcc\xa6\x60\x64\xca\x56\x2c\x51\x1a\x92\x4a\x9a\x19\xf4\xbb\x0f\x94\x36\xe8\x22\xed\x3d\xc4\xa0\xe8\x6c\x02\xc3\x8e\x7e\x3a\xbc\xf7\xf2\xf2\xe8\x42\xcb\xa5\xf7\xaf\xbb\xa1\x6e\xf6\xde\xbd\x99\x4e\x7b\x51\x9e\xc4\x41\x7a\xe6\xc9\x94\xa2\x69\xa6\xd3\xba\xed\x3b\x6d\xbd\xd9\x74\x72\x76\xa8\xed\x71\xb8\x7b\x53\x76\xed\xf2\xd0\xf5\x47\xa9\xef\xcd\xb7\x0f\xf7\xe6\x6c\x3a\x9f\x4e\xab\x41\x95\xde\xf8\x27\x0d\x66\xd5\xf3\x87\xd9\x7c\xee\x0d\xb5\xb2\xbd\xd5\xde\x9f\xd3\x89\x79\xac\x6d\x79\xf4\xee\xcd\x9b\x50\x59\xa9\x95\x68\x92\xbb\x7b\x59\xda\x59\x35\x1f\x7f\x2e\x85\x91\xdf\xf9\xb1\xa9\xef\xca\x8f\x07\x69\x0f\xba\x1b\x7a\xf3\xd1\x6a\xd1\xf6\x5d\x53\x2b\x39\x7f\x37\x9d\x4c\xb4\xb4\x83\x56\x1e\xbf\xe5\x1f\x37\x2c\xdf\x64\x49\x91\x72\x1a\x65\x1c\x50\xdc\x0d\xf5\x28\x6a\x7b\x41\x60\x3e\xf8\x61\x7e\x41\x23\x44\x59\xca\xde\x12\x0c\x3f\x08\x58\x9a\xd3\x90\xbb\x5a\xed\x09\xc4\xfb\x30\x5e\xd1\x80\xb2\x53\x4a\x96\x94\x8c\x20\x89\x63\x16\x00\x1d\xa6\x2b\x4f\x92\xa2\xf0\x24\xd8\xb2\x1c\xa6\x7a\xe4\x74\x64\x54\x36\x2c\x1f\x59\x09\x8a\x8c\x71\x81\x71\x47\xd8\x41\xda\x5e\x8e\xdf\xb6\x92\x96\x96\x32\x96\xc5\x7e\xc4\x9c\x16\x8a\x71\xa3\x38\x8c\x33\xc7\xc1\xee\xbb\x47\x45\x2d\xf4\xaa\xc8\x57\xc9\x87\xd8\x25\x8b\xbd\xa8\x35\xcc\x64\xea\x87\x19\x0d\xd3\xb2\x7c\xa8\x74\xd7\x12\xa8\x8c\x05\xd7\xeb\x2c\x89\x50\x26\xd5\xde\x76\x64\x16\xe3\x55\x9e\x60\x35\xad\x39\x00\x31\x11\xdf\x60\x2d\x34\x66\x14\x03\x31\x27\xf9\x20\x15\x55\x98\x5b\x76\xcd\x62\x50\x94\x83\xad\x5b\x49\xf5\xb2\x22\x0f\x23\x06\x1a\x59\x05\x29\x6b\x27\x4c\xa9\x6c\x43\x41\x82\x38\xdf\xd1\x88\xbe\xee\xa9\xdd\x90\x86\x29\xd8\x06\xa7\xba\xa1\x24\x6c\xc3\xdd\x0e\x77\x64\x63\x40\x47\xe6\x20\x12\x62\x7f\x3f\x46\x94\xa2\xac\xfe\x37\x46\x14\xf4\xe5\xe3\x9e\xdc\x85\xc1\xd5\x0a\x6d\xc0\xf2\x58\x35\xe2\x60\x48\xc8\x7a\xe7\x6f\x38\xc2\xb4\xdd\x9e\x84\x44\x09\x3a\x64\x8e\x74\x77\x0a\xae\x60\x6b\x2a\x8f\xba\xeb\xc8\x63\xea\x2a\x4b\x12\xb0\x63\xca\xa6\x33\x54\x66\x82\x5d\xc2\x41\x5e\xf6\x43\x4f\x00\x56\x45\x0a\x2f\x3f\xa7\xaf\x3f\xa7\x01\xf2\x53\x79\x14\xea\x20\xf7\xc2\x0a\x02\xc4\x6e\x82\x2b\x3f\xde\xb0\x95\x9f\xfb\x68\xe7\x82\x4a\x5b\x3b\x94\x5a\x85\x6b\x6d\xed\x54\x6c\x15\xaa\xb6\xb5\x43\xb9\x55\xa8\xde\xd6\x0e\x05\x57\x35\x5d\x79\xa2\x18\xbb\x24\xd8\x02\x44\x2f\xec\xb1\xec\x54\x45\x61\x52\x3f\xbf\x0a\x92\x78\x0d\x50\xe6\x49\x95\x14\x86\xdf\xc6\x01\x40\x58\x3d\xa8\x52\x58\x6a\x03\xac\xf3\xac\x88\x03\x3f\xc7\xfe\x65\x6f\xc5\x5d\x23\x4d\xfd\x07\x70\x30\xab\xdc\x7f\xbf\x63\x3c\xfc\x0d\x23\xe5\xa1\xde\xd3\x30\xb6\x09\x57\x18\x33\x40\x4c\xe1\x80\x81\x62\x5c\xb4\xf4\x90\x92\xba\x61\x74\x8f\x30\x59\x8a\x31\x50\x8c\x8b\x16\x4c\x71\xc2\xe8\xba\xd3\xb5\x7d\x02\xa8\x2c\x4c\xb2\x30\xbf\x85\x38\xdd\xd4\x6d\x0d\x1e\x1b\xb2\x5d\x18\x85\xd8\xe8\xeb\xc1\x88\x03\xa8\xea\xac\xe0\xfe\xc6\xc1\xe4\xa3\x50\x71\x87\x48\xc1\x72\x86\xd5\x5c\x1b\x23\xed\x40\x97\x62\xc8\x39\xcb\x0b\x58\x8c\xa7\xdf\x07\x39\x50\xc1\xd9\xfe\xbf\x60\x05\x88\x4c\x83\xfa\xf3\xce\xa1\x3f\x37\xb5\xa2\xda\xf3\x2e\x8c\xb7\x08\x60\xac\x24\x55\x84\x3c\x67\x40\x45\x7b\xa2\x0f\xcf\x68\x0b\xcf\xce\xf6\x54\xd5\x55\x47\x32\xd6\xe1\x3a\x41\x10\x45\x1e\x9b\xd1\x36\x46\xa7\x66\x0b\x0e\xbc\x08\x1f\x78\xcf\x08\x41\xba\xf0\x67\x8a\x8f\x9c\x78\xdb\xeb\xce\xd2\x63\x89\x28\xcd\x92\x1c\xce\x25\xda\x41\xa1\x65\x15\xb1\xc3\xc2\xbe\x60\xc0\xd2\xbe\x90\xe0\xe2\xba\x9e\x2c\xbb\x24\x45\x45\xe7\x60\x2b\xdc\x5c\x45\xaf\xa5\xa0\xaa\x26\xcd\x98\x0f\xaa\xa6\x7f\xd4\x35\x69\x29\xd2\x0f\x59\x88\xfc\x04\x90\x81\x55\x8c\x00\xd0\x10\x46\x08\x6e\x0a\x5a\x82\xa1\x4c\xc6\xf0\x3c\x46\xcb\x87\xee\x44\x43\xae\x93\x2d\x82\xb4\x74\x67\xc9\x22\xd8\x59\x1a\x23\x25\xd9\x23\x39\x63\x5b\x34\xef\x68\xe8\x6d\xc8\xd9\x0e\x0f\x07\xa1\xb7\xe3\x2e\xde\xce\x40\x6f\xc7\x5d\xbc\x9d\x41\xde\x8e\x3b\x78\x3b\x23\x6d\xd3\x1d\x6a\x45\x63\x76\xc9\x26\x8c\x21\xa8\x87\x72\x52\x17\x3d\x0e\x8e\x8a\xbb\x3a\xaa\x2f\xb8\x07\xf9\x49\x96\x10\x77\xcd\x6e\x58\x00\x71\x1a\x57\x40\xe6\x54\x02\x1a\xd7\x40\xe6\x54\x04\xd0\x32\x72\x37\xcb\x68\x90\xcf\xe3\x0e\x3e\xcf\x48\x6b\xeb\x56\x76\xd5\x5e\x80\x04\xe6\x61\xc4\x92\xf5\xca\xc7\x19\x84\x71\xc2\x51\x7a\x6a\x41\x57\xe5\xb7\x11\x6e\xaa\xe0\xd9\x15\x3f\xba\x3a\x3c\xb9\xba\x3d\xb8\x0e\xad\x30\xd4\x72\x8a\xc8\xe7\x60\x31\x83\xda\xcb\x46\x92\x5a\x8a\x78\xc5\x76\x0c\x6a\x51\x20\xb6\x45\x8c\x43\x3b\xa8\xb6\x1b\xc8\x49\x72\x11\x47\x49\x81\x46\xc9\xe8\x10\x77\x38\xc3\x9f\x11\x0f\x88\x71\x0d\x7c\x56\x2b\xa8\x07\xdd\x28\xf2\x53\x68\xd4\x00\xa2\x88\x21\xa4\xea\x34\x39\xf9\x49\x32\x90\x95\xba\x2b\xc9\x69\x78\x98\x04\x68\x1a\x3e\xb6\xde\x07\x49\x4e\xf8\x58\x70\xcd\x10\x84\x6c\x70\xec\x06\xf5\xb6\xca\x58\x61\x2f\x2f\xc8\xc1\x53\xee\xe7\x97\x17\x0e\x98\xca\x60\xd0\x9a\x23\xd4\xc1\xad\x51\x6e\x9c\x1b\x65\x03\x97\xb8\x73\x59\x22\xa4\xb8\x42\x40\x98\xdc\xa2\xd4\x5b\x2d\x4a\xd2\x95\xe7\x99\x1f\x30\x87\xac\xb9\xa4\xdf\x29\x6b\xc2\x8e\x27\xae\x01\x33\x1a\x3f\x1f\xcf\x5c\x9e\xbb\xb4\x4e\xf1\x23\xd6\xc5\x7f\xcf\xbd\xe5\xf2\x5b\x0b\xf5\xf3\xe9\x64\xb9\xf4\xc8\xb5\xee\xc7\xa7\xb2\xd7\x8e\x7b\xb9\xf4\x26\x77\x5a\x8a\xd3\x74\xb2\x97\x95\x18\x1a\x3b\xde\xa7\xd7\xb5\xb2\x8d\x9a\x9d\x3d\x0a\xad\x6a\x75\x78\x79\x71\xe2\xdd\xd9\xc2\xab\xe6\xd3\xc9\xe7\xe9\x8b\x94\xaf\xef\x40\xcc\xda\x5a\x0d\x26\x51\x72\x3e\xfd\xfc\xf5\x75\x89\xaf\x97\xcc\xac\x16\xfd\xc2\x13\x6f\x17\x9e\x38\x5f\x78\xe2\xdf\x2f\x97\xcc\xbd\x99\x7e\xbb\xf0\xf4\xf9\xcb\x17\x0b\x4f\x6a\xed\x31\xad\x55\xf7\xfc\xd2\xc4\x4b\xe0\x7e\xc0\x79\x75\xa7\x9b\x5f\x77\xab\xd4\xea\x5f\x77\xb3\xcb\x57\xff\xb2\xf0\xc4\xc5\xc2\x13\xff\x59\x78\xe2\xf2\x6f\xde\x96\x86\xbe\xd6\x70\xf3\x4f\x8a\xd0\xe2\x91\xff\xa4\x6a\xca\x7e\x8c\xfa\xde\xfd\x7e\x6e\xec\x33\x47\xee\xa8\xe4\xaf\x00\x00\x00\xff\xff\xfd\x08\xf2\x97\xc1\x24\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc4\x9a\x5b\x6f\xdb\xb8\x12\xc7\x9f\xad\x4f\xc1\x93\x97\x63\x9f\x63\xc4\x68\x4e\x4e\x16\x28\xd0\x07\x55\xa6\x1d\xad\xad\xcb\xea\x92\x26\xfb\x52\x30\x12\x65\x33\x96\x48\x2d\x49\x25\xcd\x2e\xfa\xdd\x17\x4a\x9a\x66\x8b\xb4\x33\xc4\xa2\xe8\xbe\x04\x81\x2f\x3f\xfe\x39\x33\x9c\x19\x8e\xb5\x58\x90\xff\x5e\x0f\xa2\xad\xc9\x8d\xf1\xbc\x9e\x55\x07\xb6\xe3\xc4\xdc\x9b\x8a\xb5\xad\xe7\x89\xae\x57\xda\x92\xa9\x37\x39\xda\x09\xbb\x1f\xae\x8f\x2b\xd5\x2d\x76\xaa\xdf\x73\x7d\x63\x9e\xff\xb9\x31\x47\xde\xcc\xf3\x9a\x41\x56\x64\xfc\x93\x06\xd3\xe6\xe1\x9f\xe9\x6c\x46\x06\x21\x6d\x6f\x35\xf9\xc3\x9b\x98\x3b\x61\xab\x3d\xb9\x31\xc7\xa1\xb4\x5c\x4b\xd6\x26\xd7\x37\xbc\xb2\xd3\x66\x36\xbe\x5d\x31\xc3\xbf\xf2\x66\x2b\xae\xab\xf7\x3b\x6e\x77\x5a\x0d\xbd\x79\x6f\x35\xeb\x7a\xd5\x0a\xc9\x67\xaf\xbd\xc9\x44\x73\x3b\x68\x49\xf2\xab\xfc\xfd\x9a\x16\xeb\x2c\x29\xd3\x1c\x46\x19\x07\x54\xee\x86\xba\x63\xc2\x9e\x02\x98\x77\x7e\x58\x9c\xc2\x08\x56\x55\xbc\xb7\x00\xc3\x0f\x02\x9a\x16\x30\xe4\x5a\xc8\x1a\x40\xbc\x0d\xe3\x25\x0c\xa8\x94\x94\xbc\x82\x64\x04\x49\x1c\xd3\x00\xd1\x61\x54\x75\xe0\x10\x25\x4f\x82\x0d\x2d\x50\x57\x8f\x1c\x05\x5a\x65\x4d\x8b\x91\x95\x60\x96\x31\x2e\xb0\xdc\x11\xb6\xe3\xb6\xe7\xe3\xab\x1d\x87\xa5\xa5\x94\x66\xb1\x1f\x51\xa7\x8d\xe2\xb8\x51\x1c\x8e\x33\xfb\xc1\xd6\xea\x4e\x42\x1b\x3d\x2f\x8b\x65\xf2\x2e\x76\xf1\x62\xcf\x84\x46\x3d\x99\xfa\x61\x06\xc3\x34\xaf\x6e\x1b\xad\x3a\x00\x95\xd1\xe0\x62\x95\x25\x11\xe6\x49\x59\x5b\x05\x7a\x31\x5e\x16\x09\xae\xa6\x33\x3b\x44\x4c\x94\xaf\x71\x2d\x30\x66\x14\x83\x62\x0e\xfc\x96\x4b\x28\x30\x37\xf4\x82\xc6\x48\x50\x0e\x56\x74\x1c\xca\x65\x65\x11\x46\x14\x49\x64\x0d\x4a\x59\x39\x61\x2a\x69\x5b\x08\x12\xc4\xc5\x16\x46\xf4\xa2\x87\x4e\x43\x1a\xa6\xc8\x31\x38\x88\x16\x92\xb0\x09\xb7\x5b\x3c\x23\x1b\x83\x64\xe4\x1c\xb1\x04\xab\x6f\x46\x8b\x42\x94\xe5\xcf\xa3\x45\x61\x4c\xb5\xaf\xc1\x53\x18\x9c\x2f\xb1\x03\x58\xed\x9b\x96\xed\x0c\x08\x59\x6d\xfd\x75\x8e\x61\x3a\x55\x83\x90\x28\xc1\x8a\xcc\x1e\xce\x4e\xc1\x39\x9a\x9a\xaa\xbd\x56\x0a\x2c\x53\xe7\x59\x92\x20\x27\xa6\x6a\x95\x81\x3c\x13\x6c\x93\x1c\xf1\x4b\x3d\xf4\x00\x60\x59\xa6\xe8\xd7\x4f\xe0\xef\x9f\xc0\x00\xfe\xa1\xda\x33\xb9\xe3\x35\xb3\x0c\x00\xd1\xcb\xe0\xdc\x8f\xd7\x74\xe9\x17\x3e\x76\x72\x91\x48\x5b\x39\x84\x5a\x83\xc7\xda\xca\x29\xd8\x1a\x2c\xda\x56\x0e\xe1\xd6\x60\xf1\xb6\x72\x08\xb8\xa6\x55\xd5\x01\x62\x6c\x93\x60\x83\x20\x7a\x66\xf7\x95\x92\x0d\x84\x49\xfd\xe2\x3c\x48\xe2\x15\x82\x32\xf7\xb2\x82\x30\xf9\x55\x1c\x20\x08\xab\x07\x59\x31\x0b\x1d\x80\x55\x91\x95\x71\xe0\x17\x78\xff\x52\x5b\x76\xdd\x72\x23\x7e\x47\x3a\x98\x65\xe1\xbf\xdd\xd2\x3c\xfc\x15\x47\xf2\x9d\xa8\x61\x18\x5d\x87\x4b\x1c\x33\xa0\x98\xd2\x01\x83\x8a\x71\xd1\xd2\xa3\x94\xd4\x0d\xa3\x7b\x0c\x93\xa5\x38\x06\x15\xe3\xa2\x05\xa7\x38\x61\xb4\x50\x5a\xd8\x7b\x04\x95\x85\x49\x16\x16\x57\x28\x4e\xb7\xa2\x13\xc8\xb5\x21\xdb\x86\x51\x88\x37\xfa\x7a\x30\x6c\x87\x44\x75\x56\xe6\xfe\xda\xa1\xc9\xc7\x4c\x95\x3b\x58\x0a\x0d\x67\x34\x9a\x85\x31\xdc\x0e\x70\x28\x86\x79\x4e\x8b\x12\x0d\xc6\xc3\x6f\x03\x1f\x20\xe3\x6c\x7e\x29\x69\x89\x58\xa6\xc5\xf2\xf3\xd6\x21\x3f\xb7\x42\x42\xe9\x79\x1b\xc6\x1b\x0c\x60\x2c\x07\x55\x84\x79\x41\x11\x15\xdd\x01\x2e\x9e\xd1\x06\xad\x9d\xdd\xa1\x11\x8d\x02\x19\xab\x70\x95\x60\x10\x09\x96\xcd\x68\x13\x63\x55\xb3\x43\x0a\x5e\x84\x17\xbc\x07\x04\x6b\x5b\x8c\xe2\x63\x9d\x78\xd7\x6b\x65\xe1\xb1\x44\x94\x66\x49\x81\xce\x25\xba\x41\x62\xdb\x2a\x63\x87\x8d\x0d\xd2\x61\x6b\x65\xec\xb4\x39\xd5\x83\x61\x97\xa4\x58\xd0\x39\xb4\x15\x6e\x5d\x45\xaf\x39\x83\xa2\x26\xcd\xa8\x8f\x44\x4d\x7f\xa7\x05\xd8\x52\xa4\xef\xb2\x10\xeb\x27\x10\x19\xb8\x8a\x11\x80\x24\x84\x11\x82\x27\x05\xcd\x91\xa1\x4c\x46\xf1\x79\x8c\xe6\xb7\xea\x00\x43\x2e\x92\x0d\x06\xe9\xe0\xcc\x92\x45\x68\x66\x69\x0d\xe7\x60\x8e\xcc\x29\xdd\x60\xf3\x8e\x16\x3e\x86\x39\xdd\xe2\xc3\x41\xb4\xb7\xcb\x5d\x7a\x3b\x83\xf6\x76\xb9\x4b\x6f\x67\xb0\xde\x2e\x77\xe8\xed\x0c\xb7\xad\xda\x09\x09\x63\xb6\xc9\x3a\x8c\x51\x50\x8f\xca\x49\x5d\xf4\x38\x74\x54\xb9\x6b\x47\xf5\x88\xbb\xe5\x1f\x78\x85\xe2\x2e\xe8\x25\x0d\x50\x9c\xc6\x23\x20\x73\x0a\x01\x8d\xc7\x40\xe6\x14\x04\x68\xcb\x98\xbb\xb5\x8c\x06\xeb\xf3\x72\x87\x3e\xcf\x70\x3b\x4e\x8d\x54\x53\x33\xc4\x81\xe3\xe8\x28\x59\x2d\x7d\xdc\x83\xa8\x9d\x70\x2b\xdd\x77\x48\x56\xcd\xaf\x22\x3c\xa9\x22\x77\x57\xfc\xea\xea\x70\x73\x75\xbb\xb8\x0e\x1d\x33\xd0\x76\xca\xc8\xcf\x91\xcd\x0c\xb2\xe6\x2d\x07\xb5\x94\xf1\x92\x6e\x29\xaa\x45\x22\xb6\x2d\x63\xdc\xb4\x83\xec\xd4\x00\x4e\x92\xcb\x38\x4a\x4a\x6c\x94\x8c\x15\x71\x87\x1a\xfe\x80\xb8\xc5\x18\x17\x48\x9f\xd5\x31\xe8\xa2\x1b\x45\x7e\x8a\x36\x6a\x08\xa2\x8c\x51\x48\xa3\x34\x38\xf9\x49\x32\xc4\x2b\x42\x55\xe0\x34\x3c\x4c\x02\x6c\x1a\x3e\xa6\xde\x5b\x0e\x4e\xf8\x68\x70\x41\x31\x08\x98\xe0\xe8\x25\x96\xdb\x1a\x63\x99\x3d\x3b\x05\x07\x4f\x85\x5f\x9c\x9d\x3a\x60\x1a\x83\x83\x56\x39\x86\xda\xb9\x25\xca\xb5\x73\xa2\x6c\xd1\x2d\x6e\x5d\xb6\x88\x52\x5c\x21\x8d\x41\x31\xb8\x95\x7a\xab\x59\x05\x76\xe5\x45\xe6\x07\xd4\xc1\x6b\x2e\xee\x77\xf2\x1a\xb3\x63\xc5\x35\xc8\x8c\xc6\x2f\xc6\x9a\x9b\x17\x2e\xa9\x93\x7d\x8b\x75\xfa\xd3\x09\x59\x2c\x9e\x53\xa8\x5f\x78\x93\xc5\x82\x80\x7b\xad\xc7\x5b\xd9\xcb\x8e\x7b\xb1\x20\x93\x6b\xcd\xd9\xc1\x9b\xd4\xbc\x61\x43\x6b\xc7\x75\x7a\x2d\xa4\x6d\xe5\xf4\xe8\x8e\x69\x29\xe4\xee\xe9\xc1\x89\xd7\x47\x73\xd2\xcc\xbc\xc9\x47\xef\x49\xca\xa7\x67\x20\xa6\x9d\x90\x83\x49\x24\x9f\x79\x1f\x3d\x6f\xb1\x20\xe3\x8f\x5d\x85\x66\x3d\x11\x86\xd8\xfd\xc3\x93\x17\x96\x77\x64\x84\x10\xbb\x67\x96\x28\x73\x9c\x8a\x9e\x93\x8e\x1d\xb8\x39\xf6\x2a\x25\x8d\x7d\xfe\xd6\x9b\xe7\x9f\xc4\x46\xda\xa8\x9d\xd7\x29\xb3\x7b\xf2\xb8\xee\x23\x74\xbc\x27\x3e\xd2\xd8\x17\x0b\x8c\x1f\x37\x73\xa2\x34\x91\xa2\x25\xa2\x21\xc2\x92\x5a\x71\x23\xff\x6d\x9f\x68\x84\x91\x46\xb4\xfc\xf8\xf1\xd1\x8e\x67\xfe\xd4\x6a\xd6\xcf\x09\x7b\x35\x27\xec\xe4\x69\x77\x33\xf2\x9f\x1b\x73\xfc\x68\xce\xf1\x71\x0e\xd1\x90\xf1\x63\xe4\x5f\x6f\x3e\x5f\x68\xc7\x97\x9f\x6c\x22\x45\xfb\x57\x13\xbd\xf4\x08\x7b\xf5\x60\xa6\x87\xa5\x3f\x59\xf6\x8b\x75\xe7\x84\xfd\xef\x79\xed\xa9\x7e\x35\x27\xfa\xb3\x98\x39\xe1\x5a\x13\xaa\xb5\x54\x0f\xcf\x96\x3c\xc5\xd7\x37\x38\x2f\x56\xba\xfc\x71\x4b\xa5\x56\xff\xb8\xc5\xce\x5e\x7c\x64\x4e\xd8\xe9\x9c\xb0\xff\xcf\x09\x3b\xfb\x9b\xcb\xc2\xd0\x97\x1a\x2e\xff\x49\x11\x9a\xdd\xe5\xdf\x29\x9a\xb2\x6f\xa3\xbe\xb6\xde\xf7\xb5\x7d\xe6\xc8\x1d\x95\xfc\x39\x00\xe8\x38\xb1\xeb\xe8\x25\x00\x00"),
 		},
-		"/src/syscall/syscall_linux.go": &vfsgen۰FileInfo{
-			name:    "syscall_linux.go",
-			modTime: time.Date(2020, 10, 13, 23, 35, 11, 0, time.UTC),
-			content: []byte("\x2f\x2f\x20\x2b\x62\x75\x69\x6c\x64\x20\x6a\x73\x0a\x0a\x70\x61\x63\x6b\x61\x67\x65\x20\x73\x79\x73\x63\x61\x6c\x6c\x0a\x0a\x63\x6f\x6e\x73\x74\x20\x65\x78\x69\x74\x54\x72\x61\x70\x20\x3d\x20\x53\x59\x53\x5f\x45\x58\x49\x54\x5f\x47\x52\x4f\x55\x50\x0a"),
+		"/src/syscall/syscall_linux.go": &vfsgen۰CompressedFileInfo{
+			name:             "syscall_linux.go",
+			modTime:          time.Date(2026, 10, 18, 21, 5, 2, 637853077, time.UTC),
+			uncompressedSize: 408,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x54\xce\x3f\x4f\xc3\x30\x10\x05\xf0\x39\xf7\x29\x1e\x5d\x68\xa1\x4a\x44\x77\x06\x86\x0a\x75\xa1\x11\x2d\x12\x4c\xd5\x35\xbd\x34\x4e\x13\xdb\xb2\x2f\x12\x08\xf5\xbb\xa3\x24\x94\x3f\x9b\xed\x3b\xff\xde\xcb\x32\xdc\xee\x3b\xd3\x1c\x50\x47\x22\xcf\xc5\x89\x8f\x82\xf8\x11\x0b\x6e\x1a\x22\xd3\x7a\x17\x14\x53\x4a\x26\x47\xa3\x55\xb7\x4f\x0b\xd7\x66\x47\xe7\x2b\x09\x75\xfc\x3d\xd4\x71\x42\x33\xa2\xc2\xd9\xa8\x90\x77\xa3\xdb\xc0\x1e\xf7\xd8\xbc\x6d\x76\xcb\xd7\xd5\x76\xf7\xf8\xbc\x7e\xc9\x89\xb2\x0c\xde\x78\x19\xa6\x26\x42\xab\x21\x4b\xa5\x45\x9f\x07\xad\x58\xe1\x62\x9a\x1b\x2f\x68\xf9\x24\x31\xfd\x36\x7f\x7e\x8d\x66\xbe\xca\x97\x8b\x81\x73\x5e\xac\x1c\x72\xd6\x0a\x41\xb4\x0b\x76\x54\x7d\xff\x30\x70\xfc\x2f\xa1\x5f\x8f\x73\xb8\x00\x6b\x1a\x98\x12\x46\x71\x70\x12\xed\xb5\x5e\x34\x30\x4a\xd3\x48\x4a\x65\x67\x8b\x3f\xfe\x54\x03\xfb\x39\xf8\x6e\x0e\x5e\xa0\x33\x56\xbd\x86\x19\x6e\xea\x98\xae\xf7\xb5\x14\x8a\x4f\x4a\x4c\x89\x7e\x0d\x57\x63\xcf\x75\xbe\x7c\x7a\xd8\xf6\x83\x64\x6c\xd7\xc7\x52\x72\xa6\xcb\xb5\x8e\xe9\xca\xaa\x04\xcb\xcd\x88\x4c\x79\x31\xa3\x33\x7d\x0d\x00\xbc\x1f\x49\x78\x98\x01\x00\x00"),
 		},
 		"/src/syscall/syscall_nonlinux.go": &vfsgen۰FileInfo{
 			name:    "syscall_nonlinux.go",
@@ -869,10 +912,10 @@ var FS = func() http.FileSystem {
 		},
 		"/src/syscall/syscall_unix.go": &vfsgen۰CompressedFileInfo{
 			name:             "syscall_unix.go",
-			modTime:          time.Date(2020, 10, 20, 2, 0, 20, 0, time.UTC),
-			uncompressedSize: 3370,
+			modTime:          time.Date(2026, 10, 18, 21, 5, 2, 637853077, time.UTC),
+			uncompressedSize: 3509,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xe4\x57\x4d\x6f\x1b\x37\x10\x3d\xef\xfe\x8a\x09\x51\x04\xdc\x88\x5d\x7d\xb4\x0d\x8a\xba\x3a\x38\xae\x6a\x08\x70\xed\x20\xb2\x9b\x16\x41\x60\x50\xda\x59\x99\xd2\x8a\x54\x49\xae\x1c\x21\xd1\x7f\x2f\xf8\xb1\x96\x2c\xe9\x50\x23\x41\x50\x20\x37\x61\xe7\xcd\xcc\xe3\x9b\x21\x67\xd4\x6e\x43\x6b\x5c\x8b\xaa\x80\x99\x61\xcf\xee\x85\x2c\xd4\xbd\x49\xd3\x25\x9f\xcc\xf9\x14\xc1\xac\xcd\x84\x57\x55\x9a\x8a\xc5\x52\x69\x0b\x34\x4d\x88\xae\xa5\x15\x0b\x24\x69\x42\x6a\x69\x78\x89\x24\x4d\x13\x32\x15\xf6\xae\x1e\xe7\x13\xb5\x68\x4f\xd5\xf2\x0e\xf5\xcc\x6c\x7f\xcc\x0c\x49\xb3\x34\x2d\x6b\x39\x81\xe8\x7e\x8b\x72\x65\x68\x06\xef\xde\x1b\xab\x85\x9c\xc2\xc7\x34\x59\x6a\x35\x41\x63\xe0\x97\x3e\xcc\x4c\x7e\x5e\xa9\x31\xaf\xf2\x73\xb4\x94\x44\x0b\xc9\xd2\x44\x94\xd0\xe0\xfa\x1e\x77\x23\x0b\x2c\x85\xc4\xc2\x85\x48\x34\xda\x5a\x4b\x90\xa2\x4a\x93\x4d\x9a\xcc\xcc\x40\xae\x5c\xc0\xe8\x13\xc2\xa1\x5c\xb9\x50\x28\x57\x73\x5c\x1f\xcb\x77\x35\x9e\xe1\xc4\x92\x2c\x3f\xe3\x55\x45\x89\x43\x11\x06\x3e\x58\xf0\xf3\x4e\x0b\x3e\x47\xda\x1c\x80\x41\x0c\x97\x5f\xa0\x9c\xda\x3b\x9a\x65\x69\x52\x2a\x0d\xc2\x41\x3b\x27\x20\xe0\xd7\x03\xc8\x09\x88\x56\xcb\xf3\x9e\xe3\xda\xe1\x1a\xc0\x50\x16\xf8\x81\x8a\x2c\x1f\xf9\xe0\x34\x4b\x13\x9f\xf6\x9d\x78\x0f\x7d\x70\xe0\x16\x90\x3e\x81\x56\x20\xe5\x59\xcf\x71\xbd\x8b\xdf\xa4\x8d\x18\xce\x31\xdd\x44\xfd\x0d\x5a\x94\xab\xdb\x09\x9d\x33\x58\x41\xe0\x9e\x7d\x59\xf5\x7d\xee\x43\xc1\xf3\x91\x23\xc9\x60\x95\x3d\x90\xa9\xe5\x96\xce\xd7\xe5\xf2\x1b\x56\x68\x91\xce\x3d\x97\x15\xd7\x4d\xab\xff\xa1\x8a\xba\x42\x78\x31\x33\x79\x68\x02\x6f\xe4\x95\x46\x5e\xac\xaf\xb5\xc0\xe2\x5a\x5d\x28\x5e\x40\x1f\x4a\x5e\x19\xf4\xe6\x85\x90\xb5\xb9\x92\x08\x7d\xf8\xbe\xdb\xe8\x1c\xe2\xbd\x5a\x5f\xf2\x05\x52\xc9\x17\xf8\x70\xc0\x6d\x70\x47\xb4\xc0\x12\x35\x38\x1f\x9a\x45\xe2\x13\xb5\x42\xed\x6b\xde\x6e\xc3\xb6\xa3\x41\x94\x10\x8d\x58\xa4\xc9\x86\x06\x11\x1e\x33\xef\xf7\x3d\xd4\x05\x12\xe5\x31\xe2\xce\xf2\xe8\x9a\x38\x85\x92\xa3\x27\xb4\xba\x46\x4f\xe8\x9f\x5a\x68\x3c\x52\x8d\x68\x71\xd5\x48\x3c\xb9\x00\x3c\x56\x8e\x64\xc9\xa5\x98\x50\xe2\xb1\x2e\xe3\x1e\xed\xc6\x39\x1f\xca\x95\x9a\x23\x25\xd1\x4e\x1e\xb5\xf2\x23\x27\xcf\xc1\x29\xbb\x6d\xa8\x51\xb0\x53\xab\xf9\x92\x01\xef\x32\xe0\x3d\x06\xfc\x07\xa8\x85\xb4\x4b\xab\x33\xa0\xba\xcb\x40\xf7\x9a\x0f\x0c\x50\x6b\x18\x68\x2d\x95\x57\x5f\x94\x50\xba\x83\x3e\x2e\x1f\x19\x35\x64\x4e\xa0\x84\x67\x5b\x89\xb5\xc3\x96\x0d\xe7\xfd\xac\xd9\xf6\x41\x8a\xe9\xa8\x8e\x57\xbb\x93\xe5\x43\x69\x69\x96\xb1\x03\x53\x77\x6b\xf2\xbc\x1e\x0c\xbd\xc6\xe0\x15\x11\x25\xb8\x7c\x4e\xec\xd1\xdf\xa3\xdb\xb7\x6f\x86\xd7\x03\x78\xfe\x1c\x28\xef\xba\x6f\x5d\xf8\xf4\x09\xc2\xcf\x5e\xe8\x2b\xae\x35\x5f\xc7\x22\x0e\xa5\x45\x2d\x79\x15\xda\x90\xf2\x9e\xa3\x6a\x2a\x31\xc1\x9d\x87\x6d\xbc\xb6\xc8\xc0\xbb\xed\x3e\x6a\xc9\xa1\xbf\xf7\x0c\x17\x9c\x7c\xe7\x1d\x48\x74\x74\xf8\xa5\x16\xd2\x5e\xab\x33\x25\x8d\xaa\x30\x82\x0f\xa5\xd9\x4b\xc4\xa0\xc3\xa0\xb3\x7f\x54\xfc\x20\xec\xb5\xfb\xed\xd5\x0f\xb3\x24\x3f\x57\xee\x73\x7c\xf4\x7c\xb6\xb7\x5c\xcb\xf8\x0e\xee\x65\x69\xee\x6a\x88\x3f\x38\x3d\x3b\x1b\x8c\xf6\xdb\xe7\xe5\x41\x25\x19\xf0\x1f\x19\xf0\x9f\x18\xf0\x97\x5f\xa8\x97\x5e\x3e\xb1\x99\x76\x29\x7c\x95\xc6\x7a\xd6\x87\x5e\xa7\x07\x1f\xa1\xdd\x86\x39\x6a\x99\x2b\xa3\xb1\x42\x6e\x10\x94\x84\xab\x11\xfc\xc5\xe0\x8e\x2f\x97\x28\x0d\x08\x09\x42\x0a\x0b\xaa\x04\xa2\x0c\x81\xb8\x40\x34\xc5\xdf\x29\xc7\xe6\x69\x15\x79\xc3\xef\xbf\xa1\x3b\xfd\x39\xbd\xab\x1f\x94\xba\x54\x03\xad\x95\xfe\xef\x82\xfd\xef\x54\x7a\xaa\x18\x47\xda\xe5\xdb\xbe\xc3\x9f\xd3\x48\xaf\xd6\x16\x5f\x5b\xfd\xbb\x56\x8b\xb8\x4d\x9a\x87\xd5\x85\xbe\x08\x43\x01\x5d\x83\x79\x81\x76\xa7\xca\xee\x6a\x70\x23\xa4\xfd\xf9\xd4\x8f\x82\x2c\xbf\xc4\x7b\x5a\xa1\xa4\x26\x83\x16\x74\x9b\xc5\x98\xc1\xd8\x39\x6a\x2e\xa7\x08\x61\xdc\x38\x44\x5c\x5d\xc6\xee\xb9\xef\xec\xaf\x2b\x0c\x06\xc3\xcb\x3f\x4f\x2f\x9a\xb5\xc5\xcf\x8c\x11\xda\xb8\x30\x33\x18\x07\x01\xf6\x0c\x21\x39\x83\xce\x56\x8b\x70\x94\x8c\x86\x3f\x31\xf9\x6b\x25\xdc\x4c\x8b\x53\xe8\xc6\x7f\xa4\x99\xd3\xd9\x2d\x49\x9b\xf4\xdf\x00\x00\x00\xff\xff\x77\x4c\x60\x3e\x2a\x0d\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x57\x5d\x6f\xdb\x36\x14\x7d\x16\x7f\xc5\x2d\x1f\x0a\xb2\xe6\xe4\x8f\x6d\xc5\xb0\xcc\x0f\x6d\xe6\x06\x01\xb2\xa4\xa8\x93\x75\x43\x10\x04\xb4\x7d\xe5\xd0\x96\x49\x8f\xa4\x9c\x1a\xad\xff\xfb\x40\x52\x8a\x13\xc7\x03\x96\xb5\x18\x36\x6c\x6f\x02\xef\xd7\xe1\xb9\x87\xe4\x55\xbb\x0d\xad\x51\xa5\xca\x09\xcc\x9c\x78\x76\xab\xf4\xc4\xdc\x3a\x42\x96\x72\x3c\x97\x53\x04\xb7\x76\x63\x59\x96\x84\xa8\xc5\xd2\x58\x0f\x8c\x64\xd4\x56\xda\xab\x05\x52\x92\xd1\x4a\x3b\x59\x20\x25\x24\xa3\x53\xe5\x6f\xaa\x51\x3e\x36\x8b\xf6\xd4\x2c\x6f\xd0\xce\xdc\xf6\x63\xe6\x28\xe1\x84\x14\x95\x1e\x43\x1d\x7e\x8d\x7a\xe5\x18\x87\xcb\x2b\xe7\xad\xd2\x53\xf8\x48\xb2\xa5\x35\x63\x74\x0e\xbe\xef\xc3\xcc\xe5\x47\xa5\x19\xc9\x32\x3f\x42\xcf\x68\x6d\xa1\x9c\x64\xaa\x80\xc6\xaf\x1f\xfd\x2e\xf4\x04\x0b\xa5\x71\x12\x52\x64\x16\x7d\x65\x35\x68\x55\x92\x6c\x43\xb2\x99\x1b\xe8\x55\x48\x58\xc7\xa4\x74\xa8\x57\x21\x15\xea\xd5\x1c\xd7\xfb\xea\x9d\x8d\x66\x38\xf6\x94\xe7\x87\xb2\x2c\x19\x0d\x5e\x54\x40\x4c\x96\xe2\x62\xd0\x42\xce\x91\x35\x1b\x10\x50\xa7\xcb\x4f\x50\x4f\xfd\x0d\xe3\x9c\x64\x85\xb1\xa0\x82\x6b\xe7\x00\x14\xfc\xf0\xc8\xe5\x00\x54\xab\x15\x71\xcf\x71\x1d\xfc\x1a\x87\x63\x3d\xc1\x0f\x4c\xf1\x7c\x18\x93\x33\x4e\xb2\x58\xf6\x52\x5d\x41\x1f\x82\x73\x0b\x68\x9f\x42\x2b\x81\x8a\xa8\xe7\xb8\xbe\xef\xbf\x21\x0d\x19\x21\x90\x6c\x6a\xfe\x1d\x7a\xd4\xab\xeb\x31\x9b\x0b\x58\x41\xc2\xce\xbf\x2c\xfb\xb1\xf6\x63\xc2\xf3\x61\x00\x29\x60\xc5\xef\xc0\x54\x7a\x0b\xe7\xef\xc5\xf2\x23\x96\xe8\x91\xcd\x23\x96\x95\xb4\x8d\xd4\x7f\x32\x93\xaa\x44\x78\x31\x73\x79\x12\x41\x34\xca\xd2\xa2\x9c\xac\xcf\xad\xc2\xc9\xb9\x39\x31\x72\x02\x7d\x28\x64\xe9\x30\x9a\x17\x4a\x57\xee\x4c\x23\xf4\xe1\xab\x6e\xc3\x73\xca\xf7\x7a\x7d\x2a\x17\xc8\xb4\x5c\xe0\xdd\x06\xb7\xc9\x03\xd0\x09\x16\x68\x21\xc4\x30\x5e\x03\x1f\x9b\x15\xda\xd8\xf3\x76\x1b\xb6\x8a\x06\x55\x40\x6d\xc4\x09\xc9\x36\x2c\x91\xf0\x10\x79\xbf\x1f\x5d\x43\x22\x55\xec\x03\x1e\x2c\x0f\x8e\x49\x60\x28\xdb\xbb\x43\x6f\x2b\x8c\x80\x7e\xab\x94\xc5\x3d\xdd\xa8\x2d\x94\xa7\x6a\x8d\xe3\xbe\x76\x64\x4b\xa9\xd5\x98\x51\xca\xeb\x8a\x3b\xb0\x9b\xe0\xfc\x58\xaf\xcc\x1c\x19\xad\xed\xf4\x81\x94\x1f\x04\x45\x0c\x81\xd9\xad\xa0\x86\xc9\xce\xbc\x95\x4b\x01\xb2\x2b\x40\xf6\x04\xc8\xaf\xa1\x52\xda\x2f\xbd\xe5\xc0\x6c\x57\x80\xed\x35\x0b\x02\xd0\x5a\x18\x58\xab\x4d\x64\x5f\x15\x50\x84\x8d\x3e\x6c\x1f\x1d\x36\x60\x0e\xa0\x80\x67\x5b\x8a\x6d\xf0\x2d\x1a\xcc\xbb\x55\xf9\xf6\x42\xaa\xcb\x31\x5b\x1f\xed\x0e\xcf\x8f\xb5\x67\x9c\x8b\x47\xa6\xee\xd6\x14\x71\xdd\x19\x7a\x8d\x21\x32\x12\xf8\x8e\xe8\x05\x98\x79\xea\xcd\x1b\x55\xe2\x1f\x31\xc0\x0f\x82\xdb\xbd\x2b\xd2\x0a\xe8\xc4\xf8\x26\x5b\x88\x08\xad\x1b\xfe\x3a\xbc\x7e\xff\xee\xf8\x7c\x00\xcf\x9f\x03\x93\xdd\xb0\xd6\x85\x4f\x9f\x20\x7d\xf6\x92\x4a\x47\xa9\xe4\xeb\xb5\x47\xc7\x66\xe1\xc6\xf2\x68\xb5\x2c\x93\xb0\x99\xec\x05\x98\xd9\xd2\x2a\xed\xcf\xcd\xa1\xd1\xce\x94\xc8\x46\x7b\x18\x29\x51\xb3\x51\xd8\x6c\x47\x40\x67\x17\x0b\x7e\x50\xfe\x3c\x7c\x47\xe0\xe9\xe9\xc8\x8f\x4c\x58\xae\xef\xb8\x58\xe1\xbd\xb4\xba\xbe\xf6\x76\xb2\x37\x47\x33\xe5\x1f\xbc\x3a\x3c\x1c\x0c\x77\xd5\xf2\xf2\x11\x59\x02\xe4\x37\x02\xe4\xb7\x02\xe4\xcb\x2f\x24\x9d\x97\x4f\xd4\xce\x7d\x08\xff\x42\x1d\x3d\xeb\x43\xaf\xd3\x83\x8f\xd0\x6e\xc3\x1c\xad\xce\x8d\xb3\x58\xa2\x74\x08\x46\xc3\xd9\x10\x7e\x11\x70\x23\x97\x4b\xd4\x0e\x94\x06\xa5\x95\x07\x53\x00\x35\x8e\x42\x3d\x7d\x90\xec\x51\x73\x37\x4f\xeb\xef\x3b\x79\xfb\xff\x85\xf0\x17\x1b\xf9\x39\xe7\xca\xde\xf1\x7e\x6a\x06\xd6\x1a\xfb\xe7\xe9\xff\xc7\x71\xfe\x54\x32\xf6\x88\xef\xbf\x7d\xbf\x7c\x8e\x90\xc2\xeb\xf2\xd6\xdb\x37\xd6\x2c\xea\xc1\xd6\xdd\x4d\x51\xec\xc5\x68\xed\x31\x92\x64\x92\x6c\xa4\xb5\x72\xbd\x67\x4a\xb9\x50\xda\x7f\xf7\x2a\x18\x29\xcf\x4f\xf1\x36\x3e\x39\x8e\x43\x0b\xba\xcd\x8c\x2e\x20\xbe\x67\x56\xea\x29\xc2\xe5\x55\xc8\x1c\x3c\xea\x29\x6a\x14\x9e\xa2\xce\xee\xe4\x24\x60\x70\x7c\xfa\xf3\xab\x93\x66\x82\x0a\x05\xc2\x8c\x5b\xcf\xee\x02\x46\x89\x80\x1d\x43\x2a\x2e\xa0\xb3\xe5\x22\x6d\x85\xb3\xf4\x3f\x95\xbf\x35\x4a\x7b\xb4\x2c\x05\x5e\xc4\x45\xc6\x03\xcf\x61\x5e\xdb\x90\xdf\x07\x00\xc2\xc3\xff\xd6\xb5\x0d\x00\x00"),
 		},
 		"/src/syscall/syscall_windows.go": &vfsgen۰CompressedFileInfo{
 			name:             "syscall_windows.go",
@@ -881,6 +924,13 @@ var FS = func() http.FileSystem {
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x96\xdf\x6f\xdb\x36\x10\xc7\x9f\xad\xbf\xe2\xe0\x87\x81\xf4\xb8\x38\x72\x97\xcc\x29\xe0\x87\x20\x76\xd3\x01\xee\x52\x24\x2e\x0a\xac\x28\x0a\x4a\x3c\xc9\x6c\x29\x52\x20\x29\xa3\x4e\x9a\xff\x7d\xa0\x7e\xd8\xca\x92\x02\x1b\x1a\x18\x7d\x91\x45\xde\x97\x77\x9f\xfb\x21\xc2\xe3\x31\xfc\x9a\x54\x52\x09\xf8\xec\xa2\xa8\xe4\xe9\x17\x9e\x23\xb8\xad\x4b\xb9\x52\x51\x24\x8b\xd2\x58\x0f\x43\x5b\x69\x2f\x0b\x1c\x46\xd1\x86\x5b\x28\xa4\xae\xdc\x95\x46\x98\xc1\x6f\x71\x14\x65\x95\x4e\xe1\xa6\x39\x42\xbc\xe5\x25\x03\xcd\x6d\xee\x18\xf0\x98\x01\x9f\x30\xe0\x2f\xa0\x92\xda\x97\xde\x52\x20\x36\x66\x60\x27\xdd\x06\x03\xb4\x16\x16\xd6\x6a\x43\xe1\x2e\x1a\x94\x56\x6a\xff\x9e\x5b\x2d\x75\x4e\x68\x34\xb0\xe8\x2b\xab\x3b\x35\xe9\x42\x53\x06\xc7\x0c\x16\xe7\x17\x17\x8b\x9b\xe8\xfe\x21\xc3\xe9\xf7\x20\x18\xf0\xdf\x19\xf0\x13\x06\xfc\xf4\x90\x40\x67\xff\x05\x88\x01\xff\x83\x01\x9f\x32\xe0\x67\x87\x84\x8b\x27\xff\x97\x2e\x68\x8e\xc3\x23\x28\xe3\xc9\x41\x61\x4f\x7e\x10\x36\x3c\x82\x36\x0e\xe2\xf8\xe4\xa0\xec\xd3\xe7\x65\x0f\x8f\x20\x8f\x83\x3e\x9e\x1e\x24\x15\x65\xb8\x50\x32\xb1\xdc\x6e\x49\x26\x15\x6a\x5e\x20\x8c\xc2\xd1\xf8\x94\x02\x59\x73\x2d\x14\xfe\x78\xe0\x7f\x45\xcd\xd1\x97\xd6\xa4\x5c\x08\x8b\xce\x3d\x8a\x12\x6c\x7b\x90\x29\x05\x12\x76\x9e\x9d\x82\x08\x18\x2d\xf9\xed\x76\xbe\x5c\x52\x58\x1a\x2e\x08\x0d\xae\x8d\x0d\x5e\x5b\x2f\xbf\xcc\x97\xcb\x45\xd8\xbb\x7b\xe3\xf2\x97\x30\x74\x5b\xe7\xb1\x80\xd0\x7e\x07\xda\x78\xe0\x1b\x2e\x15\x4f\x14\x32\x70\x88\xb0\xf6\xbe\x74\x2f\xc7\xe3\x5c\xfa\x75\x95\x1c\xa5\xa6\x18\xe7\xa6\x5c\xa3\xfd\xec\xf6\x2f\x89\x32\xc9\xb8\xe0\xce\xa3\x1d\x0b\x93\x8e\xdb\xdb\xd9\x1d\x15\x62\x78\xbf\xc7\x2b\x1b\xbc\xb7\xd6\xa4\x14\x5e\x49\xfd\x93\xf1\xe5\xe8\x6f\xbc\x78\x5d\xf7\x8e\xac\x41\x6a\x4f\x81\x64\x02\x9a\x9d\xba\x35\x32\x83\x35\xcc\x66\x70\xb3\x9a\x7f\xba\x7a\xb7\x7a\xfb\x6e\xf5\xe9\xf5\xf9\x5f\xf3\xe5\x22\x18\xbb\x14\xe2\x68\x70\xff\x50\xba\xb8\xbe\xbe\xba\x7e\x42\x39\xa9\x95\xed\xe2\x78\x07\x72\x89\xfe\xc2\x68\x67\x14\xbe\x31\x02\x49\xda\xbc\xb7\x1c\x0c\x0a\x23\xda\x49\x7a\x31\xa1\x40\xc2\xf0\xd4\x55\xa4\xbd\x32\xce\xab\xa2\xd8\x36\x75\xdc\x27\xf8\xde\x4a\x8f\xaf\x64\xc8\xae\x19\xd0\xce\x63\x52\x65\xf0\xe1\x63\xb2\xf5\xc8\x40\x18\xbd\xf3\xce\xc0\x6c\xd0\x2a\x5e\x96\x28\x60\x74\xb5\x7b\x7f\x14\x35\x24\xdb\xb8\x9c\xcd\x20\x86\x6f\xdf\x7a\xcb\x49\x9d\x71\x3d\xd4\x2b\xd3\xe6\x45\x92\x2a\xa3\xd1\x60\x30\xaa\xa3\xcd\xa0\x09\x47\x14\xea\xda\x42\xf7\x25\xd2\x52\xd5\x45\xfa\xce\x47\x11\xcc\x5d\x7a\x8b\xaf\xd2\x87\xd9\x0a\x5f\x20\x7e\x95\x3e\x0d\x75\xea\xca\x14\x4a\xd3\xfc\x45\x38\xba\x34\xc1\x4a\xe8\xc3\x7a\x17\x05\xd7\x62\x29\x35\x12\x0a\x24\x2d\xc4\xfe\xd2\xd8\x55\x75\x77\xa0\xa7\x5e\x99\x73\x9b\x6f\xfa\x07\x18\x70\x9b\xa7\x30\xea\xfa\xc3\x6d\xbe\x81\xd1\x87\x69\x7c\x36\xf9\xd8\xfe\x74\xc2\x27\x5b\xa7\xa5\x62\x4f\xf7\xef\x12\x3d\xea\x0d\xf9\x82\x5b\x70\xde\x4a\x9d\x53\x20\x1b\xae\x2a\x6c\x97\x0c\x32\x53\x69\x01\x89\x31\xaa\xef\x71\x38\x64\x90\x71\xe5\xb0\xef\x69\x25\x0b\xfc\xdb\x68\xfc\x53\x67\xc6\x16\xdc\x4b\xa3\x89\xbf\x95\x30\x0a\x86\x5b\xa3\x51\xee\x0d\xe1\xc6\x4e\xa1\x9b\x89\x27\xa9\x8f\x1f\x33\xfb\x6d\x89\xbd\xcd\x00\x59\xa5\xfe\x6e\x77\x1d\xf4\x8d\x14\xea\x1f\x42\xdb\x54\x1e\xd0\x47\xf7\xd1\x3f\x01\x00\x00\xff\xff\x47\x14\x60\x60\x06\x0a\x00\x00"),
 		},
+		"/src/syscall/vfs_unix.go": &vfsgen۰CompressedFileInfo{
+			name:             "vfs_unix.go",
+			modTime:          time.Date(2026, 10, 18, 21, 20, 37, 153707010, time.UTC),
+			uncompressedSize: 6442,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x58\x4d\x8f\xdb\x36\x13\x3e\x5b\xbf\x62\xb2\x78\x91\x57\x6e\x1d\x1b\xfd\x40\x0f\x29\xf6\x94\x62\x83\x02\x69\xb6\xc8\x66\xd1\x43\x10\x2c\x68\x71\x68\x73\x4d\x91\x02\x49\xaf\xe0\x06\xf9\xef\x05\x29\x51\x1f\xb6\x56\x54\xbd\x1b\xa0\x37\x9b\x9a\x79\xe6\x79\x86\xe4\x70\xc8\xd5\x0a\xbe\x5f\xef\xb9\xa0\x70\x6f\x16\x2f\x4a\x2e\xa9\x2a\x4d\x92\x14\x24\xdb\x91\x0d\x82\x39\x98\x8c\x08\x91\x24\x3c\x2f\x94\xb6\x90\x26\xb3\x8b\x0d\xb7\xdb\xfd\x7a\x99\xa9\x7c\xb5\x51\xc5\x16\xf5\xbd\x69\x7f\xdc\x9b\x8b\x64\x9e\x24\xab\x15\x5c\xcb\x0c\x81\x40\xa1\xd5\x46\x93\x1c\x50\x92\xb5\x40\x03\x76\x8b\xc0\xe5\xab\x1c\x73\xa5\x0f\xc0\xb8\xf0\x41\x2c\xe6\xa0\x18\xd4\x61\x9d\x7b\x24\xca\xea\x81\x99\x85\x07\xeb\x42\x38\xae\x06\xd6\x28\x54\x09\x1b\xe5\x3e\x6b\x0f\xc6\xa5\xb1\x48\xa8\x0b\x61\xfd\x70\xed\xb0\x84\x37\xde\x43\xc9\x0a\x86\xa2\xc9\x34\x2f\xac\xd2\x8e\x28\xb1\xc0\x2d\x50\x85\x46\xfe\xdf\x82\x2a\xe5\x02\x04\xdf\x79\x40\x0f\x61\x89\xa4\x44\x53\x50\x12\xcd\x02\x8c\xe5\x42\xf8\xa8\x55\x04\xa5\xf9\x86\x4b\x22\x80\xed\x65\x66\xb9\x92\x66\xe9\xf3\xe2\x02\xdd\x54\x74\xf9\x48\x3a\x16\x50\x6e\x79\xb6\x85\x4a\xaa\x57\xe0\xa9\x12\x03\xff\x7b\x60\x66\x99\xd8\x43\x81\x3d\x30\x69\x51\x33\x92\x21\x7c\x49\x66\xd7\xa5\x34\x29\xa3\x6e\x70\x0e\x6b\xa5\x44\x32\xbb\x2e\x50\x12\x9b\x52\xae\xab\xf1\x05\x14\xc4\x6e\xc1\x58\xcd\xe5\x66\x01\x4c\x90\x8d\xa9\xc7\x51\xe7\xb0\xe7\xd2\xfe\xf4\xe3\x1c\x52\x3f\x84\x5a\x2b\x3d\x4f\x66\x6f\x84\x32\xd8\x00\xfb\xd1\x64\xf6\x01\x09\x4d\x03\xe8\x1a\x3e\x7d\x5e\x1f\x2c\x1e\x7b\xfe\xa5\xb9\xc5\xa8\xd5\x9f\x7a\x08\x6b\x01\x8a\x31\x37\xf4\xcb\xcf\x27\x0e\xe5\x20\xee\x88\xc7\x0d\xe2\xae\xb1\x57\x8c\x19\xb4\x95\xa1\xcb\x38\xba\x45\xeb\xb5\xa5\xf5\x58\x70\xbb\x32\x96\xd8\xc6\xcf\x58\xf8\xee\xc6\x12\x7b\xd7\x66\xc1\x1b\x8c\x24\x78\xc0\xe5\x8f\x1d\xe5\x7a\xc4\xa5\x37\x11\xb5\xcf\xad\x14\x5c\xee\x46\x9c\x28\xd7\x7e\xc6\x3b\xd3\x23\x49\x8e\xc4\xa6\x4a\xd0\x8e\x93\x12\xb4\xe7\x27\xb1\xec\x7c\x95\x58\x76\xbe\x36\x58\x6f\xb6\xb9\xa2\x69\xcf\x2f\x57\x14\x8f\x49\x5e\x65\xde\x2e\x80\x0d\x99\x7c\xd4\x7b\x99\x11\x8b\x7d\x30\xc3\xff\xc6\x30\x6d\x01\xcb\x06\xcb\x26\xf9\xa7\x46\xb7\x96\xe7\x68\xde\x13\xa9\xfa\x78\xd6\xc0\xa7\xcf\x1f\xdd\xb7\x02\xb3\x8e\x0a\xca\x75\x3a\xa4\xef\x2a\xf3\x9f\x8e\x16\xf8\x5b\xb4\x25\x4d\xe7\x90\x06\xd8\xb0\x28\xdc\xca\xff\x8d\x6b\x94\xed\xca\x58\xef\xd9\xf0\xda\xfe\xea\x77\xbf\xdb\xcb\x1a\xed\x5e\xcb\xd1\xbd\xaf\x34\x48\x2e\x80\x33\xe0\x16\xb8\xaf\x3f\x55\x01\xa5\xcb\xc4\xd5\x13\x87\x93\xce\xbb\xdb\xff\x4b\x32\x63\x06\x5e\x5f\xc2\xbd\x59\xbe\x15\x6a\x4d\xc4\xf2\x2d\xda\xf4\xc2\x15\x8b\x8b\x79\x32\xe3\x0c\x98\x81\x4b\xff\xfd\x56\x52\x64\x5c\x22\x75\x5e\xb3\x8a\x8e\x8b\x97\xcc\xbe\x26\xe1\x2f\x33\xcb\xdf\x43\x45\x49\xe7\xcb\xb4\x0d\xd5\x91\x72\xe5\x38\x47\xe5\xd4\x32\x54\x29\x0d\xb0\x8e\x00\xe7\xdd\xa4\xba\x2f\xa5\x62\xfb\xfa\xb2\xd2\xf9\xab\xfb\xf3\xe2\xd2\xa7\xe4\xe5\x4b\x47\xad\xae\x6f\xf3\xae\x00\x66\xba\xfc\x9d\x1c\xcf\x33\x1c\x1a\xaf\x77\x88\xc5\xab\x50\x95\x2b\x12\xae\x26\x0e\xac\xe6\x81\x2a\x18\xa6\x17\xb5\xae\x27\x74\x9c\x66\x8f\xd7\xb2\xae\xbd\xaf\x7e\xa8\xf6\x6a\x15\xa6\x0a\x31\xef\x92\xbe\x0b\x64\xef\x02\xcf\xbb\x86\x62\xdf\x29\x2a\xad\x5f\xa9\xd3\xc7\x79\xd7\xb3\x30\xc2\x3e\x40\xc5\xa8\xb6\x76\x51\x76\xfd\xd3\xa0\x68\x77\x8c\x8c\xa5\x39\x4e\x37\x60\x2f\xa0\x88\x31\xee\x99\x46\x49\xf7\x0f\xa7\xa2\x7b\xd4\x34\x87\xc8\xf3\x48\x08\x91\x16\x50\x04\xf8\x98\x94\x41\x97\xb8\xa4\x72\x70\x22\xbe\x89\xa6\xb2\x4d\xf6\x64\x51\x83\x3e\x51\x55\x53\x8f\xf8\xa6\x47\x78\x9a\xb2\x3a\x5c\x08\x15\x82\xc4\xc4\x3d\xea\x16\xd5\x77\xdc\x8b\x90\x4e\x6b\xf1\xa4\x9d\x1e\x80\x2b\xd0\x98\x80\x63\xeb\xf8\xbc\x38\xfb\xa3\xa6\x68\x22\xf7\x34\xc6\xba\x57\x5e\xa7\xb0\x6f\xc8\xf4\xe8\xc3\x3b\xf7\x07\xb8\xf1\x64\x5d\xaf\xe1\x96\xcb\xe3\x47\xdb\x96\x18\x90\x0a\xcc\x21\x5f\x2b\xc1\x33\x70\xcd\x99\x59\x26\xab\x55\x24\x15\xef\x4c\x08\xff\x5f\xc8\x45\xcb\x66\xf2\x5c\xfa\xde\x75\xa4\x13\x3c\x93\x7f\x68\x89\xfb\x47\x65\x8c\x7f\x4b\x26\xd8\x47\xf9\x7f\xc8\x4f\x7a\xc0\x33\x29\x37\x1d\x79\xcb\xd9\xea\x7d\x94\x73\x4b\x60\x02\xdb\x2a\xc6\xb7\xa2\xcb\x88\x30\x51\xbe\x1d\x0a\x53\xd2\xeb\x2f\x1d\x29\xd3\x2a\x6f\x9b\x71\xf5\x54\xee\xcd\x55\xc6\x71\x77\xd8\x0b\x70\xbf\xac\x8a\x26\xbb\xa5\x53\x99\xc7\xfb\xa7\xc8\x4d\xe7\x4c\x05\x2d\xec\xb4\x95\x7d\x6a\x1f\x3f\x1d\x46\x2e\x5f\x4f\x3b\x1d\x02\xf0\x34\xea\x27\xe6\x51\xe6\xc3\x77\x42\x81\x72\x63\xb7\x4d\x2b\x72\x5e\xde\x7b\xd0\x01\x33\xa6\xe0\x11\xa7\xf8\x0c\x9c\x5c\x59\x27\x6b\x98\x30\x0b\x1d\xf0\xa9\x42\x86\x7d\xe2\x55\x67\xda\xad\xfa\xdc\x42\xd4\x47\x77\xb0\xd1\x1a\x34\xe4\x32\x61\x2b\x3f\x57\xa9\x6f\x91\xe2\xfb\xf6\x5f\x54\xf7\xa3\x57\x87\xa7\x6e\xd2\x0a\x6b\xc2\xf6\x0c\x86\x51\x82\xcd\x03\x48\x49\xa1\xf3\x06\x72\x46\x06\x6b\xa4\x08\xb7\x60\x35\xe1\x98\x19\x7f\x80\x79\x86\x7b\x4b\x2f\x84\x87\x8f\x1f\x36\xa7\x1e\x5e\x09\x5c\x99\x83\xcc\xea\x9e\xd1\x6e\xb9\xdc\x80\x55\x40\x15\x30\xa5\x8f\xfa\xcb\x29\x3d\xa4\x47\x1b\x59\x35\x1d\x79\x03\xd2\x8e\xde\x7b\x06\xfb\xfb\x0a\x3f\xb0\xbf\x41\xfb\x5e\xc9\xb5\x50\xd9\x6e\x9a\x86\xf0\x86\x2d\xf1\x01\x35\x78\xc7\x09\xb2\x3a\x61\x9a\x49\x95\xf5\x80\x0b\x57\x3d\x6e\x3e\xbf\xdc\x7e\xdc\x5e\x4c\x97\x81\x7f\x06\x00\x0f\x38\xc5\xad\x2a\x19\x00\x00"),
+		},
 		"/src/testing": &vfsgen۰DirInfo{
 			name:    "testing",
 			modTime: time.Date(2021, 2, 20, 9, 0, 11, 161216237, time.UTC),
@@ -958,6 +1008,20 @@ var FS = func() http.FileSystem {
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x54\x90\xcd\xae\x9b\x30\x10\x85\xd7\x9e\xa7\x38\xca\xa2\x82\xdb\x88\x28\xfd\x5b\x20\xb1\x6a\xf7\x95\xaa\xfb\x02\x8e\x19\xc8\x10\xb0\xa9\x19\xb7\x6a\xaf\xf2\xee\x95\x81\xdb\xb4\x6c\x98\x1f\xcf\x99\x33\xdf\xe9\x84\xb7\x97\x24\x63\x8b\x61\xa1\x47\xd2\x87\x73\x75\xfe\x48\x34\x5b\x77\xb3\x3d\x43\x65\x62\x22\x99\xe6\x10\x15\x87\x5e\xf4\x9a\x2e\x95\x0b\xd3\xa9\x0f\xf3\x95\xe3\xb0\x3c\x82\x61\x39\x10\xe9\xaf\x99\x11\x93\xcf\x73\xcf\x32\x71\xc4\xa2\x31\x39\xc5\x0b\x19\xc1\xf6\x89\xd7\xf7\xef\xc8\xfc\xbc\xb2\xdf\xd3\x4f\x1f\xc8\xcc\x1c\x25\xb4\x7f\xd3\x6e\x7f\xdc\x25\xef\x0a\xf1\xca\xb1\xb3\x8e\x5f\xee\x47\x24\xf1\x3a\x6b\x2c\xc9\xd8\xd8\xbf\x0a\xbe\xb6\xc9\xe4\xcd\x21\x29\x9e\x86\xa5\xfa\x7a\x19\xd8\x29\x19\xeb\x54\x7e\x30\x70\x09\x61\x24\xb3\xf0\xf7\x75\x6c\x17\xa2\x3b\x51\xde\x82\x42\xf1\xf4\x2c\xee\xc6\xb1\xc4\x37\x5e\x58\x8b\x16\x5f\x52\xb4\x2a\xc1\x97\xeb\x01\x1d\xb4\x8a\x55\x87\xa6\x81\x97\x31\x97\xcc\x6c\xbd\xb8\xe2\x90\xb7\xd6\xdb\x14\x9c\x1d\x47\x6e\x11\x3c\x92\x17\x2f\x2a\x76\x94\xdf\xdc\x62\xd3\x3e\x94\x64\xee\x64\x16\x0d\xf3\x0a\xa8\x78\xa3\x55\x3e\xc6\xa1\x6e\x30\xd9\x1b\x17\xee\x6a\x3d\x72\xef\x88\x73\x49\x46\xab\xcf\x68\xe0\x72\x10\xd1\xfc\x07\x37\x1b\xc8\x18\x6b\x00\xf9\x5f\xb4\xe5\x31\x7b\x5a\x51\xd6\x1b\xca\xbd\xd6\xd5\x1b\xcf\x85\x7d\xbb\x6a\x93\xc9\xfc\xd6\xaa\x3b\xee\x96\x6c\xd4\x7f\x3d\xdd\xe9\x4f\x00\x00\x00\xff\xff\xc6\x1e\xd1\xd3\x26\x02\x00\x00"),
 		},
+		"/src/time/go115_zoneinfo_extend.go": &vfsgen۰CompressedFileInfo{
+			name:             "go115_zoneinfo_extend.go",
+			modTime:          time.Date(2026, 10, 18, 22, 48, 0, 979889156, time.UTC),
+			uncompressedSize: 3329,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x84\x56\x6d\x6f\xdb\x38\x12\xfe\x6c\xff\x8a\xa9\x80\x36\x56\xf4\x62\xd9\x49\xdc\x9e\x13\x07\x28\xd2\x1e\x50\xe0\xda\x5b\x6c\x53\x2c\x50\xc3\x58\x30\xd2\xc8\xe6\x86\x26\xb3\x22\x1d\xc7\x4d\xf3\xdf\x17\x33\x94\x2c\x39\xdd\xee\x02\x06\x2c\x72\x38\xcf\xbc\xf2\xe1\x0c\x87\x10\xdd\x6c\xa4\x2a\xe0\x0f\xdb\x6f\x17\x4b\x33\x4a\x47\x67\xfd\xfe\x9d\xc8\x6f\xc5\x12\xc1\xc9\x35\xf6\x49\x2e\xb5\x53\x57\x2b\xcc\x6f\x41\x5a\x70\x2b\x04\xeb\x44\xe5\xc0\x94\xbc\xd8\xa1\xa8\x2c\x6c\x57\xc6\x22\xb8\x4a\x68\x2b\x9d\x34\xda\x1f\xac\x36\x0a\xa1\x34\x15\x2d\x08\xc9\x9f\x15\xa5\xc3\x8a\x51\xdf\xeb\x02\xd6\x1b\xeb\x60\x29\xef\x11\x84\x85\x2d\x2a\x35\x85\x71\x76\x92\x25\xd9\x28\xc9\x46\xf0\xe5\xfa\x2a\xed\xe7\x46\x5b\xd7\x71\x63\x06\xa3\x37\xff\x39\x39\x3d\x9b\x64\x59\xb6\xf7\xf0\xfd\x83\x43\x5d\x80\x45\xd7\xb1\x7d\xb3\x83\xed\x4a\xe6\x2b\x50\xb0\x34\x68\xc1\xe8\x43\xeb\x31\x28\x79\x8b\x7c\xbe\x34\xc6\x61\x45\x68\xa6\x84\x7b\xac\xac\x34\x1a\xc6\xf0\xcd\x68\x94\xba\x34\x50\x4a\x85\x36\x85\x0f\x8e\xb2\xb0\x16\x05\x42\x59\x99\x35\xab\x16\x62\xa7\xe4\x72\xe5\xc0\x8a\x7b\xa9\x97\x9c\x39\x30\x25\x61\x91\x58\x09\xeb\x38\xf4\x18\x24\xe7\xac\x42\xc2\x10\x7a\x17\x83\xd0\x05\xdc\xe2\x9d\x03\xa3\xd5\x8e\xc4\xd2\x27\xc3\xc7\xd0\xc9\x27\x81\x59\xa9\x73\xec\xe4\xc1\x19\x93\xc2\xdb\xce\x29\x1f\x4d\x40\xaa\xff\xad\x64\x21\x76\x70\x83\xa5\xa9\xb0\x75\xe3\xf3\x46\x17\x62\x17\x70\xd2\x2c\x6c\x2b\xe9\x1c\x6a\xca\xbc\x68\xbc\x6e\x22\x6a\x54\x33\x30\x15\xdc\x91\xee\xf8\x34\xa6\x93\x52\x43\xf0\xf1\x24\x3d\x4b\xb3\x61\x72\x3a\x09\xd2\x7e\xb9\xd1\x79\xa7\x04\x03\x05\xc7\xff\x33\xb9\x20\x87\x42\x78\xec\xf7\xee\x45\xe5\x8d\xcf\x17\x94\xcd\x6b\x72\xb7\xdf\xa3\xb6\xf8\x3d\x06\xf7\x00\xd3\x19\x54\x42\x2f\x11\x54\xea\x1e\x48\xa1\x47\x69\x7a\x48\xb7\x2b\xd4\x70\x39\x6b\x6a\x95\x9c\x4c\xce\x8e\x2d\xe6\x46\x17\xf6\x17\xac\xde\x89\x1d\x9f\xed\x31\xf4\x0c\xc4\xdd\x1d\x1b\x17\xd6\x11\x6a\xd8\xef\xf5\x9e\xfa\xf4\x93\x25\x28\xd4\x2c\x08\xe1\xc5\x0c\xc6\xac\x56\xa1\xdb\x54\x9a\x0f\x58\x57\xc4\x50\x58\x47\x8e\xa8\x94\x5c\x9c\xd3\xe1\xf9\x68\x91\x4a\x5d\xe0\xc3\x22\x3e\xd8\xce\x9a\x6d\xd2\x14\x95\x8b\x81\xfa\x8e\x74\x6b\x29\xf9\x1d\x43\x03\x41\x2b\x76\xc2\xba\x22\x95\xf6\xdd\xe7\x6b\xb6\xdf\x18\x8d\xa1\x03\x32\x6b\x76\x0a\x5e\xd7\xb2\x26\x8a\x16\xe0\xfb\x77\x78\x51\x58\xd7\x81\x6b\xc3\xe9\xf7\x24\x3b\x43\x21\xa7\x9c\x07\xca\xb4\x84\x4b\x18\xc1\xab\x57\x9c\xe3\xb9\x4c\x46\x8b\x83\xf4\xfa\x7e\xe2\xd4\x27\x09\xdb\xcb\x79\x67\x3a\x83\x57\x4d\x2d\x1f\xb5\x58\xe3\x14\x54\x4a\xff\x31\x5f\x8c\x69\x9d\x18\x4a\xf8\xd4\x43\x4f\xe5\xe2\xa9\xdf\xa3\x4d\x0b\x53\x0f\xfe\xeb\x46\xe1\x27\xb1\xc6\x01\x05\x40\xca\x21\x44\x7b\xc1\xb5\x5c\xe3\x20\x21\x89\x29\x4b\x8b\xae\x2b\x63\x25\x8a\xf3\xef\x95\x48\x52\x2b\xed\xdb\xc9\xb6\xdd\xd4\x1c\x7e\x27\x1c\xda\x81\xe7\x2c\x86\x98\x9c\x0e\x3a\xf6\xb8\x45\x1b\x75\xfc\x99\x3a\x55\xa7\x51\xee\xd8\xf5\xca\x3e\x59\x29\x7a\x06\x9a\x81\x8f\x3e\x82\x20\x0e\x20\x82\xf6\x0b\xe9\xac\x2c\xdb\x8c\x13\xfe\x80\x95\x63\x50\x71\x5d\x9b\xe9\xa2\x46\xed\xa9\x16\xb2\x6b\x81\x65\x4d\xc1\xb9\xcb\x7d\xa7\x3f\x1d\xb2\x35\x81\x43\x85\x77\xa6\x72\xc4\xcf\x48\xc4\x03\xb5\x31\x4f\x8a\x2b\xd1\x52\x65\xdc\xa1\x1d\xf6\xbf\x66\x42\x05\xa2\x32\x1b\x5d\x00\x8a\x7c\xd5\xd0\xc3\x01\xcf\x3f\x74\x18\xe0\xc7\xa8\x5a\x2e\xe0\xbb\xde\xa1\x80\x10\x6e\x8c\x51\x14\x6a\x43\x05\x6d\xf2\x6b\x1e\xa8\x05\x16\xf3\x56\x34\x4f\xd3\x74\xc1\x85\x78\x74\xbe\x87\x13\x18\xc5\xe0\xbf\x9f\x7c\xe6\x7c\x87\xfa\x1a\x91\xe6\x17\x2d\x1f\x06\x16\xf3\x18\xb2\x30\xfd\xa0\xbd\x73\x61\xfa\xd5\x68\x1c\x84\x75\x51\xb6\x42\xbb\x4f\xac\x47\x5f\xff\xff\xa9\xae\x6a\xf4\xce\x81\xcc\x10\xa3\x34\xaa\x74\x2f\x6b\xa3\x2f\x66\x5d\x98\xc7\x4e\xc9\xa0\x14\xca\xe2\x41\xe1\x1a\x89\xab\x36\xd8\xa9\x62\x73\x05\xe0\xcf\x8d\x71\x9d\xd2\x78\xbb\x94\x1b\xe1\x5f\xb9\x8d\x56\x68\x2d\x48\x7e\x9a\x84\x52\xa0\xd0\x39\xac\x6c\xa7\x30\xfb\xeb\xc4\xba\xd6\x55\x52\x2f\xc3\xfa\xbf\x29\x01\xf3\x46\x76\x0e\x12\x2e\x98\x3e\xf8\xd6\x9d\x83\x8c\xa2\x86\x94\xb9\x0c\xb4\x3d\x97\x8b\x73\xc8\xe1\x02\x8e\xde\x1e\x51\xd4\x39\x5c\xc2\xd1\xd7\x23\x22\x18\xde\x15\xed\xee\xb7\x23\x1f\x7e\x1d\x63\x70\x41\x37\x81\xbd\x88\x20\xb8\x0c\x9e\xe7\x80\x24\xcf\x72\x70\x2d\x7d\xb8\x6b\xe1\x2c\x70\x25\x38\x5c\xb7\x12\x1a\x46\x59\x06\x2b\xb3\xa9\x2c\x3f\x4d\xf3\x64\xb1\x9a\x4f\xd7\xeb\xf9\xd4\xda\xc5\xe2\x59\xf8\x4c\x1a\xd4\x4a\x52\xbb\x6e\xe8\x4c\x19\x41\xe0\x29\x16\xc9\xfd\xcc\x33\xb4\x6f\xbc\x19\x04\x49\x10\x43\x62\x31\xf7\xaf\x05\x44\x33\x90\xce\x08\xc6\x1a\xc2\xc9\x24\xcb\xc2\x46\xf9\x25\xad\xa8\xf8\x35\x04\x9d\x0d\xa6\xcc\x02\x6b\xa1\x94\xfd\xcc\x56\xe7\x16\xf3\xe1\x24\x7b\x39\xc9\x8e\xc7\xd3\xce\x77\x34\x5e\xf4\x7b\x0d\xd2\xa4\x83\xf3\x4f\x40\x7b\x94\x0e\xc4\x41\x46\xed\xb3\x74\x32\xa1\x81\x17\xfa\xa6\xda\x8a\x9d\x05\x67\x78\x1c\xc0\x67\x17\x1c\x84\xe3\x1d\x65\x72\xa1\xfc\x4c\x38\x1c\xd6\x2b\x61\xe1\xe3\x3a\xdd\xa6\xc5\x90\xf6\xa7\x60\xb4\x87\x43\xbc\xa5\x01\x42\x3a\x8b\xaa\x04\x53\xc1\xe6\x8e\xe0\xdd\xaa\x42\x1e\x2d\x6c\x33\x5b\x18\x9e\xb7\xea\x89\xcc\xc5\x20\x75\x3b\xa9\x10\x4a\xc3\x37\x6b\xa3\xdd\x8a\x80\x64\x6b\xa1\x6e\x77\xa9\x9f\x95\xd9\xf3\xb5\x77\x90\x69\x22\x84\xf9\xa2\xad\x36\x0d\x23\x05\x1d\xd9\xef\xee\xf9\x87\x3d\xfb\x81\x67\x1e\xb3\x98\xf8\x25\x19\xc5\x30\x8e\x21\x19\xc7\x70\x12\x43\x72\xe2\x99\xa6\x65\x08\x36\x98\xd4\x0f\x84\xd8\xd9\xf0\x70\x54\x61\xf6\xf8\x72\x7d\xc5\x74\xe3\x67\x41\x0e\x8a\xad\x12\x88\x4b\xc9\x71\x16\x53\x3f\xc7\xb0\x96\x7a\xe3\x90\x7b\xd0\xf8\xf9\xc2\xa5\x57\xca\xe4\xb7\x7c\x86\x42\xe0\xbe\xfd\x48\x3d\xc1\xed\x28\xb5\x1b\x30\x66\x48\x4f\x65\x90\x06\x3c\x16\xf8\xb1\x26\x48\x0f\x8e\xb9\xf4\x37\x5f\xa3\x41\xe8\x0f\x0f\x83\xe7\xaf\x2b\x39\x71\x4c\xdd\x1c\x79\x47\x8e\x27\x59\xe4\x5d\x89\x28\xbc\xc3\xe8\x42\xdf\xb6\x85\xd8\x45\xaf\xe1\x92\xfe\xed\x07\x3d\xa8\x03\xa4\x68\xeb\x17\xcd\x27\x7e\x3f\xab\xf1\x32\xe6\x72\x44\xc1\x59\x10\x91\xb7\xf5\xdc\x56\xc3\xc1\xc5\x0c\xc6\x6f\xfe\x55\x99\x03\xa3\xb4\x27\xa3\x70\xf8\x3a\x1a\x85\x5d\xa8\xf6\x26\xb0\x4a\xff\xa9\xff\xd7\x00\xa9\xc9\x70\xa7\x01\x0d\x00\x00"),
+		},
+		"/src/time/go115_zoneinfo_extend_test.go": &vfsgen۰CompressedFileInfo{
+			name:             "go115_zoneinfo_extend_test.go",
+			modTime:          time.Date(2026, 10, 18, 22, 53, 48, 840301775, time.UTC),
+			uncompressedSize: 1056,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x93\x51\x6f\xd3\x30\x10\xc7\x9f\xed\x4f\x71\x58\x8a\x94\x6c\x26\x6d\xba\x65\x88\xa1\x3d\x20\x5a\xa4\x0d\xca\x0b\xe1\x01\x10\x9a\x4c\xea\xa4\xa6\xa9\xbd\x39\x97\x96\xd2\xe5\xbb\x23\x3b\x29\xad\xa6\xa9\x20\xaa\x26\x3a\x27\x7f\xff\xee\x7c\xf7\xcf\x60\x00\xa7\xdf\x1b\x55\xcd\xe0\x47\x4d\xf7\x8b\xd2\x24\x71\x92\x52\x7a\x27\xf2\x85\x28\x25\xa0\x5a\x4a\x4a\xd5\xf2\xce\x58\x84\x90\x12\x86\xb2\x46\xa5\x4b\x46\x23\x4a\x8b\x46\xe7\x90\xc9\x1a\xaf\x35\x56\x93\x9f\x28\xf5\x2c\x44\x38\xe9\x25\x71\x16\xc1\x96\x12\xbd\xe1\x20\xad\x85\xcb\x2b\xa8\x8c\x98\x39\xe9\x7b\x93\x0b\x54\x46\x87\xec\xf5\x52\x5a\x95\x8b\xc1\x07\xb9\xbe\xfd\x6c\xec\x82\x45\x94\xa8\xc2\xeb\x9f\x5d\x81\x56\x95\x23\x10\x8c\xdf\x0a\x14\x55\x28\xad\x8d\x28\x69\xbd\x64\x2d\x34\x3a\x26\x9b\x7c\xcc\xd2\xc9\x38\x3b\xe7\xd3\xb3\x78\x14\x0f\x07\x23\x3e\x4d\x92\x38\x71\x11\x7b\x05\x7a\x13\x4b\x5f\x98\xe3\xf9\x3d\x1d\x70\x62\xad\xb1\x45\xc8\x4a\x83\x60\x9b\x4a\x42\x70\x0f\x85\xb1\x10\xac\x78\x27\x0b\xee\x19\xdf\xef\x76\x61\xf7\xa2\x2b\xc0\x49\x6f\x39\xa0\x2f\xc1\x0a\x5d\x4a\xf8\xfa\xad\x46\xdb\xe4\x7d\x02\xf0\xbf\xcc\x75\x8f\x10\x2d\x96\x12\x00\x6a\xb4\x4a\x97\x94\x10\x53\x14\xb5\x44\x50\x1a\x29\x69\x9d\x7c\x3b\x16\x28\xc3\xd1\x30\x1d\x72\xb8\x11\xba\x11\x76\xc3\x21\x49\x39\x24\x23\x0e\xc3\xdd\xff\x53\xf6\x26\xe2\xfe\xc0\x8c\xc3\xf3\x14\x4e\xe0\x62\xe8\x6f\x2d\x7f\xc4\x98\x0a\x9b\xcf\x39\x24\x67\x1c\x2e\x38\xa4\x2f\xbb\xeb\x7f\x11\x2f\x9e\xa8\x61\xec\x01\xe7\x47\x00\x37\x4d\x75\xec\x10\x4f\x02\x5a\xd8\xf6\xed\xe2\xd0\x37\xe9\xf2\x0a\x10\x63\x8c\xaf\x75\xa8\x37\x51\xfc\xc5\x68\x19\x46\x94\x38\x0b\x38\x9d\x1b\x2b\x62\xec\xc3\x87\x87\xdd\xa6\xee\x61\xbf\x70\xc8\x83\x89\x07\x2b\x50\x1a\x82\xd5\x25\xb8\xd9\xff\x32\x5a\x42\x50\x07\xa7\xb3\xdd\xdc\x5d\xcc\xb8\x4f\xda\x4d\xfd\xb0\x1c\xbe\x4b\xc6\xf7\x09\x5c\x35\xad\x73\x05\x25\x0b\x53\x2d\x04\x8a\x63\x86\xaf\x95\x18\xbc\xeb\x64\xff\x6e\xf6\x9e\x7b\x60\x65\xc6\xfe\x6a\x64\x58\x2b\x9c\x9b\x06\x61\x26\x36\x95\x2a\xe7\x08\xb5\x58\x29\x5d\xfa\x6f\x9a\xf1\x47\xd0\x3f\x6b\x9f\xb4\xa5\xbf\x07\x00\x05\x6e\x9f\x73\x20\x04\x00\x00"),
+		},
 		"/src/time/internal_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "internal_test.go",
 			modTime:          time.Date(2020, 10, 26, 1, 41, 11, 0, time.UTC),
@@ -967,10 +1031,10 @@ var FS = func() http.FileSystem {
 		},
 		"/src/time/time.go": &vfsgen۰CompressedFileInfo{
 			name:             "time.go",
-			modTime:          time.Date(2020, 10, 25, 15, 42, 13, 0, time.UTC),
-			uncompressedSize: 1554,
+			modTime:          time.Date(2026, 10, 18, 21, 24, 5, 577163615, time.UTC),
+			uncompressedSize: 1782,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x54\x4f\x6f\xe4\x34\x14\x3f\xc7\x9f\xe2\x11\x81\xd6\xde\xa6\x49\xbb\x8b\x40\x54\x1d\x24\x68\xd1\xaa\x87\xee\x1e\x0a\x67\xe4\x71\x5e\x26\x9e\x3a\x76\x64\xbf\x74\x5a\xaa\xf9\xee\xc8\x76\x9a\x99\x59\x01\x07\x6e\x89\xed\xf7\x7e\x7f\x9e\xfd\x6b\x1a\x38\x5b\x4f\xda\xb4\xb0\x0d\x8c\x8d\x52\x3d\xca\x0d\x02\xe9\x01\x19\xd3\xc3\xe8\x3c\x01\x67\x45\xb9\xd1\xd4\x4f\xeb\x5a\xb9\xa1\xd9\xb8\xb1\x47\xbf\x0d\x87\x8f\x6d\x28\x99\x60\xac\x69\xe0\x5e\x3e\x22\x84\xc9\xe7\x0e\xf5\x1f\x56\x3f\x43\x37\x59\x05\xd2\xb6\x79\xe9\x77\x3d\x20\x04\xf2\x93\x22\xd0\x04\x1e\x69\xf2\x36\x80\xf4\x08\xd2\xec\xe4\x4b\x00\x6d\x95\x99\x5a\x6c\x61\xa7\xa9\x07\xea\x75\x80\x37\x5a\xbc\xc5\x30\x6a\x42\xb8\xbd\xf9\x4d\x54\x11\x70\x8d\x4a\x4e\x01\x81\x7a\x7c\x79\xe7\x11\x2c\x62\x2c\xed\x9c\x07\x6d\x09\xbd\x95\x46\xff\x25\x49\x3b\xdb\xe0\xf3\xc9\x3f\xb8\xee\xc0\xa8\xb9\x95\x84\x35\x3c\x20\x82\x0e\x61\x42\xe8\x89\xc6\x70\xd5\x34\xff\xa9\x3b\x1d\x0d\xcd\x87\x1f\x7f\xaa\x59\x52\xa9\xad\x26\x2e\xe0\x95\x15\x4d\x03\xf2\xc9\xe9\x16\x5a\x94\x2d\x28\xd7\x22\xa0\xd1\x83\xb6\x09\x9b\x15\x4f\xd2\xc3\x9f\x90\xcc\x58\x41\xb4\x89\x5f\x54\x70\x21\xd8\x9e\xe5\x4e\x7e\xb2\x91\xdc\x67\x69\x1d\x17\x51\xca\x0f\xdf\xc7\xb6\xd9\x2e\xd8\x86\xfa\x93\x71\x6b\x69\xea\x4f\x48\xbc\x8c\xe4\x4b\x51\x7f\xc6\x1d\x17\xf5\x8d\x34\x86\x97\x1b\xa4\xd8\xbc\x14\xf5\x5d\xac\xe5\x02\xde\xe7\x2e\xfc\x5e\x1b\xa3\x03\x2a\x67\xdb\x03\x9c\x75\x3b\x2e\x80\x07\x54\xf9\x54\x05\x76\xfe\xfe\xf8\xa1\x82\xc1\x59\x97\xd7\x93\x36\x0b\x57\xab\x53\x82\x0b\x31\x0b\xcd\x0c\xf3\x90\x11\xaa\xdc\x83\x5b\xf8\xee\x74\x43\x54\x60\x17\xf8\x07\x83\x38\xf2\x16\x6e\x27\x9f\xfc\x49\x30\x2a\xc2\x0c\xf2\x11\xb9\xea\xa5\x9d\xef\xcc\xeb\x5e\xb0\xe2\x20\x3f\x8b\xfd\x36\x64\xb5\x6e\xa2\xb2\x8a\xe6\xdc\xcd\xa3\xff\xb2\xde\xa2\x22\x1e\x31\xe2\x58\x40\x19\x17\x90\x2b\x01\xfb\x4c\x8c\xb7\xcd\xb1\x1d\x82\x15\xd7\xe7\x6a\x61\x15\x48\xfa\xd4\xd7\x73\x82\xf7\xb3\xe0\xf4\x9f\xf8\x51\x2d\x15\xe9\xa7\x38\x40\xf2\x13\xb2\xa2\xd5\x5d\x17\x39\x73\xaa\x77\x3d\x5a\x38\x3f\x35\x49\x2c\xde\x9c\x8c\xa0\xd0\x1d\xa4\xca\x9f\xe1\xf2\xfa\xfa\xe3\xe5\xf9\x25\xbc\x42\xd3\xc0\x20\xa9\xaf\xef\xe5\xf3\x5d\xf4\x8f\x15\xb3\xc3\xac\xd8\x1f\x2a\xae\xe1\x22\x12\xc9\xc0\x2b\xb8\x48\x9b\x54\x53\xb6\x02\x56\xf0\x7f\x8d\x62\xc5\xb1\xba\x4e\x9a\x80\xac\x88\xb0\x54\x8f\xe8\xb5\x6b\xe1\x9b\xd5\x8c\x5d\xcc\x62\xcf\x56\xcb\x66\x5c\x3d\xf6\x4e\xb0\x22\x12\x2b\x36\x0e\xa8\xee\x38\xd5\xd2\x6f\xd2\x65\x2f\xe2\x18\x22\xf9\xb3\x4b\x71\xe4\xba\x1b\xff\xc5\xf4\xb5\x73\x26\x82\x7e\x2d\x4b\x19\x94\xfe\xa0\x6b\x71\x40\xb0\x62\x27\xc3\x2f\x59\xc7\x55\x24\x98\x35\xb1\x7f\x50\x37\x5f\xe0\xe5\x7c\xe4\xd3\xc4\x81\xb5\xf8\xfc\xeb\x0b\xc5\x5c\x00\xe5\x46\x1d\xc3\xc5\xbb\x21\x5e\x47\x6d\x37\x87\x68\x22\x37\x3f\xf8\x1c\x9a\xda\x6e\x62\xbc\xf1\xa0\xad\x4a\xe9\x04\x1e\xa5\x49\x81\xb3\x94\xb4\x0e\x83\x7d\x47\x62\x09\x8f\x19\x8a\x87\xb9\x7b\x05\x0a\xd6\x2f\x84\x29\x00\x4e\x9f\xff\x57\x83\x0b\x6f\xef\x3e\x35\xf9\xd2\xe5\xe9\x1e\x67\xc4\x43\xea\x58\xbe\x9d\x8b\x1a\x6e\x7a\xe9\x6f\x5c\x8b\x65\x05\x4a\xa4\xa0\xe0\x71\x0c\x7f\x07\x00\x00\xff\xff\xb0\xac\x84\x0d\x12\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x95\xc1\x6e\xe3\x36\x10\x86\xcf\xe2\x53\x4c\x85\x16\x4b\x6e\x14\x29\xd9\x2d\x5a\x34\x88\x0b\xb4\x49\xb1\xc8\x21\x5d\xa0\x69\xcf\x05\x4d\x8e\x2d\x3a\x34\x29\x90\xa3\x28\x6e\x90\x77\x2f\x48\x29\xb2\xbd\x49\x7b\xd8\x9b\x25\x0e\x67\xfe\xff\x9b\xd1\xb8\x69\xe0\x64\xd9\x1b\xab\x61\x13\x19\xeb\xa4\xba\x97\x6b\x04\x32\x5b\x64\xcc\x6c\x3b\x1f\x08\x38\x2b\xca\xb5\xa1\xb6\x5f\xd6\xca\x6f\x9b\xb5\xef\x5a\x0c\x9b\xb8\xff\xb1\x89\x25\x13\x8c\x35\x0d\xdc\xca\x7b\x84\xd8\x87\x31\x43\xfd\x97\x33\x8f\xb0\xea\x9d\x02\xe9\xf4\xf8\xea\x4f\xb3\x45\x88\x14\x7a\x45\x60\x08\x02\x52\x1f\x5c\x04\x19\x10\xa4\x1d\xe4\x2e\x82\x71\xca\xf6\x1a\x35\x0c\x86\x5a\xa0\xd6\x44\x78\x91\xc5\x35\xc6\xce\x10\xc2\xf5\xd5\x6f\xa2\x4a\x05\x97\xa8\x64\x1f\x11\xa8\xc5\xdd\xbb\x80\xe0\x10\xd3\xd5\x95\x0f\x60\x1c\x61\x70\xd2\x9a\x7f\x24\x19\xef\x1a\x7c\x3c\x7a\x06\xbf\xda\x2b\x6a\xae\x25\x61\x0d\x77\x88\x60\x62\xec\x11\x5a\xa2\x2e\x5e\x34\xcd\xff\xfa\xce\xa1\xb1\xf9\xf0\xe3\x4f\x35\xcb\x2e\x8d\x33\xc4\x05\x3c\xb1\xa2\x69\x40\x3e\x78\xa3\x41\xa3\xd4\xa0\xbc\x46\x40\x6b\xb6\xc6\xe5\xda\xac\x78\x90\x01\xfe\x86\x0c\x63\x01\x09\x13\x3f\xab\xe0\x4c\xb0\xe7\x8c\x31\xf4\x2e\x49\xfb\x5d\x3a\x3f\x13\xa2\x16\x61\xeb\x9d\x27\xef\x8c\x02\x65\xbd\xba\xaf\x60\x68\x8d\x6a\xb3\x8d\x10\xa1\x8f\x58\x01\x79\x3f\x89\x39\x48\xc2\x45\xa2\xf1\xc3\xf7\x49\xd9\x98\x0f\x36\xb1\xfe\x64\xfd\x52\xda\xfa\x4a\x5a\xcb\xcb\x6f\x9d\x74\x3e\xc5\x97\xa2\xbe\x49\xb1\xfc\x45\x8c\xf3\x03\x04\x94\x7a\x94\x30\x48\x6b\x8f\xab\xb7\x32\xc2\xa6\x8f\x04\x5b\x63\xad\x89\xa8\xbc\xd3\x10\x30\x7a\xdb\x27\xaf\xd5\xd8\xfb\x16\x53\xae\x2f\x0c\x40\xc4\x4e\x06\x49\x68\x77\x93\x68\xe7\x07\x2e\x80\x47\x54\xa3\xe2\x0a\xdc\xf4\xfb\xe3\x87\x2a\x5f\x1f\xdf\x67\xc8\x0e\x2e\x16\x6f\x18\xf1\xc3\xde\x03\xbc\x1f\xe3\xf9\xed\x5e\x9c\x98\x21\x38\x68\xa6\xe3\xbb\xf1\xa4\x1a\x2b\x71\x07\xdf\x1d\x1f\x88\xea\x98\x67\x62\x93\x05\xdf\x59\xc4\x8e\x6b\xb8\xee\x43\x6e\x6d\x16\xa6\x92\xb0\xad\xbc\x47\xae\x5a\xe9\xa6\x71\x7f\x7a\x16\xac\x78\xa5\x36\x22\xa5\x29\xf0\x3d\x95\x55\xf2\x72\x33\x4d\xed\xe7\xe5\x06\x15\xf1\x54\x23\x4d\x54\xe2\x15\x91\x2b\x01\xcf\xa3\x48\xae\x9b\x43\x4b\x6f\xa5\x1e\xa4\xa1\x3f\x50\x46\xef\xca\x0a\xca\x98\x84\x96\x82\x15\x97\xa7\x6a\x56\x1f\x49\x86\x5c\x3f\x70\x82\xf7\x93\xc3\xfc\x9c\x7d\x50\x2d\x15\x99\x87\x34\xa3\x14\x7a\x64\x85\x36\xab\x55\xf2\xc6\xa9\x1e\x5a\x74\x70\x7a\x4c\x45\xcc\x3c\x8f\x71\x9b\x15\xe4\x9b\x3f\xc3\xf9\xe5\xe5\xc7\xf3\xd3\x73\x78\x82\x34\x0d\x92\xda\xfa\x56\x3e\xde\x24\xe6\xac\x98\xba\xc2\x8a\xe7\xfd\x8d\x4b\x38\x4b\x42\xc6\xc2\x0b\x38\xcb\x87\x54\xd3\x88\x0c\x16\xf0\xb5\x40\x59\x71\xe8\x6e\x25\x6d\x44\x56\xa4\xb2\x54\x77\x18\x8c\xd7\xf0\xcd\x62\xaa\x5d\x4c\x66\x4f\x16\xf3\x61\x7a\x7b\xc8\x4e\xb0\x22\x09\x2b\xd6\x1e\xa8\x5e\x71\xaa\x65\x58\xe7\xef\xb9\x48\xed\x4a\xe2\x4f\xce\xc5\x01\x75\xdf\xfd\x07\xf4\xa5\xf7\x36\x15\x7d\x65\x4b\x59\x94\x61\x6f\x6c\x46\x20\x58\x31\xc8\xf8\xcb\x68\xe4\x62\x01\x2f\xa6\xd8\x1b\xf6\xa6\xa9\x9f\xe3\xa7\x0f\xdc\x38\x8d\x8f\xbf\xee\x08\xc1\x44\x50\xbe\x33\x69\x81\x06\xbf\x4d\x73\x6b\xdc\x7a\xbf\x7e\xc9\x4f\x4b\x6d\xfc\x63\x30\x6e\x0d\x86\x80\x47\xe3\x54\xde\xc0\x69\x4d\xd8\xbc\x8d\xe6\x2b\xda\x63\x74\xef\x48\xcc\x0b\x72\x2a\xc5\xe3\x94\xbd\x02\x05\xcb\x1d\x61\xde\x50\xc7\xfb\xe9\x8b\xce\x45\x31\xb1\xc8\x49\x3e\xaf\xc6\xf6\x4e\x94\x3e\x21\xf1\xf2\x2e\x67\x2c\x5f\xe2\x92\x87\xab\x56\x86\x2b\xaf\xb1\xac\x40\x89\xbc\x15\xf2\xb7\xfb\xef\x00\x29\xa4\xd6\x9e\xf6\x06\x00\x00"),
 		},
 		"/src/time/time_test.go": &vfsgen۰CompressedFileInfo{
 			name:             "time_test.go",
@@ -988,10 +1052,31 @@ var FS = func() http.FileSystem {
 		},
 		"/src/time/zoneinfo.go": &vfsgen۰CompressedFileInfo{
 			name:             "zoneinfo.go",
-			modTime:          time.Date(2020, 10, 25, 15, 20, 28, 0, time.UTC),
-			uncompressedSize: 1455,
+			modTime:          time.Date(2026, 10, 18, 21, 23, 7, 814373484, time.UTC),
+			uncompressedSize: 1924,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\x53\x4d\x6f\xe3\x36\x10\x3d\x8b\xbf\x62\xca\xa2\x80\x15\x3b\x12\x3f\x24\x4a\x0a\xd6\x87\x22\x05\xd2\x00\x8b\x06\xe8\xa6\x97\x06\x39\xd0\x32\x6d\x33\x91\x48\x83\xa4\x92\x6e\x82\xfc\xf7\x82\xb4\x92\xed\xb6\xbd\x49\xef\x3d\x3e\xce\xcc\x1b\x96\x25\x2c\x37\x93\x1e\xb6\xf0\xe0\x11\x3a\xca\xfe\x51\xee\x15\x04\x3d\x2a\x84\xf4\x78\xb4\x2e\xc0\x02\x65\xd8\x4d\x26\x62\x18\xa1\x0c\xef\x75\x38\x4c\x9b\xa2\xb7\x63\xb9\xb7\xc7\x83\x72\x0f\xfe\xdb\xc7\x83\xc7\x28\x47\xe8\x49\x3a\x78\xb1\x46\x7d\xb1\x93\xeb\x95\x87\x35\xdc\xdd\xfb\xe0\xb4\xd9\xbf\xa2\x0c\x97\x93\x77\xa5\x3f\x48\xa7\xca\x28\xd2\x66\x67\x4b\xbc\xfa\x9e\x18\xf4\xe6\xff\xc8\x08\x0f\xb6\x97\x83\x2a\x6f\xff\x4c\xf8\x5c\x5a\x71\x75\xf3\xfb\xcd\xcd\xed\x22\x87\x25\xe0\x24\x8b\xe8\x87\x45\xf1\xa2\x8f\x78\x85\xde\x10\xda\x4d\xa6\x07\x6d\x74\xf8\x1c\x6d\x16\x39\xbc\xa2\x2c\x39\x7e\xb6\x7d\x61\xe4\xa8\x60\x0d\x38\x71\xb1\xdb\x17\xb8\x58\xa7\x4e\x5e\xdf\x50\xb6\x8d\x3f\x0f\xbe\xb8\x1a\xec\x46\x0e\xc5\x95\x0a\x0b\xfc\x8b\x0c\x0a\xe7\xc5\x6f\xea\x79\x91\xa3\xcc\xee\x76\x5e\x85\x28\xdb\x16\x97\x72\x18\x16\x78\xaf\xc2\xad\x1e\x55\xb4\xb8\x49\x24\xce\x8b\x6b\x13\x16\x39\x9c\xc1\x39\x45\xd9\x4b\x31\x9f\x59\xc3\xfc\x71\x06\x82\xa0\xac\x2c\xe1\xe7\xbe\xb7\x6e\xab\xcd\x1e\x82\x85\x43\x08\x47\x7f\x51\x96\xa1\xe7\x5d\x31\x47\xa0\x6d\xa9\xfa\x51\x32\xc1\xca\x1f\xbd\xea\xcf\xc3\x7c\x91\x3a\x8d\x7a\x95\x5c\xc2\xe1\x94\x67\x24\x20\xf5\xb7\x73\x76\x84\x85\x51\xcf\x10\x8b\x5f\xe4\x79\x11\x6c\xac\xf1\x4b\x3a\xb5\xc8\x41\x7b\x90\x06\xf4\x78\x1c\xd4\xa8\x4c\x90\x41\x5b\x73\xbe\x55\x47\x65\xb6\xca\x84\xe4\xea\x94\x9f\x86\xb0\x02\x69\xb6\xa0\x0d\x5c\x59\xbb\x1f\x14\x5c\x1e\x9c\x1d\xd5\x0a\x74\x80\xbd\x7e\x52\x3e\x5d\xbe\x9b\x86\xe1\x2b\xa8\xbf\x8e\xd2\x6c\xd5\xf6\x54\x82\x93\xe1\xa0\x1c\x84\x83\x34\x1f\x45\xca\xcd\xc6\xa9\x27\x9d\x6e\x2b\x12\xfa\xab\x32\xbd\x5a\xc1\xb3\x82\xde\x1a\x1f\xdc\xd4\x87\xa4\xfc\xd6\x45\xfc\x3b\x8d\xad\x88\xa3\x7c\x8f\xef\x8f\xdb\x4b\x8c\x32\xbd\x7b\x1f\xe9\x27\x20\x31\xe6\x77\xc5\x72\x0d\xf8\x1c\xa3\xec\x3d\xae\xb3\x75\x8a\xe2\x0d\xd4\xe0\xd5\xbf\x85\x4b\x8c\xb2\x37\xf4\x0f\x44\x07\x2b\x17\xf3\xc9\x12\x04\xc9\x51\x36\x6a\x13\x33\x9f\xc1\x9f\x52\x80\x7a\x07\x11\xfe\x61\xfd\xdf\xbb\x2f\x30\x2c\x4f\x36\xa3\x36\x79\xb2\xff\xd8\xc0\x14\x53\x7c\x2e\x69\xe9\x5e\xde\xe2\xca\x96\x65\x52\xc7\x5c\x06\xfd\xa8\xc0\x07\xd7\x5b\xf3\x54\x5c\x47\x70\x33\x05\xb0\x66\xf8\x0a\xcf\xd6\x3d\x7a\xd8\x59\x07\x4f\x72\x98\x94\x07\xbb\x03\x1d\xc3\x71\xd2\xec\x15\xdc\x91\x55\xd7\xdd\x17\xd1\xec\x3a\xc0\x51\x1a\xdd\x7b\xd0\x49\xe2\xc1\x46\x93\xdd\x49\x59\xcc\x4f\x24\xd6\x17\xcf\x87\x1c\x4e\xfb\x14\xdb\x48\x07\x3e\x01\x3d\xf5\xe4\x54\x98\x9c\x81\xad\xde\xeb\xe0\xef\x34\x5c\x80\x5e\xd2\xfb\xd4\xd0\x4c\xf9\x51\x0e\x83\x3f\x6d\xd6\x9d\x3e\x63\x51\x72\xc6\x96\xec\x3e\xf6\x95\x52\xfd\x4e\x12\xc3\x23\x84\x50\xc2\x08\x27\x15\xa9\x89\x20\x0d\x69\x49\x87\x61\x89\x32\x4c\x09\xa5\x94\x51\x4e\x2b\x5a\x53\x41\x1b\xda\xd2\x99\x61\x84\x51\xc6\x18\x67\x15\xab\x99\x60\x0d\x6b\xd9\xcc\x70\xc2\x29\x67\x9c\xf3\x8a\xd7\x5c\xf0\x86\xb7\x7c\x66\x2a\x52\xd1\x8a\x55\xbc\xaa\xaa\xba\x12\x55\x53\xb5\xd5\xcc\xd4\xa4\xa6\x35\xab\x79\x5d\xd5\x75\x2d\xea\xa6\x6e\xeb\x99\x11\x44\x50\xc1\x04\x17\x95\xa8\x85\x10\x8d\x68\xc5\xcc\x34\xa4\xa1\x0d\x6b\x78\x53\x35\x75\x23\x9a\xa6\x69\x9b\x99\x69\x49\x4b\x5b\xd6\xf2\xb6\x6a\xeb\x56\xb4\x4d\xdb\xb6\x33\xd3\x91\x8e\x76\xac\xe3\x5d\xd5\xd5\x9d\xe8\x9a\xae\xed\x3a\x3c\x4f\xe5\x34\xd3\x34\x0f\xca\x78\x55\x8b\xa6\xed\x30\xfa\x3b\x00\x00\xff\xff\x6b\x33\x02\xc2\xaf\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\x54\x4b\x6f\x1b\x37\x10\x3e\x8b\xbf\x62\xb2\x45\x51\xad\x25\xef\x83\xfb\x76\xa2\x43\xe1\xa2\xae\x81\xa0\x06\x1a\xe7\x12\xc3\x07\x6a\x77\x56\xa2\xcd\x25\x05\x92\x6b\x35\x0a\xfc\xdf\x0b\x72\xd7\x4e\xd2\xa6\x07\x01\xab\xf9\x66\xbe\x79\x7c\x33\x8c\x63\x58\x6d\x47\x2e\x3a\x78\x30\x84\x1c\x58\xfb\xc8\x76\x08\x96\x0f\x48\x08\x1f\x0e\x4a\x5b\x58\x92\x45\xa0\x47\xe9\x6c\x01\x21\x8b\x60\xc7\xed\x7e\xdc\x46\xad\x1a\xe2\x9d\x3a\xec\x51\x3f\x98\xaf\x1f\x0f\x26\x20\x21\x21\x4f\x4c\xc3\x49\x49\xfc\xa0\x46\xdd\xa2\x81\x0d\xdc\xdd\x1b\xab\xb9\xdc\x7d\x21\x8b\x20\x1e\x8d\x8e\xcd\x9e\x69\x8c\x9d\x13\x97\xbd\x8a\x83\xf5\xf7\x80\xe0\xdb\x1f\x81\xce\x2c\x54\xcb\x04\xc6\xb7\x9f\xbc\x7d\x2e\x2d\xba\xba\xf9\xeb\xe6\xe6\x76\x19\xc2\x0a\x02\xef\xe6\xac\xaf\x14\xd1\x89\x1f\x82\x35\x79\x26\xa4\x1f\x65\x0b\x5c\x72\xfb\xde\xd1\x2c\x43\xf8\x42\x16\x71\x0c\xd7\xd2\x0a\x78\x94\xea\x68\xc0\xee\x11\x24\x1b\x10\x54\xef\xbf\x7d\x3e\x3f\x13\xdf\xd3\x1a\x8c\x7a\xb5\x5b\xae\x24\xb4\x4c\xc2\x16\x3d\x8d\x50\xac\xc3\x0e\x8e\xdc\xee\x81\x09\x01\xdc\x1a\xb0\x9a\x49\xc3\x9d\xa7\x89\xc8\x82\xf7\xc0\x5d\xae\x8b\x0d\x3c\x98\xe8\x4a\xa8\x2d\x13\xd1\x15\xda\x65\xe0\x4a\x08\xc2\xb7\x13\xfc\xc6\xc3\x1f\x65\x87\x3d\x97\xd8\xb9\x2a\x5d\xa8\xaf\xeb\x62\xe3\x7d\xa6\xa8\xdf\x98\xc5\x5b\x3e\xe0\xef\x4a\x0f\xcc\x06\x61\xf4\x27\x1e\x97\x61\x74\xc9\x84\x58\x06\x1a\x8d\x12\x4f\xd8\xdd\x1c\x7c\xfa\x20\x9c\x62\x5c\x2f\x9f\x94\x44\x97\xcd\x33\xfe\x28\x9b\x4b\x77\x5a\x03\x6a\xed\x12\xba\xc6\xde\xcf\x0d\x2f\x5d\x4c\xf4\xc1\xeb\xb9\x0c\xd7\xdf\x2a\x1d\xbe\xf5\x01\x9b\x0d\x48\x2e\x26\x9a\x85\x9f\xdf\x7b\xd5\xc2\x06\xce\x4e\xdf\x59\x22\x9f\x7c\x03\x81\xd7\x22\xf0\x98\x46\x3b\x6a\xe9\x3e\x9f\x89\xff\x3d\x13\x3f\xd9\x1b\xbb\x47\x7d\xe4\x06\xd7\x6e\xf8\x1a\x7f\x31\xf0\x30\x1a\xeb\xfe\x40\x3b\x6a\x8d\xd2\xc2\xc7\xdb\x4b\x50\x7d\x6f\xd0\x46\xe4\x7f\x93\x90\xc5\xc9\x35\xe4\x8a\xfe\xf2\x4c\x16\xdd\x0f\x94\x70\x33\x7d\x99\x24\x59\x4c\x8c\xce\xad\x9b\xc7\xba\x43\xeb\x46\xee\x28\x6e\x3c\x18\x84\xd1\xb5\xb4\xcb\x10\xce\xe0\x3c\x25\x8b\x53\x34\xc7\x6c\xe6\x72\xe0\x0c\xca\xc4\xb7\xf1\x6b\xdb\x2a\xdd\x71\xb9\x03\xab\x60\x6f\xed\xc1\x5c\xc4\xb1\x6d\xb3\x26\x9a\xef\x8a\xab\x18\xdb\x81\xd1\x92\xc6\x3f\x19\x6c\xcf\xed\x9c\x08\xa7\xfb\x59\x7b\x16\xd7\xf4\x0b\x30\x29\xd8\x6b\x35\xc0\x52\xe2\x11\x5c\xf1\xcb\x30\x8c\xac\x72\x35\xbe\xa8\x04\xdc\x00\x93\xc0\x87\x83\xc0\x01\xa5\xf5\x42\x9e\x77\x78\x40\xd9\xa1\xb4\x9e\x55\xa3\x19\x85\x5d\x03\x93\x1d\x70\x09\x57\x4a\xed\x04\xc2\xe5\x5e\xab\x01\xd7\xc0\x2d\xec\xf8\x13\x4e\xf7\xd1\x8f\x42\x7c\x06\xfc\xfb\xc0\xa4\xdb\x77\x5f\x82\x66\x4e\x18\xb0\x7b\x26\x5f\x8b\x64\xdb\xad\xc6\x27\xee\xb3\x45\xde\xfa\x07\xca\x16\xd7\x70\x44\x68\x95\x34\x56\x8f\xad\xfd\x7a\x71\xbe\x0b\xf7\xef\x55\xc5\xd3\xab\x7c\x1f\x6f\x2f\x03\x7f\x3e\x13\x06\xef\x20\xf1\x0b\x36\x7b\xac\x36\x10\x9c\xbb\x15\x7a\x99\xf8\xc6\x4b\xf1\x0c\x28\x0c\xfe\xdb\x71\x15\xb8\xbd\xfa\xc6\xc2\xad\x62\xcb\x39\x32\x86\x32\x09\xc9\x62\xe0\xd2\x69\x3e\x1b\x7f\xf6\x02\xf2\x1e\x9c\xf9\xcd\xe6\xbf\xb9\x2f\x02\x58\x4d\x34\x03\x97\xa1\xa7\x7f\xdd\x40\x2f\x93\x7b\x03\xfd\xd2\x9d\x9e\xdd\x3b\x14\xc7\xde\xdb\xe9\x22\xf8\x23\x82\xb1\xba\x55\xf2\x29\xba\x76\xc6\xed\x68\x41\x49\xf1\x19\x8e\x4a\x3f\x1a\xe8\x95\x86\x27\x26\x46\x34\xee\x4d\xe2\x4e\x1c\xcd\xe4\x0e\xe1\x2e\x59\x37\xcd\x7d\xe4\xc8\xae\x2d\x1c\x98\xe4\xad\x01\xee\x5d\x0c\x28\x47\xd2\x4f\x9e\xd1\xfc\xee\xb9\xfa\x5c\xbc\x0d\x61\xda\x27\xd7\x86\x0f\x78\x07\xe9\xd4\xd3\x74\x80\xd0\xf1\x1d\xb7\xe6\x8e\xc3\x05\xf0\x55\x7a\xef\x1b\x9a\x21\x33\x30\x21\xcc\xb4\x59\x77\xfc\x8c\x3a\x97\x33\xba\xa2\xf7\xae\x2f\xaf\xea\x77\x2e\x4e\xbc\x24\x49\xd2\x84\x26\x59\x92\x27\x45\x52\x26\x55\x52\x27\x4d\x00\x2b\xb2\x08\xd2\x24\x4d\x53\x9a\x66\x69\x9e\x16\x69\x99\x56\x69\x9d\xce\x08\x4d\x68\x4a\x29\xcd\x68\x4e\x0b\x5a\xd2\x8a\xd6\x74\x46\xb2\x24\x4b\x33\x9a\x65\x59\x9e\x15\x59\x99\x55\x59\x9d\xcd\x48\x9e\xe4\x69\x4e\xf3\x2c\xcf\xf3\x22\x2f\xf3\x2a\xaf\xf3\x19\x29\x92\x22\x2d\x68\x91\x15\x79\x51\x14\x65\x51\x15\x75\x31\x23\x65\x52\xa6\x25\x2d\xb3\x32\x2f\x8b\xb2\x2c\xab\xb2\x2e\x67\xa4\x4a\xaa\xb4\xa2\x55\x56\xe5\x55\x51\x95\x55\x55\xd5\xd5\x8c\xd4\x49\x9d\xd6\xb4\xce\xea\xbc\x2e\xea\xb2\xae\xea\xba\x9e\x91\x26\x69\xd2\x86\x36\x59\x93\x37\x45\x53\x36\x55\x53\x37\x4d\x30\x4f\x65\x9a\xa9\x9f\x47\x4a\xb3\xbc\x28\xab\xba\x09\xc8\x3f\x03\x00\xb2\x98\x47\xf8\x84\x07\x00\x00"),
+		},
+		"/src/time/zoneinfo_extend.go": &vfsgen۰CompressedFileInfo{
+			name:             "zoneinfo_extend.go",
+			modTime:          time.Date(2026, 10, 18, 22, 47, 55, 242856537, time.UTC),
+			uncompressedSize: 233,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x4c\x8e\xbd\x4e\xc3\x40\x10\x84\xfb\x7b\x8a\xa1\xe3\x27\x4a\x94\x82\x86\x1e\xd1\xf0\x12\x17\x7b\xcf\x5e\xb8\xec\x44\xbb\x6b\xc4\x8f\x78\x77\x64\x0b\x09\xca\x19\xcd\x37\xfa\x0e\x07\xdc\x9d\x16\xed\x23\x5e\xa2\xfc\x85\xab\x89\xc7\xfd\xf1\xbe\x94\x4b\x1d\x5e\xeb\x24\x48\x3d\x4b\x59\x07\x6a\xd9\x1f\xdf\x53\x6c\xc4\x48\x09\x18\x73\x56\x9b\x1e\x70\x92\x46\x17\x3c\x11\x2b\xb9\x43\xe7\x50\x53\x69\x81\xb9\xbe\x09\x8c\xf0\xa5\x0b\x1a\x1d\x39\xcb\x7a\xf5\x21\xd5\x03\xb5\xa5\x6c\x95\x3a\xd2\xab\x85\x6e\xd4\x0e\xd5\x46\xe4\xcc\x10\x34\xe7\x19\x9f\x34\x51\x6b\x44\xd3\x2e\x81\x48\x5e\x56\xca\x05\x49\xee\x4b\x5b\x6c\xf8\x27\x77\xdd\x71\xfb\xfc\x6b\x70\x83\xaf\xef\xf2\x33\x00\x2e\x25\xad\x8b\xe9\x00\x00\x00"),
+		},
+		"/src/time/zoneinfo_intl.go": &vfsgen۰CompressedFileInfo{
+			name:             "zoneinfo_intl.go",
+			modTime:          time.Date(2026, 10, 18, 22, 48, 0, 976877462, time.UTC),
+			uncompressedSize: 5944,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x58\xfd\x6e\xdb\x38\x12\xff\xdb\x7a\x8a\xa9\x80\x4b\xa4\x5a\x91\x9d\xa4\xd9\x0f\xa7\x2e\x90\xeb\xf6\x8a\x2c\xb6\x7b\x8b\x6b\x8a\x05\x2e\x30\x0a\x5a\xa2\x2d\xc6\x34\xe9\x23\x69\x3b\x76\x37\xef\x7e\x98\x19\xc9\x96\x9d\xec\x62\x83\x00\x51\xc8\xe1\xcc\x70\x3e\x7f\xc3\x5e\x0f\xba\xe3\xa5\xd2\x25\x3c\xf8\x28\x5a\x88\x62\x26\xa6\x12\x82\x9a\xcb\x28\x52\xf3\x85\x75\x01\x92\xa8\x13\x4b\xe7\xac\xf3\x71\x14\x75\xe2\xa9\x0a\xd5\x72\x9c\x17\x76\xde\x9b\xda\x45\x25\xdd\x83\xdf\x7f\x3c\xf8\x38\x4a\xa3\xa8\xd7\x83\xdf\x55\xa8\xec\x32\x80\xdf\xf8\x20\xe7\x50\x08\xad\x7d\x06\x5b\x6b\xa4\x32\x13\x0b\x13\xa5\xa5\x87\x42\x98\xd3\x00\x63\x09\x4e\x8a\x32\x83\xf1\x32\x40\xa8\x24\xdc\x9a\xa0\xe1\xe6\xb7\x5b\xb0\x13\x64\xf5\xb3\x58\x89\xcf\x85\x53\x8b\x00\x33\x63\xd7\x9e\x68\x50\x45\x62\xe7\x73\xb8\x01\x6d\x0b\x11\x94\x35\xa0\x3c\xe0\x75\x02\x4c\x9c\x9d\x13\xe1\x97\xbb\xf7\x60\x27\x13\x2f\x83\x47\x66\xc2\x94\x20\xc6\x63\x27\x57\x8a\x4e\x20\x37\x11\x48\x64\xfe\x93\x08\xf2\x4e\xcd\xe5\xbf\xac\x9b\x8b\x00\x53\xb5\x92\x1e\x26\xd6\x81\x0a\x03\xe4\xe5\x25\x08\x27\xa1\xb0\xf3\x85\x70\xb2\x44\x76\x73\x6b\x42\x05\xe3\x4d\xfd\x41\x52\xcf\x7f\xfc\xbe\x0f\xa1\x72\x76\x39\xad\xe0\xa2\x7f\xf9\x7d\x06\x5a\xcd\x24\xab\xed\x84\xf1\x8a\x05\x2b\x03\x2b\xe9\x3c\xaa\x7d\x8e\xbc\x0e\x8d\x93\x91\xaa\x72\x25\xdd\x06\x8a\x4a\x98\xa9\xc4\xcb\x19\xe1\x9c\x5d\xcb\x12\x4a\xbb\x36\x10\x2c\x31\xf5\xb2\xb0\xa6\xcc\xe1\x3d\x91\xd1\x35\xe9\x52\xa8\xec\xd2\x94\xd6\x48\x58\xab\x50\x29\x03\xa2\xd6\x13\x77\xe6\xca\x7b\x59\xe6\xf0\x41\x14\x15\xf9\x07\xec\x04\x6f\x3b\x17\xe1\xce\xfe\x26\x5c\xf0\x10\xc4\x8c\xd9\x09\x58\x57\x4a\xcb\x0c\xbc\x05\xd1\x52\x67\xa2\x9c\x0f\xa0\xad\x9d\xc9\x12\xcf\xc2\xba\x92\x4e\xb2\x74\x3b\x01\x01\x1b\x29\x1c\x8c\xe5\xc4\x3a\x89\x7c\x16\x4e\x96\xaa\x08\x1e\x54\xc8\xe1\x17\x11\xa4\x23\x0a\xb4\xb2\xd6\x76\x4d\xb7\x71\x4b\x2d\xf1\x30\x5b\x8e\x19\x7e\xb4\xe0\x97\x0b\x0c\x47\x3a\x8a\xac\xfe\x8b\xbe\x87\x75\x1d\x66\x87\x2e\x55\x06\x3e\x98\xa9\x56\xbe\x82\xa9\x0c\x60\xc4\x5c\x7a\xf6\x41\xdc\xed\x5f\x5d\xf6\xe3\x0c\x04\x52\xb5\xad\x9e\x47\x51\x61\x8d\xa7\x78\x57\x26\xe8\xcf\x41\xb8\x00\x43\xe8\xc3\xee\xa7\xd7\x23\xdf\x9e\xf5\xcf\xcf\xfa\xe7\x18\x58\x4c\xf9\xc1\x94\x00\x30\x84\x8b\xf3\x37\x57\x3f\x9e\x7f\xf7\x43\xbf\x8f\x94\x17\xfd\xcb\x1f\x5a\x94\x9c\x15\x48\xfe\x4b\x1d\xab\x18\xfe\x45\x25\x39\x9c\xf5\x6e\xb1\x15\xbe\x18\x94\x68\x01\x55\x54\xe4\x8a\xc6\x0d\x79\xb4\x12\xee\x88\xd7\x10\xe6\x62\x26\x93\xb9\x58\xdc\xfb\xe0\x94\x99\x8e\x5e\x37\x9b\x24\xba\x49\xd1\xc1\x4c\xca\xc5\x99\x75\x6a\xaa\x8c\xd0\xd1\x64\x69\x0a\xd0\x56\x94\x0d\x71\x82\xc6\x02\x66\x81\xee\x5e\xba\x42\x7a\xb8\x1f\xf1\x4a\x0a\xc9\x16\x76\x8c\x33\xf6\xff\x07\xe7\x80\x0a\x44\x0a\xdf\xa2\x8e\x9a\x40\x25\xfc\xe7\x8d\xa7\x94\x4f\x68\x0d\x17\xb7\x2d\xea\x21\x7c\x6d\xf4\xf9\xda\xa8\xf2\xf5\x99\x16\x3b\xf1\xe9\x75\xeb\xe8\x10\x8c\xd2\xc4\xb4\xe3\x64\x58\x3a\x83\x9c\x8d\xd2\x51\xa7\xf3\x14\xe1\x2f\xcb\x92\xce\xc1\x60\x48\x57\xbb\x6d\x19\x8a\x18\xa7\xd7\x20\x0f\x59\x1d\x71\x62\x2e\x2f\xc9\xec\xf5\xe0\x8e\x22\x72\x2e\x36\xe0\x83\xd2\x1a\xc6\x5c\x8c\x7a\x61\x5b\x8a\x20\xf2\x3d\xb7\xbf\x77\x47\xa3\x74\x4a\x12\xeb\x53\x46\xe9\xbd\xa5\xa2\x27\x0a\x9b\x96\x41\xc1\x49\x4e\x83\x75\x25\x43\x25\x1d\xfc\x6a\x4b\x99\x3f\x78\xa4\xe1\x62\xb0\x2f\xb8\x30\xb7\xe5\x12\xc3\x85\xbc\x7c\xe8\x95\xc4\xce\x60\x6c\xad\x26\xff\x38\xf9\xbf\xa5\x72\x12\x0d\xf6\xe0\xf3\x8f\xda\x8e\x85\xce\x3f\xca\x90\xc4\xf5\x4e\x9c\x92\x45\x1a\xba\x21\xd1\x7d\x31\xa5\x9c\x28\x23\xcb\xb6\x09\x27\x42\x7b\x49\xf7\x29\xe5\x44\x3a\x40\xd1\xfb\x20\x70\xb2\xb0\x2b\xe9\x92\x14\x5e\xb5\xfc\x68\x67\x30\x6c\x0e\x92\x13\x93\x74\xa7\x53\x7e\x6b\x56\x76\x26\x93\xd8\xb3\xee\x71\xba\xb3\x54\x70\x4b\x59\x1b\xe8\xc6\x50\x3a\x60\x4d\x00\x75\xd4\x20\xb8\x10\xed\x3a\x84\x08\xe0\xed\x5c\x82\x32\x3e\x08\x13\xa8\x12\x50\xc3\xe1\x72\x8f\xd5\x25\x6c\x16\x72\xcf\xcf\x07\xb7\x2c\x02\x6a\x4a\xa9\x01\x75\x72\x44\x1d\xee\x2a\x48\x88\x5a\xec\x32\xe9\x59\xb8\xc1\x2e\x77\x74\x3b\x77\xe4\x71\xda\x68\xb4\xff\x41\x56\xdf\xe3\xf1\xd1\x35\xe8\xb6\xb9\xea\xdb\xeb\x56\xac\xa2\xfa\xcf\x7d\x87\x8a\xd4\x8e\x23\x8a\xbf\xf0\x1a\x05\x1d\x37\xf9\xfc\x57\xb9\x4e\xe2\xa5\xc1\x56\x6b\x5a\x56\x8c\xa1\x4b\x85\x94\x83\x15\x0b\x10\xf7\x0a\x78\xfd\xe0\xf3\x7f\x8f\x1f\x64\x11\xa2\x4e\xcb\xdb\xcf\xdc\xff\xb2\xff\x81\xba\xcb\x7f\xb0\xa1\x7c\x40\xf9\xc8\x15\x9e\x49\xf7\x78\xbe\x43\x49\xfb\xf7\xd5\xa4\x58\xe2\x60\xea\xd4\xba\xb2\x7d\xd9\x3c\x87\xdd\x3e\x4e\x99\xa3\x34\x67\x5f\x3e\xc7\x19\x5a\xea\x13\x69\x1d\x23\x6f\x0c\x84\x78\x00\xf8\x43\x59\x4b\x1b\x95\x5d\xba\xf7\x9b\x42\xd7\x3b\x71\x75\x71\x19\xf3\x0e\xf6\xb4\x9a\x9c\x76\xcc\x72\x2e\x9d\x2a\xea\x5d\x6a\xc1\xf1\xe0\x4f\x76\x4b\xb1\x69\x1d\x3d\xde\x45\x99\x7f\xc1\x59\x99\x65\x90\xf1\xe0\xe5\x5d\x86\x09\x7f\xb6\xdb\x5c\xf3\x57\x31\x47\x0e\xb1\xaf\xac\x0b\xb4\xf7\x94\xd6\x29\xa9\x26\x14\xb4\xcf\x63\xb1\x09\x1f\x0c\x8d\xa8\xa3\x61\x08\x27\x4d\x0c\x7f\x43\x7b\x0d\xc8\x6a\x4f\x51\x07\x5d\x74\x13\x30\x56\x29\x30\xbc\x2c\xd0\x21\xdf\xbd\x49\xf7\xe9\xd6\x62\xdb\xac\xdd\x84\x84\xfd\x97\x21\xd4\xe1\x00\xf4\xa1\xf4\x30\x68\x75\x3d\x65\xc2\x48\x99\x90\x46\x1d\x51\x96\x3b\x09\xeb\x4a\x1a\x16\x91\xc1\x76\xc7\x30\x6d\x6a\x39\x25\x7e\x69\xa5\x47\x1c\x1a\xa4\xd6\x50\x8a\x8d\x56\xd3\x2a\x80\x17\x2b\x65\xa6\x14\x59\x04\x7b\x54\x38\x65\x40\x44\xb0\x6b\x2c\x41\x98\x0d\x33\xa9\xeb\x80\x18\xdb\x95\xac\x9b\xf9\x5a\x3a\xa8\x4b\xcf\xcf\xc2\x2c\x85\xdb\x10\x9a\xfb\x79\xa9\x37\xd8\x20\x08\x15\x0d\x86\x84\x26\xb8\x30\x92\x9e\xaf\x86\x20\xf4\xa2\x12\x9c\x2e\x48\x94\xc1\xd7\x0c\xbe\xc2\x10\xbe\x18\xf5\x48\x97\xc9\xa0\x9f\xe6\x5f\xee\xde\x27\x29\xc1\x55\x0a\x6e\x0c\x73\x1f\xca\x0c\xec\x0c\xb9\xa2\x69\xee\xf1\xf4\x88\x79\xbf\xb2\x33\xe6\xe8\x43\x09\x43\x60\x1f\x24\x74\x9a\x65\xd4\x2a\x66\x70\x9e\x41\xbf\xf5\xfb\xe5\xee\x7d\x9a\x93\xe4\x34\xcd\xf9\x96\x75\x1e\x5b\x18\xbc\xc8\x68\xa9\xff\x16\x97\x6b\xb0\xf0\x16\xf5\x64\xbd\x6a\xc5\x6c\x93\xb2\x9d\xfd\x0d\x80\xae\x53\xdf\x71\x6b\x1a\xb1\x75\x54\xa1\x3f\x6f\x08\xf9\x25\xdb\x9c\xb2\x3e\xab\xbd\x31\x80\x6d\x2d\x2b\x03\xe5\x7f\xfa\x7c\xb7\x5f\x80\x77\xc8\x12\xf9\x29\x64\xd7\xe7\xea\x00\x0a\xde\x82\x96\x26\xd1\xf9\x96\x22\xe4\xe4\x04\xf8\xf3\x5e\x8d\xd0\x33\x5b\x53\x57\xb1\x6e\xb7\x56\x07\xeb\x2a\x16\xd5\xf6\x29\x22\xe1\x7f\x60\x08\x62\xb1\x90\xa6\xac\x37\x33\xd8\x9a\xc6\x5b\x3a\x0f\x8f\xed\xfd\xf0\xc8\xb3\xd1\x1d\x8e\x07\xdf\xd0\xcf\x03\x60\x6f\x2b\x53\xca\xc7\x01\x2c\x95\x09\x3f\x24\x2a\x7d\xe2\xe8\x5f\x38\xb9\x6a\xb9\x60\x87\x57\x39\xfa\x13\x8a\xa2\x0c\xd1\xf6\x2a\x8d\xe8\x76\xa1\xee\x2d\xdf\xbd\x69\x11\x5f\x43\x80\xb7\x50\x43\xd8\x6b\xd2\x5d\x1a\xca\x1d\x72\x57\x68\xc5\xda\x4d\x59\x92\x9f\xfb\xec\xde\xc6\xa1\xc7\x88\x68\x2c\x61\x6e\x79\x00\x30\x94\x00\xcd\xa8\x60\x28\x31\xa8\xf2\xe5\xb5\xc1\x8d\x7c\x0c\xad\x3b\x48\x53\xa6\xd7\xbc\xf8\x6a\x48\xaa\xb3\x46\x9d\xc0\x17\xa9\x6b\x37\xcf\x38\x09\x1f\xca\x80\x4d\x87\xfb\x19\x84\x0c\xa4\x29\x33\xe2\x41\xe5\x1f\x4d\x11\x76\x66\x20\xc3\x07\x18\x22\xd1\xae\x6d\x7e\x78\x0c\xe4\x81\x94\xff\x3d\xea\xbc\x30\x04\x1d\x1d\x35\xdc\xa7\x1d\x90\x67\x55\x80\xb7\x19\x74\xf0\x3c\x54\x43\x0b\xbc\x75\xa2\x6d\x06\x95\x1a\x81\x08\x35\x90\x67\xcd\x19\x6d\xf0\x40\x6a\x09\xc7\xe1\x3a\xdb\x8d\x6f\x83\xf5\x82\xe6\x28\xdc\xc8\xe1\xb6\x3e\xc2\x16\x12\x01\x2a\x95\xc3\x27\xeb\x43\xdd\x1f\x7b\xbd\xc6\xd6\x96\x6d\xed\x11\x79\xac\xa5\x9c\x95\x62\x53\x8f\x91\x9c\xa4\x9e\x87\x46\xa6\xf6\x10\x1e\xb1\x4a\xe1\xca\xd1\xac\x26\xd0\x8f\x4e\xc9\x12\xae\x2e\x48\x9b\xab\x4b\xe2\xe7\x41\xd3\xe0\xc6\x94\x30\x56\x5e\x16\x41\x99\x69\x0d\x33\x9f\x39\x89\x0b\xf1\x51\x99\xcf\x50\xee\xfd\x68\x17\xf3\xb5\x8f\xf7\xdb\x6c\xb7\xa6\x74\xd3\xa5\xf7\xd5\x3b\xa9\x97\x0f\xea\x39\x27\xf1\x80\xf3\x31\x3c\xa6\x70\x06\xe7\xd7\xa0\xe0\x1d\xf4\x31\x99\xc3\xe3\xbd\x1a\xe5\x54\x67\xdf\xe1\x5c\x70\x76\x75\xf9\x9a\x3b\xa2\xff\x4d\xba\xdf\xa5\x9c\x5d\x83\x3a\x3b\xa3\x98\x43\x56\x5f\xb3\xfa\xb6\x83\x21\x38\x32\xec\x7d\x9e\xe7\x23\x92\xfc\xed\xea\x22\x83\xab\xcb\x27\x22\xee\x10\xcf\xc1\xb0\x2d\xa1\xcb\x67\x8f\x04\xd4\xc5\x53\x63\xf1\x23\xb2\x93\x13\xfe\xfb\x76\x88\x77\x3d\x39\x69\x72\x01\x17\xcf\xce\x53\x2c\x2e\x64\x16\x12\x43\x83\x4d\x2b\x61\x90\x28\xbd\x86\x2d\xbc\x3a\xa0\x6a\xc2\x95\xcb\xc7\x96\xd6\x9e\x76\x60\x88\x02\x1f\xaf\x57\xa9\x33\x6d\xe1\x1d\x9c\xd3\xb1\xb9\x2a\x79\x5a\x82\x2e\x24\xb4\x95\xf6\x2e\xa2\x67\x22\xe7\xaa\x24\x89\x07\x7a\x69\x8b\xc3\xa7\xa2\x22\x0d\x52\x7b\x6e\xe1\x9d\x4a\xd5\x6e\xa3\x4d\xd6\xe4\xa9\x3d\xe8\x34\x04\xad\x8c\xe2\x66\x7f\x90\x51\x7b\x64\x47\xc9\x30\x39\x7e\x9a\xa1\x27\x1e\xa3\x1e\x99\xd0\xcb\xa2\x15\x86\x07\xd8\xa1\x85\x53\x09\x46\xbc\x04\x3c\x16\xf4\xea\x81\xd8\x81\x8e\xe4\xef\x85\xd6\x49\x7c\xf0\x26\x12\xd3\xe1\xd7\xe7\xfd\x7e\x3f\x65\x18\xcc\x59\x45\xa5\x2d\x43\xf8\x90\x01\x82\xb4\x0c\x18\x8c\x65\xf5\xeb\x0c\xca\x61\xfa\xd6\x48\xd0\x0a\xda\xfe\x35\x75\x20\xd2\x20\xff\x45\x9a\x69\xa8\x92\xf4\x1a\x54\xb7\x4b\xe6\x5c\x20\x0d\x6f\xde\x62\x47\x48\x14\x56\x34\xea\x01\x0b\x86\xb3\x2b\xa1\x97\x34\xa7\x75\xfc\x5a\x85\xa2\x6a\xd6\x71\x98\x89\xd3\xfc\x33\x09\xac\xa1\x78\x21\xbc\x84\x1a\xa5\x36\x50\x03\x86\xb0\xca\x6f\x4d\x48\xd2\x1d\x41\x0d\x54\x91\x82\x3e\x5f\x20\x21\xb4\x8a\x04\x58\x65\x9e\x6f\x33\x5c\xa5\x70\xb0\xcb\x96\x08\xf8\x07\x5c\xbc\x41\xe8\x4f\xa3\xd8\xd8\xd9\xb5\x97\xce\x93\x5b\x71\x43\x04\x8c\x19\x83\x38\x6c\xaf\x4c\x8d\x6d\x49\x1b\xfa\x7e\x41\x5e\x03\x71\x91\x88\xbf\x5f\x20\x3a\x44\xba\x48\x4a\x2e\x41\xc2\xc6\x4a\xbb\x58\xc5\xb1\x91\x66\xab\x16\xca\xf9\x84\xb6\x48\xc8\x22\xe9\x5f\x78\xfc\x18\xfc\x44\xc7\xb0\xb6\x05\x8d\xf7\xb0\x45\x99\x90\xb0\xd0\x33\x02\xbb\x4f\xad\xf4\x60\xa8\x03\x9c\x1c\xed\x47\xad\x8f\x9f\xee\xba\x57\x03\x7a\xd7\xe2\x26\xd3\x1a\x68\x31\xc2\xb6\xed\xb7\x32\xaa\xed\xed\xe7\x32\xaa\xa3\xf6\x85\x57\xb2\x56\x2a\xd5\x28\xeb\x60\x9c\xe5\xbf\xcd\xf0\x2a\xeb\xb7\x15\x78\x0b\x57\xf0\xc7\x1f\xc4\xee\x7e\x70\x49\xd8\x09\x15\x8c\x77\x8b\xbc\x76\xda\x3d\xc5\x8a\xd7\x5e\x39\x3b\x3d\x18\x2a\xc4\x9c\x1f\x12\xaa\x0c\xe6\xe8\x02\x22\x7d\x33\x18\x65\x10\xc7\x11\xa3\x2f\x02\x35\xa5\x7c\xfc\xe7\x26\xc8\xa4\xca\xe0\x74\x70\x9a\x52\xcd\xc7\x77\x3b\xe4\x45\x67\x87\x50\xdd\x0f\xd4\x28\x83\xea\x5e\x75\xcf\x07\xa3\xe6\x81\x07\x75\xae\xa8\xca\x72\x15\xc4\x00\x8f\xfb\x38\x41\x56\x07\x2f\x32\xa4\xe2\xe0\xcd\x08\x37\xa0\x0b\xf3\xe8\x29\xfa\xff\x00\xb1\x94\x22\xe9\x38\x17\x00\x00"),
+		},
+		"/src/time/zoneinfo_intl_test.go": &vfsgen۰CompressedFileInfo{
+			name:             "zoneinfo_intl_test.go",
+			modTime:          time.Date(2026, 10, 18, 21, 23, 7, 814373484, time.UTC),
+			uncompressedSize: 1023,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x93\x51\x6f\xd3\x30\x14\x85\x9f\xed\x5f\x71\xb0\x54\x29\x49\x4d\x9a\x6d\xb4\xc0\x50\x1f\x10\x1b\xd2\x06\xec\x65\xe5\x01\x10\x9a\x4c\xea\x74\xa6\xa9\x3d\x39\x37\xab\x46\x97\xff\x8e\xec\xb4\x30\x90\x36\x90\x16\x25\x96\x1d\x1d\x7f\xf7\xc4\xf7\x64\x34\xc2\xf0\x5b\x6b\xea\x39\xbe\x37\x9c\x5f\xa9\x72\xa9\x16\x1a\x64\x56\x9a\x73\xb3\xba\x72\x9e\x90\x70\x26\x48\x37\x64\xec\x42\xf0\x94\xf3\xaa\xb5\x25\x66\xba\xa1\x13\x4b\xf5\x7b\x57\x2a\x32\xce\x26\x84\x6c\x2b\xca\x67\x29\x36\x9c\xd9\x1b\x09\xed\x3d\x0e\xa7\xa8\x9d\x9a\xff\x21\x16\xaf\x57\xda\x9b\x52\x8d\xce\xf4\xfa\xe2\x93\xf3\x4b\x91\x72\x66\xaa\xa8\x7f\x32\x85\x35\x75\x20\x30\xca\xdf\x2a\x52\x75\xa2\xbd\x4f\x39\xeb\x38\x5b\xba\x7a\xa9\x48\x3d\x04\x6e\x8c\x1a\xbd\xeb\x65\xff\x09\xad\x9c\xc7\x85\x04\x51\x20\x7a\x65\x17\x1a\x5f\xbe\x36\xe4\xdb\x92\xfa\x0d\x88\xd7\x2c\x9c\x09\x63\xb5\x2b\xc3\x2a\xdb\x95\xe4\x8c\x59\xb5\xd2\x00\x1a\xf2\xc6\x2e\x38\x63\xae\xaa\x1a\x4d\x30\x96\x38\xeb\x02\x61\x73\xa4\x48\x27\xfb\xc5\x7e\x21\x71\xaa\x6c\xab\xfc\x8d\xc4\xde\x58\x62\x6f\x5f\xa2\xd8\xdd\x1f\x67\x6f\x52\x89\x70\x6c\xe2\xf8\x7c\x26\x24\x9e\x8e\x91\x61\x52\xc4\xa1\x93\x7f\x71\x3e\x28\x5f\x5e\x4a\xbc\x90\x98\x48\x8c\x5f\xf6\xcf\x23\x28\xcf\xef\x71\x72\x14\x19\xcf\x1e\x60\x9c\xb6\xf5\xbf\x3e\xe7\x91\x90\x5f\x6d\x17\xc3\x62\x7c\x50\x08\x89\x71\x36\x29\xb2\x49\x81\x21\x0e\x8a\xac\xc7\x75\xd8\x6c\x7b\x21\xb1\xed\xc0\xe1\x14\x44\x39\xe5\x27\x36\x21\xca\x6b\x57\xa6\xf9\x67\x67\x75\x92\x72\x16\x82\x11\xb4\x21\x19\x44\x79\x9c\xde\xde\xee\x36\xf6\x2f\xb7\x8b\x80\x65\x94\x1f\x7b\xef\x7c\x95\x88\xc1\x35\x8c\xc5\xe0\xfa\x10\x0b\x47\xf8\xe1\xac\xc6\xa0\x19\x0c\xe7\x12\x6b\x65\xa9\x9f\x0b\x19\x0b\xc7\xb1\x76\xa5\xc4\x5d\x5b\x72\x57\x50\xfe\x2e\x12\x1c\x75\x31\x8d\xa6\x0a\x61\xbc\x37\xdf\x67\x6e\x7d\xa9\xbd\x1e\x9d\x5f\xe9\xd2\xa8\x5a\xa4\xaf\xa2\x76\x7a\x37\xdf\x3b\xa7\x61\xb7\x9e\x43\x59\xb4\x76\x69\xdd\xda\xc6\xff\x3a\x5a\x16\x31\xfa\x1d\xff\x39\x00\x5b\x59\x22\x8f\xff\x03\x00\x00"),
 		},
 		"/src/unicode": &vfsgen۰DirInfo{
 			name:    "unicode",
@@ -1201,6 +1286,7 @@ var FS = func() http.FileSystem {
 	}
 	fs["/src/internal/syscall/unix"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/internal/syscall/unix/unix.go"].(os.FileInfo),
+		fs["/src/internal/syscall/unix/vfs_unix.go"].(os.FileInfo),
 	}
 	fs["/src/internal/testenv"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/internal/testenv/testenv.go"].(os.FileInfo),
@@ -1243,10 +1329,14 @@ var FS = func() http.FileSystem {
 		fs["/src/net/http/cookiejar/example_test.go"].(os.FileInfo),
 	}
 	fs["/src/os"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/src/os/exec"].(os.FileInfo),
 		fs["/src/os/go116_os.go"].(os.FileInfo),
 		fs["/src/os/os.go"].(os.FileInfo),
 		fs["/src/os/signal"].(os.FileInfo),
 	}
+	fs["/src/os/exec"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/src/os/exec/exec.go"].(os.FileInfo),
+	}
 	fs["/src/os/signal"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/os/signal/signal.go"].(os.FileInfo),
 	}
@@ -1270,6 +1360,7 @@ var FS = func() http.FileSystem {
 	}
 	fs["/src/runtime"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/runtime/debug"].(os.FileInfo),
+		fs["/src/runtime/deterministic.go"].(os.FileInfo),
 		fs["/src/runtime/go114_runtime.go"].(os.FileInfo),
 		fs["/src/runtime/pprof"].(os.FileInfo),
 		fs["/src/runtime/runtime.go"].(os.FileInfo),
@@ -1300,14 +1391,17 @@ var FS = func() http.FileSystem {
 		fs["/src/sync/atomic/atomic_test.go"].(os.FileInfo),
 	}
 	fs["/src/syscall"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/src/syscall/exec_unix.go"].(os.FileInfo),
 		fs["/src/syscall/go116_syscall_darwin.go"].(os.FileInfo),
 		fs["/src/syscall/js"].(os.FileInfo),
+		fs["/src/syscall/pipe_unix.go"].(os.FileInfo),
 		fs["/src/syscall/syscall.go"].(os.FileInfo),
 		fs["/src/syscall/syscall_darwin.go"].(os.FileInfo),
 		fs["/src/syscall/syscall_linux.go"].(os.FileInfo),
 		fs["/src/syscall/syscall_nonlinux.go"].(os.FileInfo),
 		fs["/src/syscall/syscall_unix.go"].(os.FileInfo),
 		fs["/src/syscall/syscall_windows.go"].(os.FileInfo),
+		fs["/src/syscall/vfs_unix.go"].(os.FileInfo),
 	}
 	fs["/src/syscall/js"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/syscall/js/go112_js.go"].(os.FileInfo),
@@ -1332,11 +1426,16 @@ var FS = func() http.FileSystem {
 	fs["/src/time"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/time/go114_timer.go"].(os.FileInfo),
 		fs["/src/time/go115_timer.go"].(os.FileInfo),
+		fs["/src/time/go115_zoneinfo_extend.go"].(os.FileInfo),
+		fs["/src/time/go115_zoneinfo_extend_test.go"].(os.FileInfo),
 		fs["/src/time/internal_test.go"].(os.FileInfo),
 		fs["/src/time/time.go"].(os.FileInfo),
 		fs["/src/time/time_test.go"].(os.FileInfo),
 		fs["/src/time/timer.go"].(os.FileInfo),
 		fs["/src/time/zoneinfo.go"].(os.FileInfo),
+		fs["/src/time/zoneinfo_extend.go"].(os.FileInfo),
+		fs["/src/time/zoneinfo_intl.go"].(os.FileInfo),
+		fs["/src/time/zoneinfo_intl_test.go"].(os.FileInfo),
 	}
 	fs["/src/unicode"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/src/unicode/unicode.go"].(os.FileInfo),
@@ -0,0 +1,53 @@
+// +build js,!windows
+
+package unix
+
+import (
+	"syscall"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// os.RemoveAll uses the *at calls below, which go to the in-memory file system
+// of package github.com/gopherjs/gopherjs/js/vfs once it is enabled, like the
+// calls of package syscall.
+
+// fileSystem is the part of the in-memory file system that is used here.
+type fileSystem interface {
+	Openat(dirfd int, path string, flags int, perm uint32) (int, error)
+	Fstatat(dirfd int, path string, st *syscall.Stat_t) error
+	Unlinkat(dirfd int, path string, dir bool) error
+}
+
+// vfs returns the in-memory file system, or nil if it isn't enabled.
+func vfs() fileSystem {
+	fs := js.Global.Get("$vfs")
+	if fs == js.Undefined {
+		return nil
+	}
+	return fs.Interface().(fileSystem)
+}
+
+//gopherjs:keep-original
+func Openat(dirfd int, path string, flags int, perm uint32) (int, error) {
+	if fs := vfs(); fs != nil {
+		return fs.Openat(dirfd, path, flags, perm)
+	}
+	return _gopherjs_original_Openat(dirfd, path, flags, perm)
+}
+
+//gopherjs:keep-original
+func Fstatat(dirfd int, path string, stat *syscall.Stat_t, flags int) error {
+	if fs := vfs(); fs != nil {
+		return fs.Fstatat(dirfd, path, stat)
+	}
+	return _gopherjs_original_Fstatat(dirfd, path, stat, flags)
+}
+
+//gopherjs:keep-original
+func Unlinkat(dirfd int, path string, flags int) error {
+	if fs := vfs(); fs != nil {
+		return fs.Unlinkat(dirfd, path, flags&AT_REMOVEDIR != 0)
+	}
+	return _gopherjs_original_Unlinkat(dirfd, path, flags)
+}
@@ -20,29 +20,15 @@ type streamReader struct {
 
 func (r *streamReader) Read(p []byte) (n int, err error) {
 	if len(r.pending) == 0 {
-		var (
-			bCh   = make(chan []byte)
-			errCh = make(chan error)
-		)
-		r.stream.Call("read").Call("then",
-			func(result *js.Object) {
-				if result.Get("done").Bool() {
-					errCh <- io.EOF
-					return
-				}
-				bCh <- result.Get("value").Interface().([]byte)
-			},
-			func(reason *js.Object) {
-				// Assumes it's a DOMException.
-				errCh <- errors.New(reason.Get("message").String())
-			},
-		)
-		select {
-		case b := <-bCh:
-			r.pending = b
-		case err := <-errCh:
-			return 0, err
+		result, err := js.Await(r.stream.Call("read"))
+		if err != nil {
+			// Assumes it's a DOMException.
+			return 0, errors.New(err.(*js.Error).Get("message").String())
+		}
+		if result.Get("done").Bool() {
+			return 0, io.EOF
 		}
+		r.pending = result.Get("value").Interface().([]byte)
 	}
 	n = copy(p, r.pending)
 	r.pending = r.pending[n:]
@@ -0,0 +1,31 @@
+// +build js,!windows
+
+package exec
+
+import (
+	"os"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// findExecutable asks Node.js for the mode of file, since os.Stat only works
+// with the system call module.
+func findExecutable(file string) (err error) {
+	require := js.Global.Get("require")
+	if require == js.Undefined {
+		return os.ErrNotExist
+	}
+	defer func() {
+		if e := recover(); e != nil {
+			if _, ok := e.(*js.Error); !ok {
+				panic(e)
+			}
+			err = os.ErrNotExist
+		}
+	}()
+	st := require.Invoke("fs").Call("statSync", file)
+	if !st.Call("isDirectory").Bool() && st.Get("mode").Int()&0111 != 0 {
+		return nil
+	}
+	return os.ErrPermission
+}
@@ -33,3 +33,10 @@ func runtime_beforeExit() {}
 func executable() (string, error) {
 	return "", errors.New("Executable not implemented for GOARCH=js")
 }
+
+// blockUntilWaitable returns false, since waitid would block the JavaScript
+// thread and Node.js reaps child processes itself; syscall.Wait4 waits for
+// them instead.
+func (p *Process) blockUntilWaitable() (bool, error) {
+	return false, nil
+}
@@ -2,11 +2,131 @@
 
 package signal
 
-// Package signal is not implemented for GOARCH=js.
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
 
-func signal_disable(uint32) {}
-func signal_enable(uint32)  {}
-func signal_ignore(uint32)  {}
-func signal_recv() uint32   { return 0 }
+// In Node.js, signals are delivered to listeners on the process object, so a
+// listener is added for every signal that is notified or ignored. That also
+// keeps Node.js from taking the default action, which is restored by removing
+// the listener again. For SIGQUIT, the default action is the listener of the
+// runtime that dumps the goroutines, which is removed and added back the same
+// way. Signals that arrive are queued until the goroutine that runs loop hands
+// them over to the channels. Elsewhere, no signals arrive.
 
-func loop() {}
+var (
+	listeners = make(map[uint32]func())
+	ignored   = make(map[uint32]bool)
+	pending   []uint32
+	waiting   bool          // whether loop waits for a signal
+	arrived   chan struct{} // closed when a signal arrives
+	idle      chan struct{} // closed when loop starts waiting
+)
+
+func wait(ch *chan struct{}) {
+	if *ch == nil {
+		*ch = make(chan struct{})
+	}
+	<-*ch
+}
+
+func broadcast(ch *chan struct{}) {
+	if *ch != nil {
+		close(*ch)
+		*ch = nil
+	}
+}
+
+// signalName returns the name of sig that Node.js uses, or "" if it can't
+// listen to sig.
+func signalName(sig uint32) string {
+	require := js.Global.Get("require")
+	if require == js.Undefined || js.Global.Get("process") == js.Undefined {
+		return ""
+	}
+	signals := require.Invoke("os").Get("constants").Get("signals")
+	names := js.Global.Get("Object").Call("keys", signals)
+	for i := 0; i < names.Length(); i++ {
+		if name := names.Index(i).String(); signals.Get(name).Int() == int(sig) {
+			return name
+		}
+	}
+	return ""
+}
+
+// listen replaces the listener for sig. A nil listener restores the default
+// action.
+func listen(sig uint32, listener func()) {
+	name := signalName(sig)
+	if name == "" {
+		return
+	}
+	process := js.Global.Get("process")
+	quit := js.Undefined
+	if name == "SIGQUIT" {
+		quit = js.Global.Get("$quitListener")
+	}
+	if old := listeners[sig]; old != nil {
+		process.Call("removeListener", name, old)
+		delete(listeners, sig)
+		if quit != js.Undefined {
+			process.Call("on", name, quit)
+		}
+	}
+	if listener == nil {
+		return
+	}
+	defer func() {
+		// Node.js refuses to listen to SIGKILL and SIGSTOP.
+		if err := recover(); err != nil {
+			if _, ok := err.(*js.Error); !ok {
+				panic(err)
+			}
+		}
+	}()
+	process.Call("on", name, listener)
+	listeners[sig] = listener
+	if quit != js.Undefined {
+		process.Call("removeListener", name, quit)
+	}
+}
+
+func signal_enable(sig uint32) {
+	delete(ignored, sig)
+	listen(sig, func() {
+		pending = append(pending, sig)
+		broadcast(&arrived)
+	})
+}
+
+func signal_disable(sig uint32) {
+	delete(ignored, sig)
+	listen(sig, nil)
+}
+
+func signal_ignore(sig uint32) {
+	ignored[sig] = true
+	listen(sig, func() {})
+}
+
+func signal_ignored(sig uint32) bool {
+	return ignored[sig]
+}
+
+func signal_recv() uint32 {
+	for len(pending) == 0 {
+		waiting = true
+		broadcast(&idle)
+		wait(&arrived)
+		waiting = false
+	}
+	sig := pending[0]
+	pending = pending[1:]
+	return sig
+}
+
+func signalWaitUntilIdle() {
+	for !waiting || len(pending) != 0 {
+		wait(&idle)
+	}
+}
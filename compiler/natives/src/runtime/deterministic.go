@@ -0,0 +1,17 @@
+// +build js
+// +build gopherjs_deterministic
+
+package runtime
+
+import "github.com/gopherjs/gopherjs/js"
+
+// Programs built with the gopherjs_deterministic tag (e.g. by "gopherjs test --deterministic")
+// schedule goroutines deterministically and run timers on a virtual clock. The seed is taken
+// from the GOPHERJS_SEED environment variable, or chosen randomly and printed.
+func init() {
+	seed := js.Undefined
+	if process := js.Global.Get("process"); process != js.Undefined {
+		seed = process.Get("env").Get("GOPHERJS_SEED")
+	}
+	js.Global.Call("$enableDeterministic", js.Global.Call("parseInt", seed, 10))
+}
@@ -151,5 +151,5 @@ func extendRandom(r []byte, n int) {
 }
 
 func nanotime() int64 {
-	return js.Global.Get("Date").New().Call("getTime").Int64() * 1e6
+	return js.Global.Call("$nanotime").Int64()
 }
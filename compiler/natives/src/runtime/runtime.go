@@ -45,6 +45,16 @@ func init() {
 	js.Global.Set("$jsObjectPtr", jsPkg.Get("Object").Get("ptr"))
 	js.Global.Set("$jsErrorPtr", jsPkg.Get("Error").Get("ptr"))
 	js.Global.Set("$throwRuntimeError", js.InternalObject(throw))
+	// Like the Go runtime, dump all goroutines and exit on SIGQUIT. os/signal
+	// removes the listener while SIGQUIT is notified or ignored.
+	if process := js.Global.Get("process"); process != js.Undefined && process.Get("platform").String() != "win32" {
+		quit := js.InternalObject(func() {
+			js.Global.Get("console").Call("error", "SIGQUIT: quit\n\n"+js.Global.Call("$goroutineDump", true).String())
+			process.Call("exit", 2)
+		})
+		js.Global.Set("$quitListener", quit)
+		process.Call("on", "SIGQUIT", quit)
+	}
 	// avoid dead code elimination
 	var e error
 	e = &TypeAssertionError{}
@@ -204,11 +214,7 @@ func SetMutexProfileFraction(rate int) int {
 }
 
 func Stack(buf []byte, all bool) int {
-	s := js.Global.Get("Error").New().Get("stack")
-	if s == js.Undefined {
-		return 0
-	}
-	return copy(buf, s.Call("substr", s.Call("indexOf", "\n").Int()+1).String())
+	return copy(buf, js.Global.Call("$goroutineDump", all).String())
 }
 
 func LockOSThread() {}
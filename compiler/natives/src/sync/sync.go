@@ -33,6 +33,7 @@ func runtime_SemacquireMutex(s *uint32, lifo bool) {
 		} else {
 			semWaiters[s] = append(semWaiters[s], ch)
 		}
+		js.Global.Call("$waitReason", "semacquire")
 		<-ch
 		semAwoken[s] -= 1
 		if semAwoken[s] == 0 {
@@ -71,8 +72,7 @@ func runtime_canSpin(i int) bool {
 
 // Copy of time.runtimeNano.
 func runtime_nanotime() int64 {
-	const millisecond = 1000000
-	return js.Global.Get("Date").New().Call("getTime").Int64() * millisecond
+	return js.Global.Call("$nanotime").Int64()
 }
 
 // Implemented in runtime.
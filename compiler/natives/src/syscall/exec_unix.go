@@ -0,0 +1,214 @@
+// +build js,!windows
+
+package syscall
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// Node.js can't fork, so child processes are started with its child_process
+// module instead. Node.js reaps them itself; Wait4 waits for the exit event.
+
+// A jsProcess is a child process started by forkExec.
+type jsProcess struct {
+	exited chan struct{} // closed when the process has exited
+	status WaitStatus
+}
+
+var jsProcesses = make(map[int]*jsProcess)
+
+// nodeModule returns the Node.js module with the given name, or nil when not
+// running in Node.js.
+func nodeModule(name string) *js.Object {
+	require := js.Global.Get("require")
+	if require == js.Undefined {
+		return nil
+	}
+	return require.Invoke(name)
+}
+
+// jsErrno returns the Errno of a Node.js system error.
+func jsErrno(err *js.Object) Errno {
+	if os := nodeModule("os"); os != nil {
+		if n := os.Get("constants").Get("errno").Get(err.Get("code").String()); n != js.Undefined {
+			return Errno(n.Int())
+		}
+	}
+	return EINVAL
+}
+
+// catchJSError calls f and returns the Errno of the error it throws, if any.
+func catchJSError(f func()) (err error) {
+	defer func() {
+		e := recover()
+		if e == nil {
+			return
+		}
+		jsErr, ok := e.(*js.Error)
+		if !ok {
+			panic(e)
+		}
+		err = jsErrno(jsErr.Object)
+	}()
+	f()
+	return nil
+}
+
+func forkExec(argv0 string, argv []string, attr *ProcAttr) (pid int, err error) {
+	childProcess := nodeModule("child_process")
+	if childProcess == nil {
+		return 0, ENOSYS
+	}
+	if attr == nil {
+		attr = &zeroProcAttr
+	}
+
+	stdio := js.Global.Get("Array").New()
+	for i, fd := range attr.Files {
+		switch f := jsFiles[int(fd)]; {
+		case fd == ^uintptr(0), f != nil && f.pipe == nil:
+			stdio.SetIndex(i, "ignore")
+		case f != nil:
+			stdio.SetIndex(i, "pipe")
+		default:
+			stdio.SetIndex(i, fd) // a real file descriptor is passed on as is
+		}
+	}
+	for i := len(attr.Files); i < 3; i++ {
+		stdio.SetIndex(i, "ignore") // Node.js would create pipes for them
+	}
+	env := attr.Env
+	if env == nil {
+		env = Environ()
+	}
+	jsEnv := js.Global.Get("Object").New()
+	for _, kv := range env {
+		if i := indexByte([]byte(kv), '='); i > 0 {
+			jsEnv.Set(kv[:i], kv[i+1:])
+		}
+	}
+	opts := js.M{"stdio": stdio, "env": jsEnv}
+	if len(argv) > 0 {
+		opts["argv0"] = argv[0]
+		argv = argv[1:]
+	}
+	if attr.Dir != "" {
+		opts["cwd"] = attr.Dir
+	}
+	if sys := attr.Sys; sys != nil {
+		opts["detached"] = sys.Setsid
+		if c := sys.Credential; c != nil {
+			opts["uid"] = c.Uid
+			opts["gid"] = c.Gid
+		}
+	}
+
+	var proc *js.Object
+	if err := catchJSError(func() {
+		proc = childProcess.Call("spawn", argv0, argv, opts)
+	}); err != nil {
+		return 0, err
+	}
+	// Errors like a missing executable are only reported by an event.
+	failed := make(chan Errno, 1)
+	proc.Call("on", "error", func(err *js.Object) {
+		select {
+		case failed <- jsErrno(err):
+		default:
+		}
+	})
+	if proc.Get("pid") == js.Undefined {
+		return 0, <-failed
+	}
+
+	p := &jsProcess{exited: make(chan struct{})}
+	proc.Call("on", "exit", func(code, signal *js.Object) {
+		if signal != nil {
+			p.status = WaitStatus(nodeModule("os").Get("constants").Get("signals").Get(signal.String()).Int())
+		} else {
+			p.status = WaitStatus(code.Int() << 8)
+		}
+		close(p.exited)
+	})
+	for i, fd := range attr.Files {
+		if f := jsFiles[int(fd)]; f != nil && f.pipe != nil {
+			connectPipe(f, proc.Get("stdio").Index(i))
+		}
+	}
+	pid = proc.Get("pid").Int()
+	jsProcesses[pid] = p
+	return pid, nil
+}
+
+// connectPipe connects the end of a pipe that was passed to a child process
+// to the stream that Node.js created for it.
+func connectPipe(f *jsFile, stream *js.Object) {
+	p := f.pipe
+	if f.write {
+		p.writers++
+		stream.Call("on", "data", func(chunk *js.Object) {
+			p.push(jsBytes(chunk))
+		})
+		stream.Call("on", "close", func() {
+			p.closeWriter()
+		})
+		return
+	}
+	p.readers++
+	p.sink = stream
+	if len(p.buf) != 0 {
+		stream.Call("write", p.buf)
+		p.buf = nil
+	}
+	if p.writers == 0 {
+		stream.Call("end")
+	}
+	// Writing to a process that has exited fails with EPIPE.
+	stream.Call("on", "error", func() {})
+	stream.Call("on", "close", func() {
+		p.sink = nil
+		p.readers--
+	})
+}
+
+func Wait4(pid int, wstatus *WaitStatus, options int, rusage *Rusage) (wpid int, err error) {
+	p := jsProcesses[pid]
+	if p == nil {
+		var status _C_int
+		wpid, err = wait4(pid, &status, options, rusage)
+		if wstatus != nil {
+			*wstatus = WaitStatus(status)
+		}
+		return
+	}
+	if options&WNOHANG != 0 {
+		select {
+		case <-p.exited:
+		default:
+			return 0, nil
+		}
+	}
+	<-p.exited
+	delete(jsProcesses, pid)
+	if wstatus != nil {
+		*wstatus = p.status
+	}
+	return pid, nil
+}
+
+func Kill(pid int, signum Signal) (err error) {
+	if p := jsProcesses[pid]; p != nil {
+		select {
+		case <-p.exited:
+			return ESRCH
+		default:
+		}
+	}
+	process := js.Global.Get("process")
+	if process == js.Undefined {
+		return ENOSYS
+	}
+	return catchJSError(func() {
+		process.Call("kill", pid, int(signum))
+	})
+}
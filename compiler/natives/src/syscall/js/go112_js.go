@@ -6,3 +6,28 @@ package js
 func (v Value) String() string {
 	return v.internal().String()
 }
+
+// TypedArray represents a JavaScript typed array.
+type TypedArray struct {
+	Value
+}
+
+// TypedArrayOf returns a JavaScript typed array backed by the slice's
+// underlying array.
+//
+// The supported types are []int8, []int16, []int32, []uint8, []uint16,
+// []uint32, []float32 and []float64. Passing an unsupported value causes a
+// panic.
+func TypedArrayOf(slice interface{}) TypedArray {
+	switch slice := slice.(type) {
+	case []int8, []int16, []int32, []uint8, []uint16, []uint32, []float32, []float64:
+		return TypedArray{objectToValue(id.Invoke(slice))}
+	default:
+		panic("TypedArrayOf: not a supported slice")
+	}
+}
+
+// Release frees up resources allocated for the typed array. There are none
+// in GopherJS, since the typed array shares the memory of the slice.
+func (a TypedArray) Release() {
+}
@@ -30,31 +30,29 @@ func (v Value) String() string {
 // It returns the number of bytes copied, which will be the minimum of the lengths of src and dst.
 // CopyBytesToGo panics if src is not an Uint8Array.
 func CopyBytesToGo(dst []byte, src Value) int {
-	return copy(dst, src.internal().Interface().([]byte))
+	if !src.InstanceOf(Global().Get("Uint8Array")) {
+		panic("syscall/js: CopyBytesToGo: expected src to be an Uint8Array")
+	}
+	n := src.Length()
+	if n > len(dst) {
+		n = len(dst)
+	}
+	// Externalizing a []byte gives a Uint8Array that shares its memory.
+	id.Invoke(dst).Call("set", src.internal().Call("subarray", 0, n))
+	return n
 }
 
-// func CopyBytesToGo(dst []byte, src Value) int {
-// 	s := make([]byte, src.Get("byteLength").Int())
-// 	a := TypedArrayOf(s)
-// 	a.Call("set", src)
-// 	a.Release()
-// 	return copy(dst, s)
-// }
-
 // CopyBytesToJS copies bytes from src to the Uint8Array dst.
 // It returns the number of bytes copied, which will be the minimum of the lengths of src and dst.
 // CopyBytesToJS panics if dst is not an Uint8Array.
 func CopyBytesToJS(dst Value, src []byte) int {
-	return copy(dst.internal().Interface().([]byte), src)
+	if !dst.InstanceOf(Global().Get("Uint8Array")) {
+		panic("syscall/js: CopyBytesToJS: expected dst to be an Uint8Array")
+	}
+	n := dst.Length()
+	if n > len(src) {
+		n = len(src)
+	}
+	dst.internal().Call("set", id.Invoke(src[:n]))
+	return n
 }
-
-// func CopyBytesToJS(dst Value, src []byte) int {
-// 	n := dst.Get("byteLength").Int()
-// 	if n > len(src) {
-// 		n = len(src)
-// 	}
-// 	a := TypedArrayOf(src[:n])
-// 	dst.Call("set", a)
-// 	a.Release()
-// 	return n
-// }
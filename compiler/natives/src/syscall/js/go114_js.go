@@ -15,39 +15,19 @@ func (v Value) IsUndefined() bool {
 	return !v.inited
 }
 
+// IsNaN reports whether v is the JavaScript value "NaN".
 func (v Value) IsNaN() bool {
-	return js.Global.Call("isNaN", v.internal()).Bool()
+	return v.Type() == TypeNumber && js.Global.Call("isNaN", v.internal()).Bool()
 }
 
+// Equal reports whether v and w are equal according to JavaScript's === operator.
 func (v Value) Equal(w Value) bool {
-	//return v.internal() == w.internal() && !v.IsNaN()
-	if v.Type() != w.Type() {
-		return false
-	}
-	switch v.Type() {
-	case TypeString:
-		return v.internal().String() == w.internal().String()
-	case TypeUndefined:
-		return true
-	case TypeNull:
-		return true
-	case TypeBoolean:
-		return v.internal().Bool() == w.internal().Bool()
-	case TypeNumber:
-		return v.internal().Float() == w.internal().Float()
-	case TypeSymbol:
-		return v.internal() == w.internal()
-	case TypeObject:
-		return v.internal() == w.internal()
-	case TypeFunction:
-		return v.internal() == w.internal()
-	default:
-		panic("bad type")
-	}
+	// *js.Object values are compared with ===.
+	return v.internal() == w.internal()
 }
 
 func (v Value) Delete(p string) {
-	if vType := v.Type(); vType != TypeObject {
+	if vType := v.Type(); !vType.isObject() {
 		panic(&ValueError{"Value.Delete", vType})
 	}
 	v.internal().Delete(p)
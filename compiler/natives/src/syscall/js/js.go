@@ -3,7 +3,6 @@
 package js
 
 import (
-	"reflect"
 	"unsafe"
 
 	"github.com/gopherjs/gopherjs/js"
@@ -57,26 +56,48 @@ func Undefined() Value {
 	return objectToValue(js.Undefined)
 }
 
+// Func is a wrapped Go function to be called by JavaScript.
 type Func struct {
-	Value
+	Value // the JavaScript function that invokes the Go function
+	id    uint32
 }
 
-func (f Func) Release() {
-	f.Value = Null()
-}
+var (
+	funcs             = make(map[uint32]func(Value, []Value) interface{})
+	nextFuncID uint32 = 1
+)
 
+// FuncOf returns a function to be used by JavaScript. Like with WebAssembly,
+// the function must be released with Release when it isn't used anymore;
+// calling it afterwards logs "call to released function".
 func FuncOf(fn func(this Value, args []Value) interface{}) Func {
+	fid := nextFuncID
+	nextFuncID++
+	funcs[fid] = fn
 	return Func{
+		id: fid,
 		Value: objectToValue(js.MakeFunc(func(this *js.Object, args []*js.Object) interface{} {
+			f, ok := funcs[fid]
+			if !ok {
+				js.Global.Get("console").Call("error", "call to released function")
+				return js.Undefined
+			}
 			vargs := make([]Value, len(args))
 			for i, a := range args {
 				vargs[i] = objectToValue(a)
 			}
-			return fn(objectToValue(this), vargs)
+			return ValueOf(f(objectToValue(this), vargs)).internal()
 		})),
 	}
 }
 
+// Release frees up resources allocated for the function.
+// The function must not be invoked after calling Release.
+// It is allowed to call Release while the function is still running.
+func (c Func) Release() {
+	delete(funcs, c.id)
+}
+
 type Error struct {
 	Value
 }
@@ -107,49 +128,51 @@ var (
 
 func init() {
 	if js.Global != nil {
-		id = js.Global.Call("eval", "(function(x) { return x; })")
-		instanceOf = js.Global.Call("eval", "(function(x, y) { return x instanceof y; })")
-		getValueType = js.Global.Call("eval", `(function(x) {
-  if (typeof(x) === "undefined") {
-    return 0; // TypeUndefined
-  }
-  if (x === null) {
-    return 1; // TypeNull
-  }
-  if (typeof(x) === "boolean") {
-    return 2; // TypeBoolean
-  }
-  if (typeof(x) === "number") {
-    return 3; // TypeNumber
-  }
-  if (typeof(x) === "string") {
-    return 4; // TypeString
-  }
-  if (typeof(x) === "symbol") {
-    return 5; // TypeSymbol
-  }
-  if (typeof(x) === "function") {
-    return 7; // TypeFunction
-  }
-  return 6; // TypeObject
-})`)
+		id = js.Global.Get("$jsIdentity")
+		instanceOf = js.Global.Get("$jsInstanceOf")
+		getValueType = js.Global.Get("$jsValueType")
 	}
 }
 
+// ValueOf returns x as a JavaScript value, like the WebAssembly version:
+//
+//	| Go                     | JavaScript             |
+//	| ---------------------- | ---------------------- |
+//	| js.Value               | [its value]            |
+//	| js.Func                | function               |
+//	| nil                    | null                   |
+//	| bool                   | boolean                |
+//	| integers and floats    | number                 |
+//	| string                 | string                 |
+//	| []interface{}          | new array              |
+//	| map[string]interface{} | new object             |
+//
+// Wrappers are converted with their JSValue method. Panics for any other
+// type, including slices other than []interface{}.
 func ValueOf(x interface{}) Value {
 	switch x := x.(type) {
-	case Value:
+	case Value: // should precede Wrapper to avoid a loop
 		return x
-	case Func:
-		return x.Value
-	case TypedArray:
-		return x.Value
+	case Wrapper:
+		return x.JSValue()
 	case nil:
 		return Null()
-	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, unsafe.Pointer, string, map[string]interface{}, []interface{}:
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, uintptr, float32, float64, unsafe.Pointer, string:
 		return objectToValue(id.Invoke(x))
+	case []interface{}:
+		a := js.Global.Get("Array").New(len(x))
+		for i, s := range x {
+			a.SetIndex(i, ValueOf(s).internal())
+		}
+		return objectToValue(a)
+	case map[string]interface{}:
+		o := js.Global.Get("Object").New()
+		for k, v := range x {
+			o.Set(k, ValueOf(v).internal())
+		}
+		return objectToValue(o)
 	default:
-		panic(`invalid arg: ` + reflect.TypeOf(x).String())
+		panic("ValueOf: invalid value")
 	}
 }
 
@@ -182,7 +205,7 @@ func (v Value) Call(m string, args ...interface{}) Value {
 		panic(&ValueError{"Value.Call", vType})
 	}
 	if propType := v.Get(m).Type(); propType != TypeFunction {
-		panic("js: Value.Call: property " + m + " is not a function, got " + propType.String())
+		panic("syscall/js: Value.Call: property " + m + " is not a function, got " + propType.String())
 	}
 	return objectToValue(v.internal().Call(m, convertArgs(args...)...))
 }
@@ -235,13 +258,19 @@ func (v Value) JSValue() Value {
 }
 
 func (v Value) Length() int {
+	if vType := v.Type(); !vType.isObject() {
+		panic(&ValueError{"Value.Length", vType})
+	}
 	return v.internal().Length()
 }
 
-func (v Value) newWrap(args ...interface{}) (obj *js.Object, err error) {
+func (v Value) newWrap(args ...interface{}) (obj *js.Object, err *js.Error) {
 	defer func() {
-		ret := recover()
-		if r, ok := ret.(*js.Error); ok {
+		if ret := recover(); ret != nil {
+			r, ok := ret.(*js.Error)
+			if !ok {
+				panic(ret)
+			}
 			err = r
 		}
 	}()
@@ -253,9 +282,9 @@ func (v Value) New(args ...interface{}) Value {
 	obj, err := v.newWrap(args...)
 	if err != nil {
 		if vType := v.Type(); vType != TypeFunction { // check here to avoid overhead in success case
-			panic(&ValueError{"Value.Invoke", vType})
+			panic(&ValueError{"Value.New", vType})
 		}
-		panic(Error{objectToValue(obj)})
+		panic(Error{objectToValue(err.Object)})
 	}
 	return objectToValue(obj)
 }
@@ -274,10 +303,6 @@ func (v Value) SetIndex(i int, x interface{}) {
 	v.internal().SetIndex(i, convertArgs(x)[0])
 }
 
-// func (v Value) String() string {
-// 	return v.internal().String()
-// }
-
 func (v Value) Truthy() bool {
 	return v.internal().Bool()
 }
@@ -286,23 +311,6 @@ func (v Value) Type() Type {
 	return Type(getValueType.Invoke(v.internal()).Int())
 }
 
-type TypedArray struct {
-	Value
-}
-
-func TypedArrayOf(slice interface{}) TypedArray {
-	switch slice := slice.(type) {
-	case []int8, []int16, []int32, []uint8, []uint16, []uint32, []float32, []float64:
-		return TypedArray{objectToValue(id.Invoke(slice))}
-	default:
-		panic("TypedArrayOf: not a supported slice")
-	}
-}
-
-func (t *TypedArray) Release() {
-	t.Value = Value{}
-}
-
 type ValueError struct {
 	Method string
 	Type   Type
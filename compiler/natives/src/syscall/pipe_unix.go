@@ -0,0 +1,159 @@
+// +build js,!windows
+
+package syscall
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// Without the system call module, a Go program in Node.js has no file
+// descriptors of its own besides the standard ones. The pipes that os/exec
+// connects to child processes and the /dev/null it opens for them are
+// emulated here, with file descriptors that only this package knows about.
+
+// A jsPipe is a pipe that is buffered in memory.
+type jsPipe struct {
+	buf     []byte
+	readers int           // open read ends, including the child processes reading it
+	writers int           // open write ends, including the child processes writing to it
+	wait    chan struct{} // closed when data arrives or the last writer goes away
+	sink    *js.Object    // stdin of the child process reading the pipe, if any
+}
+
+// notify wakes up the goroutines that wait for p.
+func (p *jsPipe) notify() {
+	if p.wait != nil {
+		close(p.wait)
+		p.wait = nil
+	}
+}
+
+// push adds data that was written to p.
+func (p *jsPipe) push(b []byte) {
+	p.buf = append(p.buf, b...)
+	p.notify()
+}
+
+// closeWriter closes one of the write ends of p.
+func (p *jsPipe) closeWriter() {
+	p.writers--
+	if p.writers == 0 {
+		p.notify()
+		if p.sink != nil {
+			p.sink.Call("end")
+		}
+	}
+}
+
+// A jsFile is an emulated file descriptor: an end of a jsPipe, or /dev/null
+// if pipe is nil.
+type jsFile struct {
+	pipe  *jsPipe
+	write bool
+}
+
+var jsFiles = make(map[int]*jsFile)
+var nextJSFile = 3
+
+func newJSFile(f *jsFile) int {
+	fd := nextJSFile
+	nextJSFile++
+	jsFiles[fd] = f
+	return fd
+}
+
+// jsBytes returns a slice that shares its memory with array.
+func jsBytes(array *js.Object) []byte {
+	b := make([]byte, array.Length())
+	js.InternalObject(b).Set("$array", array)
+	return b
+}
+
+// jsFileSyscall carries out the system calls that refer to emulated files. It
+// reports whether trap was one of them.
+func jsFileSyscall(trap, a1, a2, a3 uintptr) (r uintptr, err Errno, ok bool) {
+	if trap == pipeTrap {
+		p := &jsPipe{readers: 1, writers: 1}
+		fds := js.InternalObject(a1)
+		fds.SetIndex(0, newJSFile(&jsFile{pipe: p}))
+		fds.SetIndex(1, newJSFile(&jsFile{pipe: p, write: true}))
+		return 0, 0, true
+	}
+	if path := openedPath(trap, a1, a2); path != nil {
+		if string(jsBytes(path)) != "/dev/null\x00" {
+			return 0, 0, false
+		}
+		return uintptr(newJSFile(&jsFile{})), 0, true
+	}
+
+	f := jsFiles[int(a1)]
+	if f == nil {
+		return 0, 0, false
+	}
+	switch trap { // the calls that take a file descriptor as first argument
+	case SYS_WRITE:
+		b := jsBytes(js.InternalObject(a2))
+		if f.pipe == nil {
+			return uintptr(len(b)), 0, true
+		}
+		p := f.pipe
+		switch {
+		case !f.write:
+			return uintptr(minusOne), EBADF, true
+		case p.sink != nil:
+			p.sink.Call("write", js.Global.Get("Uint8Array").New(js.InternalObject(a2)))
+		case p.readers == 0:
+			return uintptr(minusOne), EPIPE, true
+		default:
+			p.push(b)
+		}
+		return uintptr(len(b)), 0, true
+	case SYS_CLOSE:
+		delete(jsFiles, int(a1))
+		if p := f.pipe; p != nil {
+			if f.write {
+				p.closeWriter()
+			} else {
+				p.readers--
+			}
+		}
+		return 0, 0, true
+	case SYS_FCNTL:
+		return 0, 0, true
+	}
+	return 0, 0, false
+}
+
+// readJSFile reads from an emulated file. Unlike the other calls, reading
+// from a pipe blocks until there is data, so it can't go through Syscall.
+func readJSFile(f *jsFile, b []byte) (int, error) {
+	p := f.pipe
+	if p == nil {
+		return 0, nil
+	}
+	if f.write {
+		return 0, EBADF
+	}
+	for len(p.buf) == 0 {
+		if p.writers == 0 {
+			return 0, nil
+		}
+		if p.wait == nil {
+			p.wait = make(chan struct{})
+		}
+		<-p.wait
+	}
+	n := copy(b, p.buf)
+	p.buf = p.buf[n:]
+	return n, nil
+}
+
+func Read(fd int, p []byte) (n int, err error) {
+	if fs := vfsFile(fd); fs != nil {
+		return fs.Read(fd, p)
+	}
+	if f := jsFiles[fd]; f != nil {
+		return readJSFile(f, p)
+	}
+	return read(fd, p)
+}
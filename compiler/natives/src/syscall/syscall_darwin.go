@@ -250,6 +250,18 @@ func funcPC(f func()) uintptr {
 	return uintptr(minusOne)
 }
 
+// pipeTrap is the system call that os.Pipe makes.
+const pipeTrap = SYS_PIPE
+
+// openedPath returns the path that a system call opens, or nil if it doesn't
+// open a file.
+func openedPath(trap, a1, a2 uintptr) *js.Object {
+	if trap != SYS_OPEN {
+		return nil
+	}
+	return js.InternalObject(a1)
+}
+
 func syscall(trap, a1, a2, a3 uintptr) (r1, r2 uintptr, err Errno) {
 	return Syscall(trap, a1, a2, a3)
 }
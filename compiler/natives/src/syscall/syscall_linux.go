@@ -2,4 +2,20 @@
 
 package syscall
 
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
 const exitTrap = SYS_EXIT_GROUP
+
+// pipeTrap is the system call that os.Pipe makes.
+const pipeTrap = SYS_PIPE2
+
+// openedPath returns the path that a system call opens, or nil if it doesn't
+// open a file.
+func openedPath(trap, a1, a2 uintptr) *js.Object {
+	if trap != SYS_OPENAT {
+		return nil
+	}
+	return js.InternalObject(a2)
+}
@@ -68,12 +68,13 @@ func Syscall(trap, a1, a2, a3 uintptr) (r1, r2 uintptr, err Errno) {
 		r := f.Invoke(trap, a1, a2, a3)
 		return uintptr(r.Index(0).Int()), uintptr(r.Index(1).Int()), Errno(r.Index(2).Int())
 	}
+	if r, err, ok := jsFileSyscall(trap, a1, a2, a3); ok {
+		return r, 0, err
+	}
 	if trap == SYS_WRITE && (a1 == 1 || a1 == 2) {
-		array := js.InternalObject(a2)
-		slice := make([]byte, array.Length())
-		js.InternalObject(slice).Set("$array", array)
-		printToConsole(slice)
-		return uintptr(array.Length()), 0, 0
+		b := jsBytes(js.InternalObject(a2))
+		printToConsole(b)
+		return uintptr(len(b)), 0, 0
 	}
 	if trap == exitTrap {
 		runtime.Goexit()
@@ -87,6 +88,9 @@ func Syscall6(trap, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2 uintptr, err Errno)
 		r := f.Invoke(trap, a1, a2, a3, a4, a5, a6)
 		return uintptr(r.Index(0).Int()), uintptr(r.Index(1).Int()), Errno(r.Index(2).Int())
 	}
+	if r, err, ok := jsFileSyscall(trap, a1, a2, a3); ok {
+		return r, 0, err
+	}
 	if trap != 202 { // kern.osrelease on OS X, happens in init of "os" package
 		printWarning()
 	}
@@ -98,6 +102,9 @@ func RawSyscall(trap, a1, a2, a3 uintptr) (r1, r2 uintptr, err Errno) {
 		r := f.Invoke(trap, a1, a2, a3)
 		return uintptr(r.Index(0).Int()), uintptr(r.Index(1).Int()), Errno(r.Index(2).Int())
 	}
+	if r, err, ok := jsFileSyscall(trap, a1, a2, a3); ok {
+		return r, 0, err
+	}
 	printWarning()
 	return uintptr(minusOne), 0, EACCES
 }
@@ -0,0 +1,253 @@
+// +build js,!windows
+
+package syscall
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// Once a program enables the in-memory file system of package
+// github.com/gopherjs/gopherjs/js/vfs, the file system calls below go there
+// instead of to the system. Calls on file descriptors that it doesn't own, like
+// the standard ones, still go to the original functions.
+
+// fileSystem is the in-memory file system, which js/vfs installs as $vfs.
+type fileSystem interface {
+	Owns(fd int) bool
+	Openat(dirfd int, path string, flags int, perm uint32) (int, error)
+	Close(fd int) error
+	Read(fd int, b []byte) (int, error)
+	Write(fd int, b []byte) (int, error)
+	Pread(fd int, b []byte, off int64) (int, error)
+	Pwrite(fd int, b []byte, off int64) (int, error)
+	Seek(fd int, offset int64, whence int) (int64, error)
+	Fstat(fd int, st *Stat_t) error
+	Fstatat(dirfd int, path string, st *Stat_t) error
+	Mkdirat(dirfd int, path string, perm uint32) error
+	Unlinkat(dirfd int, path string, dir bool) error
+	Renameat(olddirfd int, oldpath string, newdirfd int, newpath string) error
+	Chmod(path string, mode uint32) error
+	Fchmod(fd int, mode uint32) error
+	Truncate(path string, size int64) error
+	Ftruncate(fd int, size int64) error
+	UtimesNano(path string, ts []Timespec) error
+	Chdir(path string) error
+	Fchdir(fd int) error
+	Getwd() (string, error)
+	ReadDirent(fd int, buf []byte) (int, error)
+}
+
+// vfs returns the in-memory file system, or nil if it isn't enabled.
+func vfs() fileSystem {
+	fs := js.Global.Get("$vfs")
+	if fs == js.Undefined {
+		return nil
+	}
+	return fs.Interface().(fileSystem)
+}
+
+// vfsFile returns the in-memory file system if it owns fd.
+func vfsFile(fd int) fileSystem {
+	if fs := vfs(); fs != nil && fs.Owns(fd) {
+		return fs
+	}
+	return nil
+}
+
+//gopherjs:keep-original
+func Open(path string, mode int, perm uint32) (fd int, err error) {
+	if fs := vfs(); fs != nil {
+		return fs.Openat(-1, path, mode, perm)
+	}
+	return _gopherjs_original_Open(path, mode, perm)
+}
+
+//gopherjs:keep-original
+func Close(fd int) (err error) {
+	if fs := vfsFile(fd); fs != nil {
+		return fs.Close(fd)
+	}
+	return _gopherjs_original_Close(fd)
+}
+
+//gopherjs:keep-original
+func Write(fd int, p []byte) (n int, err error) {
+	if fs := vfsFile(fd); fs != nil {
+		return fs.Write(fd, p)
+	}
+	return _gopherjs_original_Write(fd, p)
+}
+
+//gopherjs:keep-original
+func Pread(fd int, p []byte, offset int64) (n int, err error) {
+	if fs := vfsFile(fd); fs != nil {
+		return fs.Pread(fd, p, offset)
+	}
+	return _gopherjs_original_Pread(fd, p, offset)
+}
+
+//gopherjs:keep-original
+func Pwrite(fd int, p []byte, offset int64) (n int, err error) {
+	if fs := vfsFile(fd); fs != nil {
+		return fs.Pwrite(fd, p, offset)
+	}
+	return _gopherjs_original_Pwrite(fd, p, offset)
+}
+
+//gopherjs:keep-original
+func Seek(fd int, offset int64, whence int) (off int64, err error) {
+	if fs := vfsFile(fd); fs != nil {
+		return fs.Seek(fd, offset, whence)
+	}
+	return _gopherjs_original_Seek(fd, offset, whence)
+}
+
+//gopherjs:keep-original
+func Fstat(fd int, stat *Stat_t) (err error) {
+	if fs := vfsFile(fd); fs != nil {
+		return fs.Fstat(fd, stat)
+	}
+	return _gopherjs_original_Fstat(fd, stat)
+}
+
+//gopherjs:keep-original
+func Stat(path string, stat *Stat_t) (err error) {
+	if fs := vfs(); fs != nil {
+		return fs.Fstatat(-1, path, stat)
+	}
+	return _gopherjs_original_Stat(path, stat)
+}
+
+// Lstat is Stat, since the in-memory file system has no symbolic links.
+//
+//gopherjs:keep-original
+func Lstat(path string, stat *Stat_t) (err error) {
+	if fs := vfs(); fs != nil {
+		return fs.Fstatat(-1, path, stat)
+	}
+	return _gopherjs_original_Lstat(path, stat)
+}
+
+//gopherjs:keep-original
+func Mkdir(path string, mode uint32) (err error) {
+	if fs := vfs(); fs != nil {
+		return fs.Mkdirat(-1, path, mode)
+	}
+	return _gopherjs_original_Mkdir(path, mode)
+}
+
+//gopherjs:keep-original
+func Rmdir(path string) (err error) {
+	if fs := vfs(); fs != nil {
+		return fs.Unlinkat(-1, path, true)
+	}
+	return _gopherjs_original_Rmdir(path)
+}
+
+//gopherjs:keep-original
+func Unlink(path string) (err error) {
+	if fs := vfs(); fs != nil {
+		return fs.Unlinkat(-1, path, false)
+	}
+	return _gopherjs_original_Unlink(path)
+}
+
+//gopherjs:keep-original
+func Rename(from string, to string) (err error) {
+	if fs := vfs(); fs != nil {
+		return fs.Renameat(-1, from, -1, to)
+	}
+	return _gopherjs_original_Rename(from, to)
+}
+
+//gopherjs:keep-original
+func Chmod(path string, mode uint32) (err error) {
+	if fs := vfs(); fs != nil {
+		return fs.Chmod(path, mode)
+	}
+	return _gopherjs_original_Chmod(path, mode)
+}
+
+//gopherjs:keep-original
+func Fchmod(fd int, mode uint32) (err error) {
+	if fs := vfsFile(fd); fs != nil {
+		return fs.Fchmod(fd, mode)
+	}
+	return _gopherjs_original_Fchmod(fd, mode)
+}
+
+//gopherjs:keep-original
+func Truncate(path string, length int64) (err error) {
+	if fs := vfs(); fs != nil {
+		return fs.Truncate(path, length)
+	}
+	return _gopherjs_original_Truncate(path, length)
+}
+
+//gopherjs:keep-original
+func Ftruncate(fd int, length int64) (err error) {
+	if fs := vfsFile(fd); fs != nil {
+		return fs.Ftruncate(fd, length)
+	}
+	return _gopherjs_original_Ftruncate(fd, length)
+}
+
+//gopherjs:keep-original
+func UtimesNano(path string, ts []Timespec) (err error) {
+	if fs := vfs(); fs != nil {
+		return fs.UtimesNano(path, ts)
+	}
+	return _gopherjs_original_UtimesNano(path, ts)
+}
+
+//gopherjs:keep-original
+func Chdir(path string) (err error) {
+	if fs := vfs(); fs != nil {
+		return fs.Chdir(path)
+	}
+	return _gopherjs_original_Chdir(path)
+}
+
+//gopherjs:keep-original
+func Fchdir(fd int) (err error) {
+	if fs := vfsFile(fd); fs != nil {
+		return fs.Fchdir(fd)
+	}
+	return _gopherjs_original_Fchdir(fd)
+}
+
+//gopherjs:keep-original
+func Getwd() (wd string, err error) {
+	if fs := vfs(); fs != nil {
+		return fs.Getwd()
+	}
+	return _gopherjs_original_Getwd()
+}
+
+//gopherjs:keep-original
+func ReadDirent(fd int, buf []byte) (n int, err error) {
+	if fs := vfsFile(fd); fs != nil {
+		return fs.ReadDirent(fd, buf)
+	}
+	return _gopherjs_original_ReadDirent(fd, buf)
+}
+
+// Fsync has nothing to do for in-memory files.
+//
+//gopherjs:keep-original
+func Fsync(fd int) (err error) {
+	if vfsFile(fd) != nil {
+		return nil
+	}
+	return _gopherjs_original_Fsync(fd)
+}
+
+// SetNonblock has nothing to do for in-memory files, which never block.
+//
+//gopherjs:keep-original
+func SetNonblock(fd int, nonblocking bool) (err error) {
+	if vfsFile(fd) != nil {
+		return nil
+	}
+	return _gopherjs_original_SetNonblock(fd, nonblocking)
+}
@@ -0,0 +1,110 @@
+// +build js
+// +build go1.15
+
+package time
+
+// intlCheck is the start of the years whose transitions the rule for the
+// years after intlEnd must give as well: 2030-01-01 UTC.
+const intlCheck = 1893456000
+
+// intlExtend sets the rule by which l goes on after intlEnd, like the footer
+// of version 2 zoneinfo files. It is made from the daylight saving time of
+// the last year, if there is any, and kept only if it gives the transitions
+// since intlCheck too. A transition like "the Friday before the last Sunday"
+// is written as a time of the day before 0 or past 24, as in "M3.5.0/-46".
+func intlExtend(l *Location) {
+	var last []zoneTrans
+	for _, tx := range l.tx {
+		if tx.when >= intlEnd-365*secondsPerDay {
+			last = append(last, tx)
+		}
+	}
+	if len(last) != 2 {
+		return
+	}
+	std, dst := l.zone[last[1].index], l.zone[last[0].index]
+	start, end := last[0].when, last[1].when
+	if std.isDST {
+		std, dst, start, end = dst, std, end, start
+	}
+	if std.isDST || !dst.isDST {
+		return
+	}
+
+	i := len(l.tx)
+	for i > 1 && l.tx[i-1].when >= intlCheck {
+		i--
+	}
+	check := &Location{name: l.name, zone: l.zone, tx: l.tx[:i]}
+	zones := intlRuleName(std.name) + intlRuleTime(-std.offset) + intlRuleName(dst.name) + intlRuleTime(-dst.offset)
+	for _, s := range intlRuleDates(start + int64(std.offset)) {
+		for _, e := range intlRuleDates(end + int64(dst.offset)) {
+			check.extend = zones + "," + s + "," + e
+			if intlCheckRule(check, l, l.tx[i:]) {
+				l.extend = check.extend
+				return
+			}
+		}
+	}
+}
+
+// intlCheckRule reports whether check, which has the rule, gives the zones
+// of l around each of the transitions tx.
+func intlCheckRule(check, l *Location, tx []zoneTrans) bool {
+	for _, t := range tx {
+		for _, sec := range [...]int64{t.when - 1, t.when} {
+			name, offset := Unix(sec, 0).In(check).Zone()
+			if wantName, wantOffset := Unix(sec, 0).In(l).Zone(); name != wantName || offset != wantOffset {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// intlRuleName quotes the zone name for a rule unless it is all letters.
+func intlRuleName(name string) string {
+	for i := 0; i < len(name); i++ {
+		if c := name[i]; c < 'A' || c > 'Z' && c < 'a' || c > 'z' {
+			return "<" + name + ">"
+		}
+	}
+	return name
+}
+
+// intlRuleTime formats sec, less than 100 hours, as [-]h[:mm[:ss]].
+func intlRuleTime(sec int) string {
+	s := ""
+	if sec < 0 {
+		s, sec = "-", -sec
+	}
+	s += itoa(sec / 3600)
+	if sec%3600 != 0 {
+		s += ":" + smallsString[sec/60%60*2:sec/60%60*2+2]
+		if sec%60 != 0 {
+			s += ":" + smallsString[sec%60*2:sec%60*2+2]
+		}
+	}
+	return s
+}
+
+// intlRuleDates returns the ways to write the transition at the local time
+// local as Mm.w.d/time: on the weekday itself or up to three days before or
+// after it, in the last week of the month or in the week it is in.
+func intlRuleDates(local int64) []string {
+	var dates []string
+	for _, days := range [...]int{0, 1, -1, 2, -2, 3, -3} {
+		t := Unix(local-int64(days)*secondsPerDay, 0).UTC()
+		year, month, day := t.Date()
+		hour, minute, second := t.Clock()
+		date := "M" + itoa(int(month)) + "."
+		rest := "." + itoa(int(t.Weekday())) + "/" + intlRuleTime(hour*3600+minute*60+second+days*secondsPerDay)
+		if day+7 > daysIn(month, year) {
+			dates = append(dates, date+"5"+rest)
+		}
+		if day <= 28 {
+			dates = append(dates, date+itoa((day-1)/7+1)+rest)
+		}
+	}
+	return dates
+}
@@ -0,0 +1,41 @@
+// +build js
+// +build go1.15
+
+package time
+
+import (
+	"testing"
+)
+
+func TestIntlExtend(t *testing.T) {
+	ny, err := loadIntlLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "EST5EDT4,M3.2.0/2,M11.1.0/2"; ny.extend != want {
+		t.Errorf("got rule %q for %v, want %q", ny.extend, ny, want)
+	}
+	for _, tt := range []struct {
+		t      Time
+		name   string
+		offset int
+	}{
+		{Date(2050, January, 15, 12, 0, 0, 0, UTC), "EST", -5 * 60 * 60},
+		{Date(2050, March, 13, 6, 59, 59, 0, UTC), "EST", -5 * 60 * 60},
+		{Date(2050, March, 13, 7, 0, 0, 0, UTC), "EDT", -4 * 60 * 60},
+		{Date(2050, July, 15, 12, 0, 0, 0, UTC), "EDT", -4 * 60 * 60},
+	} {
+		name, offset := tt.t.In(ny).Zone()
+		if name != tt.name || offset != tt.offset {
+			t.Errorf("%v in %v: got zone %s%+d, want %s%+d", tt.t, ny, name, offset, tt.name, tt.offset)
+		}
+	}
+
+	kolkata, err := loadIntlLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kolkata.extend != "" {
+		t.Errorf("got rule %q for %v without daylight saving time", kolkata.extend, kolkata)
+	}
+}
@@ -13,18 +13,22 @@ func init() {
 	var _ Time = Unix(0, 0)
 }
 
+// runtimeNano returns the monotonic clock, which timers use, too.
 func runtimeNano() int64 {
-	return js.Global.Get("Date").New().Call("getTime").Int64() * int64(Millisecond)
+	return js.Global.Call("$nanotime").Int64()
 }
 
+// now reads the wall clock, which has just millisecond resolution, and the
+// monotonic clock separately.
 func now() (sec int64, nsec int32, mono int64) {
-	n := runtimeNano()
-	return n / int64(Second), int32(n % int64(Second)), n
+	n := js.Global.Call("$now").Int64() * int64(Millisecond)
+	return n / int64(Second), int32(n % int64(Second)), runtimeNano()
 }
 
 func Sleep(d Duration) {
 	c := make(chan struct{})
 	js.Global.Call("$setTimeout", js.InternalObject(func() { close(c) }), int(d/Millisecond))
+	js.Global.Call("$waitReason", "sleep")
 	<-c
 }
 
@@ -48,7 +52,7 @@ func startTimer(t *runtimeTimer) {
 }
 
 func stopTimer(t *runtimeTimer) bool {
-	js.Global.Call("clearTimeout", t.timeout)
+	js.Global.Call("$clearTimeout", t.timeout)
 	wasActive := t.active
 	t.active = false
 	return wasActive
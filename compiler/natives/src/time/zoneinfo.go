@@ -16,6 +16,19 @@ var zoneSources = []string{
 }
 
 func initLocal() {
+	// Intl knows the name of the local time zone, so the location can be
+	// loaded with all its transitions.
+	if intl := js.Global.Get("Intl"); intl != js.Undefined {
+		if name := intl.Get("DateTimeFormat").New().Call("resolvedOptions").Get("timeZone"); name != js.Undefined {
+			if z, err := loadLocation(name.String(), zoneSources); err == nil {
+				localLoc = *z
+				localLoc.name = "Local"
+				return
+			}
+		}
+	}
+
+	// Otherwise, there's just the current UTC offset.
 	localLoc.name = "Local"
 
 	z := zone{}
@@ -0,0 +1,8 @@
+// +build js
+// +build !go1.15
+
+package time
+
+// intlExtend does nothing: before Go 1.15, locations have no rule for the
+// years after their transitions, and those from zoneinfo files stop there too.
+func intlExtend(l *Location) {}
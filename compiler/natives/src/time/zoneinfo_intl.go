@@ -0,0 +1,214 @@
+// +build js
+
+package time
+
+import (
+	"errors"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// Without system calls, zoneinfo files can't be read, but the Intl API of
+// JavaScript knows the time zones. A location is built from the UTC offsets
+// and abbreviations that Intl.DateTimeFormat gives for it: these are compared
+// month by month from 1970 through 2037, like the transitions in version 1
+// zoneinfo files, and every change is narrowed down to the second. Changes
+// that are undone within a month are missed. Each call of formatToParts takes
+// a while, so a change is first looked for where that of a year before
+// predicts it. Later years follow the rule of 2037, where Go supports it.
+// Zones without abbreviations in English get names like "+0530", as in
+// zoneinfo.
+
+const (
+	intlStart = 0          // 1970-01-01 UTC
+	intlEnd   = 2145916800 // 2038-01-01 UTC
+)
+
+// intlLocations caches the locations built from Intl, which take a while.
+var intlLocations = make(map[string]*Location)
+
+//gopherjs:keep-original
+func loadLocation(name string, sources []string) (z *Location, firstErr error) {
+	if hasSyscalls() {
+		if z, firstErr = _gopherjs_original_loadLocation(name, sources); firstErr == nil {
+			return z, nil
+		}
+	}
+	if z, err := loadIntlLocation(name); err == nil {
+		return z, nil
+	}
+	if firstErr == nil {
+		// There may still be time/tzdata.
+		return _gopherjs_original_loadLocation(name, nil)
+	}
+	return nil, firstErr
+}
+
+// hasSyscalls reports whether Node.js has the system call module.
+func hasSyscalls() (ok bool) {
+	require := js.Global.Get("require")
+	if require == js.Undefined {
+		return false
+	}
+	defer func() {
+		if recover() != nil {
+			ok = false
+		}
+	}()
+	require.Invoke("syscall")
+	return true
+}
+
+// An intlZone is the time zone of a location at some instant, as Intl gives it.
+type intlZone struct {
+	name   string
+	offset int
+}
+
+func loadIntlLocation(name string) (l *Location, err error) {
+	if l := intlLocations[name]; l != nil {
+		return l, nil
+	}
+	intl := js.Global.Get("Intl")
+	if intl == js.Undefined {
+		return nil, errors.New("unknown time zone " + name)
+	}
+	var format *js.Object
+	func() {
+		defer func() {
+			if recover() != nil { // a RangeError for unknown time zones
+				err = errors.New("unknown time zone " + name)
+			}
+		}()
+		format = intl.Get("DateTimeFormat").New("en-US", js.M{
+			"timeZone":     name,
+			"hourCycle":    "h23",
+			"year":         "numeric",
+			"month":        "numeric",
+			"day":          "numeric",
+			"hour":         "numeric",
+			"minute":       "numeric",
+			"second":       "numeric",
+			"timeZoneName": "short",
+		})
+	}()
+	if err != nil {
+		return nil, err
+	}
+
+	l = &Location{name: name}
+	zoneAt := func(sec int64) intlZone {
+		return intlZoneAt(format, sec)
+	}
+	stds := make(map[int]int)
+	add := func(when int64, z intlZone) {
+		// Intl doesn't tell daylight saving time, so it's taken to be any
+		// offset above the lower one of January and July.
+		year := 1970
+		if when != alpha {
+			year, _, _ = Unix(when, 0).UTC().Date()
+		}
+		std, ok := stds[year]
+		if !ok {
+			std = zoneAt(Date(year, January, 1, 0, 0, 0, 0, UTC).Unix()).offset
+			if o := zoneAt(Date(year, July, 1, 0, 0, 0, 0, UTC).Unix()).offset; o < std {
+				std = o
+			}
+			stds[year] = std
+		}
+		zn := zone{name: intlAbbrev(z.name), offset: z.offset, isDST: z.offset > std}
+		i := 0
+		for i < len(l.zone) && l.zone[i] != zn {
+			i++
+		}
+		if i == len(l.zone) {
+			l.zone = append(l.zone, zn)
+		}
+		l.tx = append(l.tx, zoneTrans{when: when, index: uint8(i)})
+	}
+	prev := zoneAt(intlStart)
+	add(alpha, prev)
+	for t := int64(intlStart); t < intlEnd; {
+		end := Unix(t, 0).UTC().AddDate(0, 1, 0).Unix()
+		// There may be more than one change in the month.
+		for next := zoneAt(end); next != prev; {
+			t, prev = intlChange(zoneAt, l.tx, prev, t, end, next)
+			add(t, prev)
+		}
+		t = end
+	}
+	intlExtend(l)
+	intlLocations[name] = l
+	return l, nil
+}
+
+// intlChange returns the first instant in (lo, hi] at which zoneAt gives
+// another zone than prev, and that zone. It gives next at hi. Most zones
+// change on the same weekday every year, so the changes tx of the year before
+// are tried 52 and 53 weeks later before bisecting.
+func intlChange(zoneAt func(int64) intlZone, tx []zoneTrans, prev intlZone, lo, hi int64, next intlZone) (int64, intlZone) {
+	for i := len(tx) - 1; i > 0 && tx[i].when >= lo-53*secondsPerWeek; i-- {
+		for _, weeks := range [...]int64{52, 53} {
+			when := tx[i].when + weeks*secondsPerWeek
+			if lo < when && when <= hi && zoneAt(when-1) == prev {
+				if z := zoneAt(when); z != prev {
+					return when, z
+				}
+			}
+		}
+	}
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		if z := zoneAt(mid); z == prev {
+			lo = mid
+		} else {
+			hi, next = mid, z
+		}
+	}
+	return hi, next
+}
+
+// intlZoneAt returns the time zone that format gives for the Unix time sec.
+func intlZoneAt(format *js.Object, sec int64) intlZone {
+	parts := format.Call("formatToParts", sec*1000)
+	var year, month, day, hour, minute, second int
+	var name string
+	for i := 0; i < parts.Length(); i++ {
+		p := parts.Index(i)
+		v := p.Get("value")
+		switch p.Get("type").String() {
+		case "year":
+			year = v.Int()
+		case "month":
+			month = v.Int()
+		case "day":
+			day = v.Int()
+		case "hour":
+			hour = v.Int() % 24 // some browsers give 24 at midnight
+		case "minute":
+			minute = v.Int()
+		case "second":
+			second = v.Int()
+		case "timeZoneName":
+			name = v.String()
+		}
+	}
+	local := Date(year, Month(month), day, hour, minute, second, 0, UTC).Unix()
+	return intlZone{name: name, offset: int(local - sec)}
+}
+
+// intlAbbrev turns names like "GMT+5:30", which Intl gives for zones without
+// abbreviations, into names like "+0530".
+func intlAbbrev(name string) string {
+	if len(name) < 5 || name[:3] != "GMT" || name[3] != '+' && name[3] != '-' {
+		return name
+	}
+	h, m := name[4:], ""
+	if i := indexByte(h, ':'); i >= 0 {
+		h, m = h[:i], h[i+1:]
+	}
+	if len(h) == 1 {
+		h = "0" + h
+	}
+	return name[3:4] + h + m
+}
@@ -0,0 +1,38 @@
+// +build js
+
+package time
+
+import (
+	"testing"
+)
+
+func TestIntlLocation(t *testing.T) {
+	ny, err := loadIntlLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	kolkata, err := loadIntlLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		t      Time
+		loc    *Location
+		name   string
+		offset int
+	}{
+		{Date(2020, January, 15, 12, 0, 0, 0, UTC), ny, "EST", -5 * 60 * 60},
+		{Date(2020, March, 8, 6, 59, 59, 0, UTC), ny, "EST", -5 * 60 * 60},
+		{Date(2020, March, 8, 7, 0, 0, 0, UTC), ny, "EDT", -4 * 60 * 60},
+		{Date(2020, July, 15, 12, 0, 0, 0, UTC), ny, "EDT", -4 * 60 * 60},
+		{Date(2020, July, 15, 12, 0, 0, 0, UTC), kolkata, "+0530", 5*60*60 + 30*60},
+	} {
+		name, offset := tt.t.In(tt.loc).Zone()
+		if name != tt.name || offset != tt.offset {
+			t.Errorf("%v in %v: got zone %s%+d, want %s%+d", tt.t, tt.loc, name, offset, tt.name, tt.offset)
+		}
+	}
+	if _, err := loadIntlLocation("Nowhere/Special"); err == nil {
+		t.Errorf("loaded an unknown time zone")
+	}
+}
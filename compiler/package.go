@@ -12,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/goplusjs/gopherjs/compiler/analysis"
+	"github.com/goplusjs/gopherjs/compiler/typesutil"
 	"github.com/neelance/astrewrite"
 	"golang.org/x/tools/go/gcexportdata"
 	"golang.org/x/tools/go/types/typeutil"
@@ -35,13 +36,35 @@ type pkgContext struct {
 	anonTypes    []*types.TypeName
 	anonTypeMap  typeutil.Map
 	escapingVars map[*types.Var]bool
+	// heapVars holds all variables that were moved to the heap, for FuncNames.
+	heapVars    map[*types.Var]bool
+	methodNames map[string]bool // exported methods that may be called by name, "*" for all
+	escapes     *analysis.EscapeInfo
+	diagnostics map[diagnostic]bool
+	// funcNames holds the names recorded for the functions of the declaration
+	// being translated if debugInfo is set.
+	funcNames    map[token.Pos]FuncNames
 	indentation  int
 	dependencies map[types.Object]bool
 	minify       bool
+	debugInfo    bool
 	fileSet      *token.FileSet
 	errList      ErrorList
 }
 
+type diagnostic struct {
+	pos token.Pos
+	msg string
+}
+
+// diagnose records a message for Options.Diagnose. Code may be translated more
+// than once, so duplicates are dropped.
+func (p *pkgContext) diagnose(pos token.Pos, format string, a ...interface{}) {
+	if p.diagnostics != nil {
+		p.diagnostics[diagnostic{pos, fmt.Sprintf(format, a...)}] = true
+	}
+}
+
 func (p *pkgContext) SelectionOf(e *ast.SelectorExpr) (selection, bool) {
 	if sel, ok := p.Selections[e]; ok {
 		return sel, true
@@ -89,6 +112,8 @@ type funcContext struct {
 	delayedOutput []byte
 	posAvailable  bool
 	pos           token.Pos
+	funcName      string // as printed in goroutine dumps, e.g. "main.main" or "main.main.func1"
+	funcLits      int    // number of function literals translated so far, for naming them
 }
 
 type flowData struct {
@@ -125,7 +150,24 @@ func (pi packageImporter) Import(path string) (*types.Package, error) {
 	return pi.importContext.Packages[a.ImportPath], nil
 }
 
-func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, importContext *ImportContext, linknames []LinkName, minify bool) (*Archive, error) {
+// Options control optional parts of the compilation.
+type Options struct {
+	// Minify produces minified code.
+	Minify bool
+	// Inline replaces calls to small leaf functions with their bodies.
+	Inline bool
+	// Diagnose, if not nil, is called with the decisions of the escape
+	// analysis, like "moved to heap: x", sorted by position.
+	Diagnose func(pos token.Position, msg string)
+	// AllErrors reports all errors instead of only the first 10.
+	AllErrors bool
+	// DebugInfo records the names of local variables for debuggers (see
+	// FuncNames).
+	DebugInfo bool
+}
+
+// Compile compiles a type-checked package.
+func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, importContext *ImportContext, linknames []LinkName, options Options) (*Archive, error) {
 	typesInfo := &types.Info{
 		Types:      make(map[ast.Expr]types.TypeAndValue),
 		Defs:       make(map[*ast.Ident]types.Object),
@@ -157,14 +199,7 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 		return nil, importError
 	}
 	if errList != nil {
-		if len(errList) > 10 {
-			pos := token.NoPos
-			if last, ok := errList[9].(types.Error); ok {
-				pos = last.Pos
-			}
-			errList = append(errList[:10], types.Error{Fset: fileSet, Pos: pos, Msg: "too many errors"})
-		}
-		return nil, errList
+		return nil, typeErrors(importPath, errList, files).Truncate(options.AllErrors)
 	}
 	if err != nil {
 		return nil, err
@@ -183,9 +218,10 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 	simplifiedFiles := make([]*ast.File, len(files))
 	for i, file := range files {
 		simplifiedFiles[i] = astrewrite.Simplify(file, typesInfo, false)
+		removeDeadBranches(simplifiedFiles[i], typesInfo)
 	}
 
-	isBlocking := func(f *types.Func) bool {
+	importedDecl := func(f *types.Func) *Decl {
 		archive, err := importContext.Import(f.Pkg().Path())
 		if err != nil {
 			panic(err)
@@ -193,12 +229,46 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 		fullName := f.FullName()
 		for _, d := range archive.Declarations {
 			if string(d.FullName) == fullName {
-				return d.Blocking
+				return d
 			}
 		}
 		panic(fullName)
 	}
+
+	var inlineSources map[*types.Func]string
+	if options.Inline {
+		inliner := newInliner(typesInfo, typesPkg, importedDecl)
+		inlineSources = inliner.addCandidates(simplifiedFiles, fileSet)
+		for _, file := range simplifiedFiles {
+			inliner.inlineCalls(file)
+		}
+	}
+
+	isBlocking := func(f *types.Func) bool {
+		return importedDecl(f).Blocking
+	}
+	isOptimisticBlocking := func(f *types.Func) bool {
+		d := importedDecl(f)
+		return d.Blocking && d.FastDeclCode == nil
+	}
+	// The optimistic analysis is a second full pass over the package, and
+	// functions that only block through interface and func value calls are
+	// translated twice, once more for FastDeclCode. The pass itself costs about
+	// as much as the first one, 1-4ms for packages like fmt, text/template and
+	// compress/flate. The extra translations grow with the share of such
+	// functions: a package where half of its 800 functions call an interface
+	// method in a loop compiles about 1.5 times slower than without them.
 	pkgInfo := analysis.AnalyzePkg(simplifiedFiles, fileSet, typesInfo, typesPkg, isBlocking)
+	optimisticInfo := analysis.AnalyzePkgOptimistic(simplifiedFiles, fileSet, typesInfo, typesPkg, isOptimisticBlocking, isBlocking)
+	paramEscapes := func(f *types.Func, i int) bool {
+		for _, j := range importedDecl(f).NonEscapingParams {
+			if j == i {
+				return false
+			}
+		}
+		return true
+	}
+	escapes := analysis.AnalyzeEscapes(simplifiedFiles, typesInfo, typesPkg, paramEscapes)
 
 	c := &funcContext{
 		FuncInfo: pkgInfo.InitFuncInfo,
@@ -210,19 +280,27 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 			objectNames:  make(map[types.Object]string),
 			varPtrNames:  make(map[*types.Var]string),
 			escapingVars: make(map[*types.Var]bool),
+			heapVars:     make(map[*types.Var]bool),
+			escapes:      escapes,
 			indentation:  1,
 			dependencies: make(map[types.Object]bool),
-			minify:       minify,
+			methodNames:  make(map[string]bool),
+			minify:       options.Minify,
+			debugInfo:    options.DebugInfo,
 			fileSet:      fileSet,
 		},
 		allVars:     make(map[string]int),
 		flowDatas:   map[*types.Label]*flowData{nil: {}},
 		caseCounter: 1,
 		labelCases:  make(map[*types.Label]int),
+		funcName:    runtimePkgPath(typesPkg) + ".init",
 	}
 	for name := range reservedKeywords {
 		c.allVars[name] = 1
 	}
+	if options.Diagnose != nil {
+		c.p.diagnostics = make(map[diagnostic]bool)
+	}
 
 	// imports
 	var importDecls []*Decl
@@ -297,15 +375,27 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 
 	collectDependencies := func(f func()) []string {
 		c.p.dependencies = make(map[types.Object]bool)
+		c.p.methodNames = make(map[string]bool)
 		f()
 		var deps []string
 		for o := range c.p.dependencies {
-			qualifiedName := o.Pkg().Path() + "." + o.Name()
 			if f, ok := o.(*types.Func); ok && f.Type().(*types.Signature).Recv() != nil {
-				deps = append(deps, qualifiedName+"~")
+				if f.Exported() {
+					// Calls through interfaces may reach exported methods of
+					// any package, so only the name matters.
+					c.p.methodNames[f.Name()] = true
+					continue
+				}
+				deps = append(deps, o.Pkg().Path()+"."+o.Name()+"~")
 				continue
 			}
-			deps = append(deps, qualifiedName)
+			if typesutil.IsJsPackage(o.Pkg()) && o.Name() == "MakeWrapper" {
+				c.p.methodNames["*"] = true // makes all exported methods callable from JavaScript
+			}
+			deps = append(deps, o.Pkg().Path()+"."+o.Name())
+		}
+		for name := range c.p.methodNames {
+			deps = append(deps, name+"~")
 		}
 		sort.Strings(deps)
 		return deps
@@ -355,6 +445,8 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 			})
 			d.Vars = append(d.Vars, c.localVars...)
 		})
+		d.Functions = c.p.takeFuncNames()
+		d.BlockingNodes = c.blockingNodes(optimisticInfo, nil, init.Rhs)
 		if len(init.Lhs) == 1 {
 			if !analysis.HasSideEffect(init.Rhs, c.p.Info.Info) {
 				d.DceObjectFilter = init.Lhs[0].Name()
@@ -372,6 +464,9 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 		d := Decl{
 			FullName: o.FullName(),
 			Blocking: len(funcInfo.Blocking) != 0,
+			Inline:   inlineSources[o],
+
+			NonEscapingParams: escapes.NonEscapingParams[o],
 		}
 		if fun.Recv == nil {
 			d.Vars = []string{c.objectName(o)}
@@ -393,22 +488,46 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 				d.DceObjectFilter = ""
 			}
 		}
+		sig := o.Type().(*types.Signature)
+		params := make([]*types.Var, 0, sig.Params().Len()+1)
+		if sig.Recv() != nil {
+			params = append(params, sig.Recv())
+		}
+		for i := 0; i < sig.Params().Len(); i++ {
+			params = append(params, sig.Params().At(i))
+		}
+		for _, param := range params {
+			if leaks, tracked := escapes.ParamEscapes(param); tracked && leaks {
+				c.p.diagnose(param.Pos(), "leaking param: %s", param.Name())
+			} else if tracked {
+				c.p.diagnose(param.Pos(), "%s does not escape", param.Name())
+			}
+		}
 		if fun.Recv != nil {
-			recvType := o.Type().(*types.Signature).Recv().Type()
+			recvType := sig.Recv().Type()
 			ptr, isPointer := recvType.(*types.Pointer)
 			namedRecvType, _ := recvType.(*types.Named)
 			if isPointer {
 				namedRecvType = ptr.Elem().(*types.Named)
 			}
 			d.DceObjectFilter = namedRecvType.Obj().Name()
-			if !fun.Name.IsExported() {
-				d.DceMethodFilter = o.Name() + "~"
-			}
+			d.DceMethodFilter = o.Name() + "~"
 		}
 
 		d.DceDeps = collectDependencies(func() {
 			d.DeclCode = c.translateToplevelFunction(fun, funcInfo)
+			if fastInfo := optimisticInfo.FuncDeclInfos[o]; d.Blocking && len(fastInfo.Blocking) == 0 {
+				d.FastDeclCode = c.translateOptimistic(optimisticInfo, func() []byte {
+					return c.translateToplevelFunction(fun, fastInfo)
+				})
+			}
 		})
+		d.Functions = c.p.takeFuncNames()
+		if fun.Body != nil {
+			d.BlockingNodes = c.blockingNodes(optimisticInfo, o, fun.Body)
+		} else {
+			d.BlockingNodes = c.blockingNodes(optimisticInfo, o, nil)
+		}
 		if fun.Body == nil {
 			for _, link := range linknames {
 				if link.Local == fun.Name.Name {
@@ -477,8 +596,15 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 				switch t := o.Type().Underlying().(type) {
 				case *types.Struct:
 					params := make([]string, t.NumFields())
-					for i := 0; i < t.NumFields(); i++ {
+					for i, j := 0, 0; i < t.NumFields(); i++ {
 						params[i] = fieldName(t, i) + "_"
+						if c.p.minify {
+							for reservedKeywords[shortIdent(j, false)] {
+								j++
+							}
+							params[i] = shortIdent(j, false)
+							j++
+						}
 					}
 					constructor = fmt.Sprintf("function(%s) {\n\t\tthis.$val = this;\n\t\tif (arguments.length === 0) {\n", strings.Join(params, ", "))
 					for i := 0; i < t.NumFields(); i++ {
@@ -486,7 +612,7 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 					}
 					constructor += "\t\t\treturn;\n\t\t}\n"
 					for i := 0; i < t.NumFields(); i++ {
-						constructor += fmt.Sprintf("\t\tthis.%[1]s = %[1]s_;\n", fieldName(t, i))
+						constructor += fmt.Sprintf("\t\tthis.%s = %s;\n", fieldName(t, i), params[i])
 					}
 					constructor += "\t}"
 				case *types.Basic, *types.Array, *types.Slice, *types.Chan, *types.Signature, *types.Interface, *types.Pointer, *types.Map:
@@ -550,15 +676,32 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 
 	var allDecls []*Decl
 	for _, d := range append(append(append(importDecls, typeDecls...), varDecls...), funcDecls...) {
-		d.DeclCode = removeWhitespace(d.DeclCode, minify)
-		d.MethodListCode = removeWhitespace(d.MethodListCode, minify)
-		d.TypeInitCode = removeWhitespace(d.TypeInitCode, minify)
-		d.InitCode = removeWhitespace(d.InitCode, minify)
+		d.DeclCode = removeWhitespace(d.DeclCode, options.Minify)
+		d.FastDeclCode = removeWhitespace(d.FastDeclCode, options.Minify)
+		d.MethodListCode = removeWhitespace(d.MethodListCode, options.Minify)
+		d.TypeInitCode = removeWhitespace(d.TypeInitCode, options.Minify)
+		d.InitCode = removeWhitespace(d.InitCode, options.Minify)
 		allDecls = append(allDecls, d)
 	}
 
 	if len(c.p.errList) != 0 {
-		return nil, c.p.errList
+		return nil, c.p.errList.Truncate(options.AllErrors)
+	}
+
+	if options.Diagnose != nil {
+		diagnostics := make([]diagnostic, 0, len(c.p.diagnostics))
+		for d := range c.p.diagnostics {
+			diagnostics = append(diagnostics, d)
+		}
+		sort.Slice(diagnostics, func(i, j int) bool {
+			if diagnostics[i].pos != diagnostics[j].pos {
+				return diagnostics[i].pos < diagnostics[j].pos
+			}
+			return diagnostics[i].msg < diagnostics[j].msg
+		})
+		for _, d := range diagnostics {
+			options.Diagnose(fileSet.Position(d.pos), d.msg)
+		}
 	}
 
 	return &Archive{
@@ -568,7 +711,7 @@ func Compile(importPath string, files []*ast.File, fileSet *token.FileSet, impor
 		ExportData:   exportData.Bytes(),
 		Declarations: allDecls,
 		FileSet:      encodedFileSet.Bytes(),
-		Minified:     minify,
+		Minified:     options.Minify,
 	}, nil
 }
 
@@ -635,7 +778,7 @@ func (c *funcContext) translateToplevelFunction(fun *ast.FuncDecl, info *analysi
 			return []byte(fmt.Sprintf("\t%s = function() {\n\t\t$throwRuntimeError(\"native function not implemented: %s\");\n\t};\n", funcRef, o.FullName()))
 		}
 
-		params, fun := translateFunction(fun.Type, recv, fun.Body, c, sig, info, funcRef)
+		params, fun := translateFunction(fun.Type, recv, fun.Body, c, sig, info, funcRef, runtimeFuncName(o))
 		joinedParams = strings.Join(params, ", ")
 		return []byte(fmt.Sprintf("\t%s = %s;\n", funcRef, fun))
 	}
@@ -687,11 +830,24 @@ func (c *funcContext) translateToplevelFunction(fun *ast.FuncDecl, info *analysi
 	return code.Bytes()
 }
 
-func translateFunction(typ *ast.FuncType, recv *ast.Ident, body *ast.BlockStmt, outerContext *funcContext, sig *types.Signature, info *analysis.FuncInfo, funcRef string) ([]string, string) {
+func translateFunction(typ *ast.FuncType, recv *ast.Ident, body *ast.BlockStmt, outerContext *funcContext, sig *types.Signature, info *analysis.FuncInfo, funcRef string, funcName string) ([]string, string) {
 	if info == nil {
 		panic("nil info")
 	}
 
+	if funcName == "" {
+		// Name function literals the way gc does.
+		outerContext.funcLits++
+		switch {
+		case outerContext.parent == nil:
+			funcName = fmt.Sprintf("%s.glob..func%d", runtimePkgPath(outerContext.p.Pkg), outerContext.funcLits)
+		case outerContext.parent.parent == nil:
+			funcName = fmt.Sprintf("%s.func%d", outerContext.funcName, outerContext.funcLits)
+		default:
+			funcName = fmt.Sprintf("%s.%d", outerContext.funcName, outerContext.funcLits)
+		}
+	}
+
 	c := &funcContext{
 		FuncInfo:    info,
 		p:           outerContext.p,
@@ -702,6 +858,7 @@ func translateFunction(typ *ast.FuncType, recv *ast.Ident, body *ast.BlockStmt,
 		flowDatas:   map[*types.Label]*flowData{nil: {}},
 		caseCounter: 1,
 		labelCases:  make(map[*types.Label]int),
+		funcName:    funcName,
 	}
 	for k, v := range outerContext.allVars {
 		c.allVars[k] = v
@@ -754,6 +911,7 @@ func translateFunction(typ *ast.FuncType, recv *ast.Ident, body *ast.BlockStmt,
 		}
 	}))
 
+	c.recordNames(typ, recv, body)
 	sort.Strings(c.localVars)
 
 	var prefix, suffix, functionName string
@@ -114,33 +114,60 @@ var $throw = function(err) { throw err; };
 var $noGoroutine = { asleep: false, exit: false, deferStack: [], panicStack: [] };
 var $curGoroutine = $noGoroutine, $totalGoroutines = 0, $awakeGoroutines = 0, $checkForDeadlock = true;
 var $mainFinished = false;
+var $goroutines = {}, $goroutineCounter = 0; /* live goroutines by id, for goroutine dumps */
 var $go = function(fun, args) {
+  $schedule($newGoroutine(fun, args));
+};
+
+/* $newGoroutine creates a goroutine running fun, to be started by $schedule or by calling it directly. If settle is given, it is called with fun's result, or with the error that terminated the goroutine, which is then not rethrown. */
+var $newGoroutine = function(fun, args, settle) {
   $totalGoroutines++;
   $awakeGoroutines++;
+  var $goroutine = $allocGoroutine();
+  $goroutine.fun = fun;
+  $goroutine.args = args;
+  $goroutine.settle = settle;
+  $goroutine.id = ++$goroutineCounter;
+  $goroutines[$goroutine.id] = $goroutine;
+  return $goroutine;
+};
+
+/* $allocGoroutine returns a goroutine that the scheduler doesn't know about yet. When called, it runs its fun with its args, as described for $newGoroutine. */
+var $allocGoroutine = function() {
   var $goroutine = function() {
+    var caller = $curGoroutine;
     try {
       $curGoroutine = $goroutine;
-      var r = fun.apply(undefined, args);
+      $goroutine.stack = [];
+      var r = $goroutine.fun.apply(undefined, $goroutine.args);
       if (r && r.$blk !== undefined) {
-        fun = function() { return r.$blk(); };
-        args = [];
+        $goroutine.fun = function() { return r.$blk(); };
+        $goroutine.args = [];
         return;
       }
       $goroutine.exit = true;
+      if ($goroutine.settle !== undefined) {
+        $goroutine.settle(r, null);
+      }
     } catch (err) {
       if (!$goroutine.exit) {
-        throw err;
+        if ($goroutine.settle === undefined) {
+          throw err;
+        }
+        $goroutine.exit = true;
+        $goroutine.settle(undefined, err);
       }
     } finally {
-      $curGoroutine = $noGoroutine;
+      $curGoroutine = caller;
       if ($goroutine.exit) { /* also set by runtime.Goexit() */
         $totalGoroutines--;
         $goroutine.asleep = true;
+        delete $goroutines[$goroutine.id];
       }
       if ($goroutine.asleep) {
         $awakeGoroutines--;
         if (!$mainFinished && $awakeGoroutines === 0 && $checkForDeadlock) {
-          console.error("fatal error: all goroutines are asleep - deadlock!");
+          console.error("fatal error: all goroutines are asleep - deadlock!\n\n" + $goroutineDump(true));
           if ($global.process !== undefined) {
             $global.process.exit(2);
           }
@@ -152,19 +179,138 @@ var $go = function(fun, args) {
   $goroutine.exit = false;
   $goroutine.deferStack = [];
   $goroutine.panicStack = [];
-  $schedule($goroutine);
+  $goroutine.stack = [];
+  $goroutine.waitReason = "";
+  return $goroutine;
+};
+
+/* $callback calls fun with self and args on behalf of JavaScript, as a goroutine of its own that only becomes known to the scheduler if fun blocks, so that callbacks which return right away cost little more than a plain call. It returns done(r) for the result r of fun. If fun blocks and doesn't finish before $callback returns, it returns a Promise for done(r) instead, which is rejected with the error that terminates the goroutine, if any. */
+var $callbackGoroutines = []; /* goroutines of callbacks that returned without blocking, for reuse */
+var $callback = function(fun, self, args, done) {
+  var g = $callbackGoroutines.pop();
+  if (g === undefined) {
+    g = $allocGoroutine();
+  }
+  g.id = ++$goroutineCounter;
+  var caller = $curGoroutine, r, blocked = false;
+  try {
+    $curGoroutine = g;
+    r = fun.apply(self, args);
+    blocked = r && r.$blk !== undefined;
+  } finally {
+    $curGoroutine = caller;
+  }
+  if (!blocked) {
+    $callbackGoroutines.push(g);
+    if (caller === $noGoroutine && $scheduled.length > 0) {
+      $runScheduled();
+    }
+    return done(r);
+  }
+
+  /* Start g as a goroutine that continues where fun blocked. It is asleep, unless fun already woke it up, in which case $schedule counted it as awake. */
+  var settled = false, result, err = null, resolve, reject;
+  g.fun = function() { return r.$blk(); };
+  g.args = [];
+  g.settle = function(r, e) {
+    settled = true;
+    result = r;
+    err = e;
+    if (resolve === undefined) {
+      return;
+    }
+    if (e !== null) {
+      reject(e);
+      return;
+    }
+    resolve(done(r));
+  };
+  $totalGoroutines++;
+  $goroutines[g.id] = g;
+  if (caller === $noGoroutine) {
+    $runScheduled();
+  }
+  if (settled) {
+    if (err !== null) {
+      throw err;
+    }
+    return done(result);
+  }
+  return new Promise(function(res, rej) {
+    resolve = res;
+    reject = rej;
+  });
+};
+
+/* $unwinding is called by each function that returns because a call it made has blocked. It records the function and the position of the call for goroutine dumps. */
+var $unwinding = function(funcName, pos) {
+  if ($curGoroutine !== $noGoroutine) {
+    $curGoroutine.stack.push([funcName, pos]);
+  }
+};
+
+/* $waitReason sets the reason shown in goroutine dumps for the blocking operation that immediately follows, instead of the default one of that operation. */
+var $waitReason = function(reason) {
+  $curGoroutine.nextWaitReason = reason;
+};
+
+/* $goroutineDump formats the stacks of the current goroutine, or of all goroutines, like the Go runtime does. Blocked goroutines show where they block; the running one shows its JavaScript stack. */
+var $goroutineDump = function(all) {
+  var format = function(g) {
+    if (g === $curGoroutine) {
+      var s = "goroutine " + g.id + " [running]:\n";
+      var stack = new Error().stack;
+      if (stack !== undefined) {
+        s += stack.substr(stack.indexOf("\n") + 1) + "\n";
+      }
+      return s;
+    }
+    var s = "goroutine " + g.id + " [" + (g.asleep ? g.waitReason : "runnable") + "]:\n";
+    for (var i = 0; i < g.stack.length; i++) {
+      s += g.stack[i][0] + "(...)\n";
+      if (g.stack[i][1] !== "") {
+        s += "\t" + g.stack[i][1] + "\n";
+      }
+    }
+    return s;
+  };
+  var dump = [];
+  if ($curGoroutine !== $noGoroutine) {
+    dump.push(format($curGoroutine));
+  }
+  if (all) {
+    var ids = $keys($goroutines);
+    for (var i = 0; i < ids.length; i++) {
+      var g = $goroutines[ids[i]];
+      if (g !== $curGoroutine) {
+        dump.push(format(g));
+      }
+    }
+  }
+  return dump.join("\n");
 };
 
 var $scheduled = [];
+
+/* $nextScheduled removes the goroutine to run next from $scheduled: the one scheduled first, or in deterministic mode one chosen by $random. */
+var $nextScheduled = function() {
+  if ($virtualClock === null || $scheduled.length < 2) {
+    return $scheduled.shift();
+  }
+  return $scheduled.splice(Math.floor($random() * $scheduled.length), 1)[0];
+};
+
 var $runScheduled = function() {
   try {
     var r;
-    while ((r = $scheduled.shift()) !== undefined) {
+    while ((r = $nextScheduled()) !== undefined) {
       r();
     }
   } finally {
     if ($scheduled.length > 0) {
       setTimeout($runScheduled, 0);
+    } else if ($virtualClock !== null) {
+      $advanceVirtualClock();
     }
   }
 };
@@ -182,17 +328,126 @@ var $schedule = function(goroutine) {
 
 var $setTimeout = function(f, t) {
   $awakeGoroutines++;
+  if ($virtualClock !== null) {
+    var timer = { when: $virtualClock.now + Math.max(t, 0), f: f };
+    var timers = $virtualClock.timers, i = timers.length;
+    while (i > 0 && timers[i - 1].when > timer.when) {
+      i--;
+    }
+    timers.splice(i, 0, timer);
+    if ($curGoroutine === $noGoroutine) {
+      $advanceVirtualClock();
+    }
+    return timer;
+  }
   return setTimeout(function() {
     $awakeGoroutines--;
     f();
   }, t);
 };
 
-var $block = function() {
+var $clearTimeout = function(timer) {
+  if ($virtualClock !== null) {
+    var i = $virtualClock.timers.indexOf(timer);
+    if (i !== -1) {
+      $virtualClock.timers.splice(i, 1);
+      $awakeGoroutines--;
+    }
+    return;
+  }
+  clearTimeout(timer);
+};
+
+/* $now returns the current time in milliseconds since the Unix epoch, as seen by the time package. */
+var $now = function() {
+  if ($virtualClock !== null) {
+    return $virtualClock.now;
+  }
+  return new Date().getTime();
+};
+
+/* $nanotime returns a monotonic reading in nanoseconds since the program started, with sub-millisecond precision where available. Unlike $now, it doesn't jump when the wall clock is set. */
+var $nanotime = (function() {
+  var clock;
+  if (typeof process !== "undefined" && typeof process.hrtime === "function") {
+    var start = process.hrtime();
+    clock = function() {
+      var t = process.hrtime(start);
+      return t[0] * 1e9 + t[1];
+    };
+  } else if (typeof performance !== "undefined" && typeof performance.now === "function") {
+    var origin = performance.now();
+    clock = function() { return Math.round((performance.now() - origin) * 1e6); };
+  } else {
+    var dateOrigin = new Date().getTime(), last = 0;
+    clock = function() { /* Date can go back */
+      last = Math.max(last, (new Date().getTime() - dateOrigin) * 1e6);
+      return last;
+    };
+  }
+  return function() {
+    if ($virtualClock !== null) {
+      return ($virtualClock.now - $virtualClock.start) * 1e6;
+    }
+    return clock();
+  };
+})();
+
+/* $random returns a pseudo-random number in [0, 1) for scheduling decisions. */
+var $random = Math.random;
+
+/* $virtualClock holds the timers of deterministic mode, ordered by due time and then by creation. */
+var $virtualClock = null;
+
+/* $advanceVirtualClock arranges for the next timer to fire once no goroutine is runnable, moving the virtual clock forward to its due time. */
+var $advanceVirtualClock = function() {
+  if ($virtualClock.armed || $virtualClock.timers.length === 0) {
+    return;
+  }
+  $virtualClock.armed = true;
+  setTimeout(function() {
+    $virtualClock.armed = false;
+    if ($scheduled.length > 0) {
+      return; /* $runScheduled comes back here when done */
+    }
+    var timer = $virtualClock.timers.shift();
+    if (timer === undefined) {
+      return;
+    }
+    $virtualClock.now = Math.max($virtualClock.now, timer.when);
+    $awakeGoroutines--;
+    timer.f();
+    if ($scheduled.length === 0) {
+      $advanceVirtualClock();
+    }
+  }, 0);
+};
+
+/* $enableDeterministic switches the scheduler to deterministic mode: $select chooses among ready cases and $runScheduled among runnable goroutines with a PRNG seeded by seed (random if NaN), and timers run on a virtual clock that only moves when all goroutines are blocked. The seed is printed so that a run can be replayed. */
+var $enableDeterministic = function(seed) {
+  if (isNaN(seed)) {
+    seed = Math.floor(Math.random() * 4294967296);
+  }
+  console.error("gopherjs: deterministic scheduler seed " + seed + " (set GOPHERJS_SEED=" + seed + " to replay)");
+  var state = seed >>> 0;
+  $random = function() { /* mulberry32 */
+    state = (state + 0x6D2B79F5) >>> 0;
+    var t = state;
+    t = Math.imul(t ^ (t >>> 15), t | 1);
+    t ^= t + Math.imul(t ^ (t >>> 7), t | 61);
+    return ((t ^ (t >>> 14)) >>> 0) / 4294967296;
+  };
+  var now = new Date().getTime();
+  $virtualClock = { now: now, start: now, timers: [], armed: false };
+};
+
+var $block = function(reason) {
   if ($curGoroutine === $noGoroutine) {
     $throwRuntimeError("cannot block in JavaScript callback, fix by wrapping code in goroutine");
   }
   $curGoroutine.asleep = true;
+  $curGoroutine.waitReason = $curGoroutine.nextWaitReason || reason;
+  $curGoroutine.nextWaitReason = undefined;
 };
 
 var $send = function(chan, value) {
@@ -216,7 +471,7 @@ var $send = function(chan, value) {
     $schedule(thisGoroutine);
     return value;
   });
-  $block();
+  $block("chan send");
   return {
     $blk: function() {
       if (closedDuringSend) {
@@ -245,7 +500,7 @@ var $recv = function(chan) {
     $schedule(thisGoroutine);
   };
   chan.$recvQueue.push(queueEntry);
-  $block();
+  $block("chan receive");
   return f;
 };
 var $close = function(chan) {
@@ -295,7 +550,7 @@ var $select = function(comms) {
   }
 
   if (ready.length !== 0) {
-    selection = ready[Math.floor(Math.random() * ready.length)];
+    selection = ready[Math.floor($random() * ready.length)];
   }
   if (selection !== -1) {
     var comm = comms[selection];
@@ -352,7 +607,7 @@ var $select = function(comms) {
       }
     })(i);
   }
-  $block();
+  $block(comms.length === 0 ? "select (no cases)" : "select");
   return f;
 };
 `
@@ -23,6 +23,125 @@ var $needsExternalization = function(t) {
   }
 };
 
+/* $lookupStructTag returns the value associated with key in the struct tag, or undefined if there is no such key. It follows the conventions of reflect.StructTag.Lookup. */
+var $lookupStructTag = function(tag, key) {
+  while (tag !== "") {
+    var i = 0;
+    while (i < tag.length && tag[i] === " ") {
+      i++;
+    }
+    tag = tag.substring(i);
+    if (tag === "") {
+      break;
+    }
+    i = 0;
+    while (i < tag.length && tag.charCodeAt(i) > 0x20 && tag[i] !== ":" && tag[i] !== "\"" && tag.charCodeAt(i) !== 0x7f) {
+      i++;
+    }
+    if (i === 0 || i + 1 >= tag.length || tag[i] !== ":" || tag[i + 1] !== "\"") {
+      break;
+    }
+    var name = tag.substring(0, i);
+    tag = tag.substring(i + 1);
+    i = 1;
+    while (i < tag.length && tag[i] !== "\"") {
+      if (tag[i] === "\\") {
+        i++;
+      }
+      i++;
+    }
+    if (i >= tag.length) {
+      break;
+    }
+    var qvalue = tag.substring(0, i + 1);
+    tag = tag.substring(i + 1);
+    if (key === name) {
+      try {
+        return JSON.parse(qvalue);
+      } catch (e) {
+        break;
+      }
+    }
+  }
+  return undefined;
+};
+
+/* $jsField returns how struct field f maps onto a plain JS object: the property name and whether it is omitted when empty, as given by a js:"name,omitempty" tag. It returns null for fields that are not externalized: unexported ones and those tagged js:"-". */
+var $jsField = function(f) {
+  if (f.jsField !== undefined) {
+    return f.jsField;
+  }
+  f.jsField = null;
+  if (!f.exported) {
+    return null;
+  }
+  var name = f.name, omitEmpty = false;
+  var tag = $lookupStructTag(f.tag, "js");
+  if (tag === "-") {
+    return null;
+  }
+  if (tag !== undefined) {
+    var opts = tag.split(",");
+    if (opts[0] !== "") {
+      name = opts[0];
+    }
+    omitEmpty = opts.indexOf("omitempty", 1) !== -1;
+  }
+  f.jsField = { name: name, omitEmpty: omitEmpty };
+  return f.jsField;
+};
+
+/* $isEmptyValue reports whether v is the empty value of type t, following the omitempty rules of encoding/json. */
+var $isEmptyValue = function(v, t) {
+  switch (t.kind) {
+  case $kindBool:
+    return !v;
+  case $kindInt:
+  case $kindInt8:
+  case $kindInt16:
+  case $kindInt32:
+  case $kindUint:
+  case $kindUint8:
+  case $kindUint16:
+  case $kindUint32:
+  case $kindUintptr:
+  case $kindFloat32:
+  case $kindFloat64:
+    return v === 0;
+  case $kindInt64:
+  case $kindUint64:
+    return v.$high === 0 && v.$low === 0;
+  case $kindString:
+    return v === "";
+  case $kindArray:
+    return t.len === 0;
+  case $kindSlice:
+    return v.$length === 0;
+  case $kindMap:
+    return v === false || $keys(v).length === 0;
+  case $kindPtr:
+    return v === null || v === t.nil;
+  case $kindInterface:
+    return v === $ifaceNil;
+  case $kindFunc:
+    return v === $throwNilPointerError;
+  case $kindChan:
+    return v === $chanNil;
+  }
+  return false;
+};
+
+/* $embedsJsObject reports whether struct type t wraps a *js.Object through its first field, in which case it is mapped to that object rather than to a plain JS object. */
+var $embedsJsObject = function(t) {
+  switch (t.kind) {
+  case $kindPtr:
+    return t === $jsObjectPtr || $embedsJsObject(t.elem);
+  case $kindStruct:
+    return t.fields.length !== 0 && $embedsJsObject(t.fields[0].typ);
+  }
+  return false;
+};
+
 var $externalize = function(v, t) {
   if (t === $jsObjectPtr) {
     return v;
@@ -129,10 +248,11 @@ var $externalize = function(v, t) {
     o = {};
     for (var i = 0; i < t.fields.length; i++) {
       var f = t.fields[i];
-      if (!f.exported) {
+      var jf = $jsField(f);
+      if (jf === null || (jf.omitEmpty && $isEmptyValue(v[f.prop], f.typ))) {
         continue;
       }
-      o[f.name] = $externalize(v[f.prop], f.typ);
+      o[jf.name] = $externalize(v[f.prop], f.typ);
     }
     return o;
   }
@@ -145,6 +265,19 @@ var $externalizeFunction = function(v, t, passThis) {
   }
   if (v.$externalizeWrapper === undefined) {
     $checkForDeadlock = false;
+    var externalizeResult = function(result) {
+      switch (t.results.length) {
+      case 0:
+        return;
+      case 1:
+        return $externalize(result, t.results[0]);
+      default:
+        for (var i = 0; i < t.results.length; i++) {
+          result[i] = $externalize(result[i], t.results[i]);
+        }
+        return result;
+      }
+    };
     v.$externalizeWrapper = function() {
       var args = [];
       for (var i = 0; i < t.params.length; i++) {
@@ -158,18 +291,8 @@ var $externalizeFunction = function(v, t, passThis) {
         }
         args.push($internalize(arguments[i], t.params[i]));
       }
-      var result = v.apply(passThis ? this : undefined, args);
-      switch (t.results.length) {
-      case 0:
-        return;
-      case 1:
-        return $externalize(result, t.results[0]);
-      default:
-        for (var i = 0; i < t.results.length; i++) {
-          result[i] = $externalize(result[i], t.results[i]);
-        }
-        return result;
-      }
+      /* If the function blocks, it hands out a Promise for its results. */
+      return $callback(v, passThis ? this : undefined, args, externalizeResult);
     };
   }
   return v.$externalizeWrapper;
@@ -310,6 +433,9 @@ var $internalize = function(v, t, recv) {
     return m;
   case $kindPtr:
     if (t.elem.kind === $kindStruct) {
+      if ((v === null || v === undefined) && !$embedsJsObject(t.elem)) {
+        return t.nil;
+      }
       return $internalize(v, t.elem);
     }
   case $kindSlice:
@@ -363,6 +489,19 @@ var $internalize = function(v, t, recv) {
     if (o !== noJsObject) {
       return o;
     }
+    if (v === null || v === undefined || typeof v !== "object") {
+      break;
+    }
+    var n = new t.ptr();
+    for (var i = 0; i < t.fields.length; i++) {
+      var f = t.fields[i];
+      var jf = $jsField(f);
+      if (jf === null || v[jf.name] === undefined) {
+        continue;
+      }
+      n[f.prop] = $internalize(v[jf.name], f.typ);
+    }
+    return n;
   }
   $throwRuntimeError("cannot internalize " + t.string);
 };
@@ -376,4 +515,35 @@ var $isASCII = function(s) {
   }
   return true;
 };
+
+/* $jsIdentity, $jsInstanceOf and $jsValueType are used by syscall/js, which can't create them with eval under a strict Content Security Policy. $jsValueType returns a syscall/js.Type. */
+var $jsIdentity = function(x) {
+  return x;
+};
+
+var $jsInstanceOf = function(x, y) {
+  return x instanceof y;
+};
+
+var $jsValueType = function(x) {
+  if (typeof x === "undefined") {
+    return 0; /* TypeUndefined */
+  }
+  if (x === null) {
+    return 1; /* TypeNull */
+  }
+  switch (typeof x) {
+    case "boolean":
+      return 2; /* TypeBoolean */
+    case "number":
+      return 3; /* TypeNumber */
+    case "string":
+      return 4; /* TypeString */
+    case "symbol":
+      return 5; /* TypeSymbol */
+    case "function":
+      return 7; /* TypeFunction */
+  }
+  return 6; /* TypeObject */
+};
 `
@@ -1,10 +1,40 @@
 package prelude
 
-//go:generate go run genmin.go
+import (
+	"sync"
+
+	"github.com/goplusjs/gopherjs/compiler/jsmin"
+)
 
 // Prelude is the GopherJS JavaScript interop layer.
 const Prelude = prelude + numeric + types + goroutines + jsmapping
 
+var (
+	minifyOnce sync.Once
+	minified   string
+	mappings   []jsmin.Mapping
+)
+
+func minify() {
+	code, m, err := jsmin.MinifyWithMappings([]byte(Prelude))
+	if err != nil {
+		panic(err)
+	}
+	minified, mappings = string(code), m
+}
+
+// Minified returns a minified version of Prelude.
+func Minified() string {
+	minifyOnce.Do(minify)
+	return minified
+}
+
+// MinifiedMappings relates the tokens of Minified to their offsets in Prelude.
+func MinifiedMappings() []jsmin.Mapping {
+	minifyOnce.Do(minify)
+	return mappings
+}
+
 const prelude = `Error.stackTraceLimit = Infinity;
 
 var $global, $module;
@@ -222,6 +252,15 @@ var $bytesToString = function(slice) {
   return str;
 };
 
+/* $decodeBase64 decodes the base64 that embedded files are stored in. */
+var $decodeBase64 = function(str) {
+  if (typeof Buffer !== "undefined") {
+    var buf = Buffer.from(str, "base64");
+    return new Uint8Array(buf.buffer, buf.byteOffset, buf.length);
+  }
+  return $stringToBytes(atob(str));
+};
+
 var $stringToRunes = function(str) {
   var array = new Int32Array(str.length);
   var rune, j = 0;
@@ -9,6 +9,8 @@ import (
 	"go/token"
 	"go/types"
 	"net/url"
+	"path"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
@@ -130,7 +132,7 @@ func (c *funcContext) translateArgs(sig *types.Signature, argExprs []ast.Expr, e
 	return args
 }
 
-func (c *funcContext) translateSelection(sel selection, pos token.Pos) ([]string, string) {
+func (c *funcContext) translateSelection(sel selection, node ast.Node) ([]string, string) {
 	var fields []string
 	t := sel.Recv()
 	for _, index := range sel.Index() {
@@ -153,7 +155,7 @@ func (c *funcContext) translateSelection(sel selection, pos token.Pos) ([]string
 				var ok bool
 				s, ok = ft.(*types.Struct)
 				if !ok || s.NumFields() == 0 {
-					c.p.errList = append(c.p.errList, types.Error{Fset: c.p.fileSet, Pos: pos, Msg: fmt.Sprintf("could not find field with type *js.Object for 'js' tag of field '%s'", jsFieldName), Soft: true})
+					c.p.gopherJSError(node, fmt.Sprintf("could not find field with type *js.Object for 'js' tag of field '%s'", jsFieldName), true)
 					return nil, ""
 				}
 			}
@@ -211,25 +213,11 @@ func (c *funcContext) newVariableWithLevel(name string, pkgLevel bool) string {
 	}
 	name = encodeIdent(name)
 	if c.p.minify {
-		i := 0
-		for {
-			offset := int('a')
-			if pkgLevel {
-				offset = int('A')
-			}
-			j := i
-			name = ""
-			for {
-				name = string(rune(offset+(j%26))) + name
-				j = j/26 - 1
-				if j == -1 {
-					break
-				}
-			}
+		for i := 0; ; i++ {
+			name = shortIdent(i, pkgLevel)
 			if c.allVars[name] == 0 {
 				break
 			}
-			i++
 		}
 	}
 	n := c.allVars[name]
@@ -250,6 +238,24 @@ func (c *funcContext) newVariableWithLevel(name string, pkgLevel bool) string {
 	return varName
 }
 
+// shortIdent returns the i-th identifier of the sequence a, b, ..., z, aa,
+// ab, ..., or A, B, ... if upper is set. Minified code uses lower case names
+// for local variables and upper case names for package-level ones.
+func shortIdent(i int, upper bool) string {
+	offset := int('a')
+	if upper {
+		offset = int('A')
+	}
+	name := ""
+	for {
+		name = string(rune(offset+(i%26))) + name
+		i = i/26 - 1
+		if i == -1 {
+			return name
+		}
+	}
+}
+
 func (c *funcContext) newIdent(name string, t types.Type) *ast.Ident {
 	ident := ast.NewIdent(name)
 	c.setType(ident, t)
@@ -309,6 +315,30 @@ func (c *funcContext) objectName(o types.Object) string {
 	return name
 }
 
+// trackMethodLookup records the exported methods that a call of method m with
+// args may reach by name. reflect.Value.MethodByName with a constant name
+// reaches the methods of that name, while looking up methods by index or
+// non-constant names may reach any of them. The reflect package itself only
+// looks up methods on behalf of such calls.
+func (c *funcContext) trackMethodLookup(m types.Object, args []ast.Expr) {
+	if m.Pkg() == nil || m.Pkg().Path() != "reflect" || c.p.Pkg.Path() == "reflect" {
+		return
+	}
+	switch m.Name() {
+	case "MethodByName":
+		if len(args) == 1 {
+			if value := c.p.Types[args[0]].Value; value != nil && value.Kind() == constant.String {
+				c.p.methodNames[constant.StringVal(value)] = true
+				return
+			}
+		}
+	case "Method":
+	default:
+		return
+	}
+	c.p.methodNames["*"] = true
+}
+
 func (c *funcContext) varPtrName(o *types.Var) string {
 	if isPkgLevel(o) && o.Exported() {
 		return c.pkgVar(o.Pkg()) + "." + o.Name() + "$ptr"
@@ -372,8 +402,17 @@ func (c *funcContext) handleEscapingVars(n ast.Node) {
 	}
 	c.p.escapingVars = newEscapingVars
 
+	// Functions that block save their local variables between resumptions, so
+	// a cached pointer to one would refer to a stale copy.
+	var escapes *analysis.EscapeInfo
+	if len(c.Blocking) == 0 {
+		escapes = c.p.escapes
+	}
 	var names []string
-	objs := analysis.EscapingObjects(n, c.p.Info.Info)
+	objs, nonEscaping := analysis.EscapingObjects(n, c.p.Info.Info, escapes)
+	for _, e := range nonEscaping {
+		c.p.diagnose(e.Pos(), "&%s does not escape", e.X.(*ast.Ident).Name)
+	}
 	sort.Slice(objs, func(i, j int) bool {
 		if objs[i].Name() == objs[j].Name() {
 			return objs[i].Pos() < objs[j].Pos()
@@ -383,6 +422,8 @@ func (c *funcContext) handleEscapingVars(n ast.Node) {
 	for _, obj := range objs {
 		names = append(names, c.objectName(obj))
 		c.p.escapingVars[obj] = true
+		c.p.heapVars[obj] = true
+		c.p.diagnose(obj.Pos(), "moved to heap: %s", obj.Name())
 	}
 	sort.Strings(names)
 	for _, name := range names {
@@ -645,6 +686,45 @@ func endsWithReturn(stmts []ast.Stmt) bool {
 	return false
 }
 
+// runtimePkgPath returns the package path as it appears in function names
+// printed by the Go runtime, which uses "main" for main packages.
+func runtimePkgPath(pkg *types.Package) string {
+	if pkg.Name() == "main" {
+		return "main"
+	}
+	return pkg.Path()
+}
+
+// runtimeFuncName returns the name of o as printed in Go stack traces,
+// e.g. "main.main" or "net/http.(*Client).Do".
+func runtimeFuncName(o *types.Func) string {
+	name := o.Name()
+	if recv := o.Type().(*types.Signature).Recv(); recv != nil {
+		switch t := recv.Type().(type) {
+		case *types.Pointer:
+			name = "(*" + t.Elem().(*types.Named).Obj().Name() + ")." + name
+		case *types.Named:
+			name = t.Obj().Name() + "." + name
+		}
+	}
+	return runtimePkgPath(o.Pkg()) + "." + name
+}
+
+// dumpPos returns the position of call as shown in goroutine dumps. The file
+// is given by the import path of the package and its base name, so that the
+// output doesn't depend on where the package was built.
+func (c *funcContext) dumpPos(call *ast.CallExpr) string {
+	pos := call.Pos()
+	if !pos.IsValid() {
+		pos = c.pos // synthesized call, e.g. for a channel operation
+	}
+	if !pos.IsValid() {
+		return ""
+	}
+	p := c.p.fileSet.Position(pos)
+	return fmt.Sprintf("%s:%d", path.Join(c.p.Pkg.Path(), filepath.Base(p.Filename)), p.Line)
+}
+
 func encodeIdent(name string) string {
 	return strings.Replace(url.QueryEscape(name), "%", "$", -1)
 }
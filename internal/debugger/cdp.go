@@ -0,0 +1,211 @@
+package debugger
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// cdpClient talks the Chrome DevTools Protocol over a WebSocket connection.
+type cdpClient struct {
+	ws *wsConn
+
+	mu      sync.Mutex
+	nextID  int
+	pending map[int]chan *cdpMessage
+	err     error // set once the connection is gone
+	// events holds the events that haven't been handled yet. The queue is
+	// unbounded, so that reading responses never waits for event handlers.
+	events []*cdpMessage
+	ready  chan struct{}
+	done   chan struct{}
+}
+
+type cdpMessage struct {
+	ID     int             `json:"id,omitempty"`
+	Method string          `json:"method,omitempty"`
+	Params json.RawMessage `json:"params,omitempty"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  *cdpError       `json:"error,omitempty"`
+}
+
+type cdpError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *cdpError) Error() string {
+	return fmt.Sprintf("%s (%d)", e.Message, e.Code)
+}
+
+var errDisconnected = errors.New("disconnected from Node.js")
+
+// newCDPClient starts reading from ws. handle is called with each event, one
+// at a time, and with an empty method once the connection is closed.
+func newCDPClient(ws *wsConn, handle func(method string, params json.RawMessage)) *cdpClient {
+	c := &cdpClient{
+		ws:      ws,
+		pending: make(map[int]chan *cdpMessage),
+		ready:   make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+	go c.read()
+	go func() {
+		for {
+			c.mu.Lock()
+			events, err := c.events, c.err
+			c.events = nil
+			c.mu.Unlock()
+			for _, e := range events {
+				handle(e.Method, e.Params)
+			}
+			if len(events) == 0 && err != nil {
+				handle("", nil)
+				close(c.done)
+				return
+			}
+			<-c.ready
+		}
+	}()
+	return c
+}
+
+func (c *cdpClient) read() {
+	for {
+		data, err := c.ws.ReadMessage()
+		var msg cdpMessage
+		if err == nil {
+			err = json.Unmarshal(data, &msg)
+		}
+		c.mu.Lock()
+		if err != nil {
+			c.err = errDisconnected
+			for id, ch := range c.pending {
+				close(ch)
+				delete(c.pending, id)
+			}
+			c.mu.Unlock()
+			c.signal()
+			return
+		}
+		if msg.Method != "" {
+			c.events = append(c.events, &msg)
+			c.mu.Unlock()
+			c.signal()
+			continue
+		}
+		ch := c.pending[msg.ID]
+		delete(c.pending, msg.ID)
+		c.mu.Unlock()
+		if ch != nil {
+			ch <- &msg
+		}
+	}
+}
+
+func (c *cdpClient) signal() {
+	select {
+	case c.ready <- struct{}{}:
+	default:
+	}
+}
+
+// call calls method and decodes its result into result, unless it is nil.
+func (c *cdpClient) call(method string, params, result interface{}) error {
+	c.mu.Lock()
+	if c.err != nil {
+		c.mu.Unlock()
+		return c.err
+	}
+	c.nextID++
+	id := c.nextID
+	ch := make(chan *cdpMessage, 1)
+	c.pending[id] = ch
+	c.mu.Unlock()
+
+	if params == nil {
+		params = struct{}{}
+	}
+	data, err := json.Marshal(struct {
+		ID     int         `json:"id"`
+		Method string      `json:"method"`
+		Params interface{} `json:"params"`
+	}{id, method, params})
+	if err != nil {
+		return err
+	}
+	if err := c.ws.WriteMessage(data); err != nil {
+		return err
+	}
+	msg, ok := <-ch
+	if !ok {
+		return errDisconnected
+	}
+	if msg.Error != nil {
+		return fmt.Errorf("%s: %v", method, msg.Error)
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(msg.Result, result)
+}
+
+func (c *cdpClient) Close() error {
+	return c.ws.Close()
+}
+
+// The types below are the parts of the Debugger and Runtime domains that
+// are used.
+
+type cdpLocation struct {
+	ScriptID     string `json:"scriptId"`
+	LineNumber   int    `json:"lineNumber"`
+	ColumnNumber int    `json:"columnNumber"`
+}
+
+type cdpScope struct {
+	Type   string          `json:"type"`
+	Object cdpRemoteObject `json:"object"`
+}
+
+type cdpCallFrame struct {
+	CallFrameID  string      `json:"callFrameId"`
+	FunctionName string      `json:"functionName"`
+	Location     cdpLocation `json:"location"`
+	ScopeChain   []cdpScope  `json:"scopeChain"`
+}
+
+type cdpPaused struct {
+	CallFrames     []cdpCallFrame `json:"callFrames"`
+	Reason         string         `json:"reason"`
+	HitBreakpoints []string       `json:"hitBreakpoints"`
+}
+
+type cdpRemoteObject struct {
+	Type                string          `json:"type"`
+	Subtype             string          `json:"subtype,omitempty"`
+	ClassName           string          `json:"className,omitempty"`
+	Value               json.RawMessage `json:"value,omitempty"`
+	UnserializableValue string          `json:"unserializableValue,omitempty"`
+	Description         string          `json:"description,omitempty"`
+	ObjectID            string          `json:"objectId,omitempty"`
+}
+
+type cdpPropertyDescriptor struct {
+	Name  string           `json:"name"`
+	Value *cdpRemoteObject `json:"value,omitempty"`
+}
+
+type cdpEvaluateResult struct {
+	Result           cdpRemoteObject `json:"result"`
+	ExceptionDetails *struct {
+		Text      string           `json:"text"`
+		Exception *cdpRemoteObject `json:"exception"`
+	} `json:"exceptionDetails"`
+}
+
+type cdpBreakpoint struct {
+	BreakpointID string        `json:"breakpointId"`
+	Locations    []cdpLocation `json:"locations"`
+}
@@ -0,0 +1,151 @@
+package debugger
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+	"sync"
+)
+
+// dapConn reads and writes messages of the Debug Adapter Protocol, which are
+// JSON objects preceded by a Content-Length header.
+type dapConn struct {
+	r   *textproto.Reader
+	br  *bufio.Reader
+	w   io.Writer
+	wmu sync.Mutex
+	seq int
+}
+
+type dapRequest struct {
+	Seq       int             `json:"seq"`
+	Type      string          `json:"type"`
+	Command   string          `json:"command"`
+	Arguments json.RawMessage `json:"arguments"`
+}
+
+type dapResponse struct {
+	Seq        int         `json:"seq"`
+	Type       string      `json:"type"`
+	RequestSeq int         `json:"request_seq"`
+	Command    string      `json:"command"`
+	Success    bool        `json:"success"`
+	Message    string      `json:"message,omitempty"`
+	Body       interface{} `json:"body,omitempty"`
+}
+
+type dapEvent struct {
+	Seq   int         `json:"seq"`
+	Type  string      `json:"type"`
+	Event string      `json:"event"`
+	Body  interface{} `json:"body,omitempty"`
+}
+
+func newDAPConn(rw io.ReadWriter) *dapConn {
+	br := bufio.NewReader(rw)
+	return &dapConn{r: textproto.NewReader(br), br: br, w: rw}
+}
+
+func (c *dapConn) read() (*dapRequest, error) {
+	header, err := c.r.ReadMIMEHeader()
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(header.Get("Content-Length"))
+	if err != nil {
+		return nil, fmt.Errorf("bad Content-Length in DAP message: %v", err)
+	}
+	data := make([]byte, n)
+	if _, err := io.ReadFull(c.br, data); err != nil {
+		return nil, err
+	}
+	var req dapRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		return nil, err
+	}
+	return &req, nil
+}
+
+func (c *dapConn) write(msg interface{}) error {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	c.seq++
+	switch msg := msg.(type) {
+	case *dapResponse:
+		msg.Seq, msg.Type = c.seq, "response"
+	case *dapEvent:
+		msg.Seq, msg.Type = c.seq, "event"
+	}
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(c.w, "Content-Length: %d\r\n\r\n", len(data)); err != nil {
+		return err
+	}
+	_, err = c.w.Write(data)
+	return err
+}
+
+func (c *dapConn) respond(req *dapRequest, body interface{}, err error) error {
+	resp := &dapResponse{RequestSeq: req.Seq, Command: req.Command, Success: err == nil, Body: body}
+	if err != nil {
+		resp.Message = err.Error()
+	}
+	return c.write(resp)
+}
+
+func (c *dapConn) event(event string, body interface{}) error {
+	return c.write(&dapEvent{Event: event, Body: body})
+}
+
+// The types below are the parts of the protocol that are used.
+
+type dapSource struct {
+	Name string `json:"name,omitempty"`
+	Path string `json:"path,omitempty"`
+}
+
+type dapSourceBreakpoint struct {
+	Line      int    `json:"line"`
+	Condition string `json:"condition,omitempty"`
+}
+
+type dapBreakpoint struct {
+	ID       int        `json:"id,omitempty"`
+	Verified bool       `json:"verified"`
+	Message  string     `json:"message,omitempty"`
+	Source   *dapSource `json:"source,omitempty"`
+	Line     int        `json:"line,omitempty"`
+}
+
+type dapThread struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type dapStackFrame struct {
+	ID               int        `json:"id"`
+	Name             string     `json:"name"`
+	Source           *dapSource `json:"source,omitempty"`
+	Line             int        `json:"line"`
+	Column           int        `json:"column"`
+	PresentationHint string     `json:"presentationHint,omitempty"`
+}
+
+type dapScope struct {
+	Name               string `json:"name"`
+	PresentationHint   string `json:"presentationHint,omitempty"`
+	VariablesReference int    `json:"variablesReference"`
+	Expensive          bool   `json:"expensive"`
+}
+
+type dapVariable struct {
+	Name               string `json:"name"`
+	Value              string `json:"value"`
+	EvaluateName       string `json:"evaluateName,omitempty"`
+	VariablesReference int    `json:"variablesReference"`
+}
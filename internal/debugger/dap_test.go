@@ -0,0 +1,71 @@
+package debugger
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+// dapMessage encodes body the way clients send it.
+func dapMessage(body string) string {
+	return fmt.Sprintf("Content-Length: %d\r\n\r\n%s", len(body), body)
+}
+
+type readWriter struct {
+	io.Reader
+	io.Writer
+}
+
+func TestDAPRead(t *testing.T) {
+	in := dapMessage(`{"seq":1,"type":"request","command":"initialize","arguments":{"adapterID":"gopherjs"}}`) +
+		dapMessage(`{"seq":2,"type":"request","command":"threads"}`)
+	c := newDAPConn(readWriter{strings.NewReader(in), new(bytes.Buffer)})
+
+	req, err := c.read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.Seq != 1 || req.Command != "initialize" || string(req.Arguments) != `{"adapterID":"gopherjs"}` {
+		t.Errorf("got request %+v, want initialize", req)
+	}
+	req, err = c.read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.Seq != 2 || req.Command != "threads" || req.Arguments != nil {
+		t.Errorf("got request %+v, want threads", req)
+	}
+	if _, err := c.read(); err != io.EOF {
+		t.Errorf("got error %v at the end, want EOF", err)
+	}
+
+	c = newDAPConn(readWriter{strings.NewReader("Content-Length: x\r\n\r\n{}"), new(bytes.Buffer)})
+	if _, err := c.read(); err == nil || !strings.Contains(err.Error(), "bad Content-Length") {
+		t.Errorf("got error %v, want a bad Content-Length", err)
+	}
+}
+
+func TestDAPWrite(t *testing.T) {
+	var out bytes.Buffer
+	c := newDAPConn(readWriter{strings.NewReader(""), &out})
+	req := &dapRequest{Seq: 5, Command: "threads"}
+	if err := c.respond(req, map[string][]dapThread{"threads": {{ID: 1, Name: "main"}}}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.event("stopped", map[string]string{"reason": "breakpoint"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.respond(&dapRequest{Seq: 6, Command: "evaluate"}, nil, errors.New("undefined: x")); err != nil {
+		t.Fatal(err)
+	}
+
+	want := dapMessage(`{"seq":1,"type":"response","request_seq":5,"command":"threads","success":true,"body":{"threads":[{"id":1,"name":"main"}]}}`) +
+		dapMessage(`{"seq":2,"type":"event","event":"stopped","body":{"reason":"breakpoint"}}`) +
+		dapMessage(`{"seq":3,"type":"response","request_seq":6,"command":"evaluate","success":false,"message":"undefined: x"}`)
+	if got := out.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,916 @@
+// Package debugger implements gopherjs debug. It runs a program in Node.js
+// with the inspector enabled, controls it through the Chrome DevTools
+// Protocol and serves the Debug Adapter Protocol, like Delve does, so that
+// editors can debug the program at the level of its Go source.
+package debugger
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// noGoroutine is the thread of code that runs outside of goroutines, like
+// the initialization of the runtime and JavaScript callbacks.
+const noGoroutine = -1
+
+// maxSteps limits the single steps taken for one step of the user.
+const maxSteps = 10000
+
+// Session is a program that runs under the debugger.
+type Session struct {
+	prog *program
+	// urlRegex matches the URL of the script of the program.
+	urlRegex  string
+	script    *regexp.Regexp
+	node      *exec.Cmd
+	cdp       *cdpClient
+	exited    chan struct{}
+	exitCode  int
+	terminate sync.Once
+
+	mu          sync.Mutex
+	dap         *dapConn
+	stopOnEntry bool
+	started     bool // whether the program got past its first pause
+	paused      *cdpPaused
+	current     int // the goroutine running when the program paused
+	goroutines  []goroutine
+	frames      []frame
+	refs        []varRef
+	breakpoints map[string][]string // the ids of the breakpoints in each file
+	scripts     map[string]bool     // the ids of the script of the program
+	nextBPID    int
+	step        *step
+}
+
+type goroutine struct {
+	ID     int
+	State  string
+	Frames []string // function name and position of each frame
+}
+
+// frame is a stack frame that was shown to the client. callFrame is nil for
+// frames of goroutines that don't run.
+type frame struct {
+	callFrame *cdpCallFrame
+	loc       location
+	mapped    bool
+}
+
+type varRef struct {
+	frame    int
+	objectID string
+	slice    bool
+}
+
+type step struct {
+	method string
+	loc    location
+	depth  int
+	count  int
+}
+
+// Start runs script with args in Node.js, paused before its first line, and
+// connects to the inspector of Node.js. The source map of script has to be
+// next to it.
+func Start(script string, args []string, dir string) (*Session, error) {
+	prog, err := loadProgram(script + ".map")
+	if err != nil {
+		return nil, err
+	}
+
+	node := exec.Command("node", append([]string{"--inspect-brk=127.0.0.1:0", script}, args...)...)
+	node.Dir = dir
+	node.Stdin = os.Stdin
+	node.Stdout = os.Stdout
+	stderr, err := node.StderrPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := node.Start(); err != nil {
+		return nil, fmt.Errorf("could not run Node.js: %v", err)
+	}
+
+	// Node.js prints the address of the inspector first.
+	r := bufio.NewReader(stderr)
+	var wsURL string
+	for wsURL == "" {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			node.Process.Kill()
+			node.Wait()
+			return nil, errors.New("could not start the inspector of Node.js")
+		}
+		if strings.HasPrefix(line, "Debugger listening on ") {
+			wsURL = strings.TrimSpace(strings.TrimPrefix(line, "Debugger listening on "))
+			continue
+		}
+		os.Stderr.WriteString(line)
+	}
+	go copyStderr(r)
+
+	ws, err := dialWebSocket(wsURL)
+	if err != nil {
+		node.Process.Kill()
+		node.Wait()
+		return nil, err
+	}
+	urlRegex := `(^|[/\\])` + regexp.QuoteMeta(filepath.Base(script)) + `$`
+	s := &Session{
+		prog:        prog,
+		urlRegex:    urlRegex,
+		script:      regexp.MustCompile(urlRegex),
+		node:        node,
+		exited:      make(chan struct{}),
+		current:     noGoroutine,
+		breakpoints: make(map[string][]string),
+		scripts:     make(map[string]bool),
+	}
+	s.cdp = newCDPClient(ws, s.handleEvent)
+	go func() {
+		err := node.Wait()
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			s.exitCode = exitErr.ExitCode()
+		}
+		close(s.exited)
+		s.cdp.Close()
+	}()
+
+	for _, call := range []struct {
+		method string
+		params interface{}
+	}{
+		{"Runtime.enable", nil},
+		{"Debugger.enable", nil},
+		{"Debugger.setPauseOnExceptions", map[string]string{"state": "uncaught"}},
+	} {
+		if err := s.cdp.call(call.method, call.params, nil); err != nil {
+			s.Close()
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// copyStderr copies the output of Node.js to os.Stderr, except for the
+// messages of the inspector.
+func copyStderr(r *bufio.Reader) {
+	for {
+		line, err := r.ReadString('\n')
+		switch {
+		case strings.HasPrefix(line, "Debugger attached."),
+			strings.HasPrefix(line, "Debugger ending on "),
+			strings.HasPrefix(line, "Waiting for the debugger to disconnect..."),
+			strings.HasPrefix(line, "For help, see: https://nodejs.org/"):
+		default:
+			os.Stderr.WriteString(line)
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// Close stops the program.
+func (s *Session) Close() error {
+	s.node.Process.Kill()
+	<-s.exited
+	return nil
+}
+
+// Serve serves a single client of the Debug Adapter Protocol that connects
+// to l. It returns when the client disconnects.
+func (s *Session) Serve(l net.Listener) error {
+	conn, err := l.Accept()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	dap := newDAPConn(conn)
+	s.mu.Lock()
+	s.dap = dap
+	s.mu.Unlock()
+	for {
+		req, err := dap.read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		s.mu.Lock()
+		body, err := s.handleRequest(req)
+		dap.respond(req, body, err)
+		switch req.Command {
+		case "initialize":
+			dap.event("initialized", nil)
+		case "configurationDone":
+			if err := s.cdp.call("Runtime.runIfWaitingForDebugger", nil, nil); err != nil {
+				s.terminated()
+			}
+		}
+		s.mu.Unlock()
+		if req.Command == "disconnect" {
+			return nil
+		}
+	}
+}
+
+func (s *Session) handleRequest(req *dapRequest) (interface{}, error) {
+	switch req.Command {
+	case "initialize":
+		return map[string]bool{
+			"supportsConfigurationDoneRequest": true,
+			"supportsConditionalBreakpoints":   true,
+			"supportsEvaluateForHovers":        true,
+			"supportTerminateDebuggee":         true,
+		}, nil
+
+	case "launch", "attach":
+		var args struct {
+			StopOnEntry bool `json:"stopOnEntry"`
+		}
+		json.Unmarshal(req.Arguments, &args)
+		s.stopOnEntry = args.StopOnEntry
+		return nil, nil
+
+	case "configurationDone":
+		return nil, nil
+
+	case "setBreakpoints":
+		var args struct {
+			Source      dapSource             `json:"source"`
+			Breakpoints []dapSourceBreakpoint `json:"breakpoints"`
+		}
+		if err := json.Unmarshal(req.Arguments, &args); err != nil {
+			return nil, err
+		}
+		return s.setBreakpoints(args.Source, args.Breakpoints)
+
+	case "threads":
+		return map[string]interface{}{"threads": s.threads()}, nil
+
+	case "stackTrace":
+		var args struct {
+			ThreadID int `json:"threadId"`
+		}
+		json.Unmarshal(req.Arguments, &args)
+		frames := s.stackTrace(args.ThreadID)
+		return map[string]interface{}{"stackFrames": frames, "totalFrames": len(frames)}, nil
+
+	case "scopes":
+		var args struct {
+			FrameID int `json:"frameId"`
+		}
+		json.Unmarshal(req.Arguments, &args)
+		scopes := []dapScope{}
+		if f := s.frame(args.FrameID); f != nil && f.callFrame != nil {
+			scopes = append(scopes, dapScope{Name: "Locals", PresentationHint: "locals", VariablesReference: s.newRef(varRef{frame: args.FrameID})})
+		}
+		return map[string]interface{}{"scopes": scopes}, nil
+
+	case "variables":
+		var args struct {
+			VariablesReference int `json:"variablesReference"`
+		}
+		json.Unmarshal(req.Arguments, &args)
+		vars, err := s.variables(args.VariablesReference)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"variables": vars}, nil
+
+	case "evaluate":
+		var args struct {
+			Expression string `json:"expression"`
+			FrameID    int    `json:"frameId"`
+		}
+		json.Unmarshal(req.Arguments, &args)
+		v, err := s.evaluate(args.Expression, args.FrameID)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"result": v.Value, "variablesReference": v.VariablesReference}, nil
+
+	case "continue":
+		if err := s.resume("Debugger.resume"); err != nil {
+			return nil, err
+		}
+		return map[string]bool{"allThreadsContinued": true}, nil
+
+	case "next", "stepIn", "stepOut":
+		method := map[string]string{"next": "Debugger.stepOver", "stepIn": "Debugger.stepInto", "stepOut": "Debugger.stepOut"}[req.Command]
+		if s.paused == nil {
+			return nil, errors.New("the program is running")
+		}
+		loc, _ := s.location(&s.paused.CallFrames[0])
+		s.step = &step{method: method, loc: loc, depth: s.depth()}
+		return nil, s.resume(method)
+
+	case "pause":
+		return nil, s.cdp.call("Debugger.pause", nil, nil)
+
+	case "disconnect", "terminate":
+		s.cdp.Close()
+		s.node.Process.Kill()
+		return nil, nil
+	}
+	return nil, fmt.Errorf("unsupported request %q", req.Command)
+}
+
+// handleEvent handles the events of the inspector. An empty method means that
+// the connection is gone.
+func (s *Session) handleEvent(method string, params json.RawMessage) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch method {
+	case "Debugger.paused":
+		var p cdpPaused
+		if err := json.Unmarshal(params, &p); err != nil || len(p.CallFrames) == 0 {
+			return
+		}
+		s.paused = &p
+		s.frames, s.refs, s.goroutines = nil, nil, nil
+		s.stopped(&p)
+
+	case "Debugger.scriptParsed":
+		var script struct {
+			ScriptID string `json:"scriptId"`
+			URL      string `json:"url"`
+		}
+		if json.Unmarshal(params, &script) == nil && s.script.MatchString(script.URL) {
+			s.scripts[script.ScriptID] = true
+		}
+
+	case "Debugger.resumed":
+		s.paused = nil
+
+	case "Runtime.executionContextDestroyed":
+		// The program is done. Node.js waits for the debugger to disconnect.
+		s.cdp.Close()
+
+	case "":
+		s.terminated()
+	}
+}
+
+// stopped decides whether a pause is shown to the client, or whether the
+// program goes on, e.g. because a step isn't done yet.
+func (s *Session) stopped(p *cdpPaused) {
+	reason := "pause"
+	switch {
+	case !s.started:
+		s.started = true
+		if !s.stopOnEntry {
+			s.resume("Debugger.resume")
+			return
+		}
+		reason = "entry"
+	case len(p.HitBreakpoints) != 0:
+		s.step = nil
+		reason = "breakpoint"
+	case p.Reason == "exception" || p.Reason == "promiseRejection":
+		s.step = nil
+		reason = "exception"
+	case s.step != nil:
+		if method := s.nextStep(); method != "" {
+			s.step.count++
+			s.resume(method)
+			return
+		}
+		s.step = nil
+		reason = "step"
+	}
+
+	s.current = noGoroutine
+	var result struct{ Cur int }
+	if s.evaluateJSON(`{cur: $curGoroutine.id}`, &result) == nil && result.Cur != 0 {
+		s.current = result.Cur
+	}
+	if s.dap != nil {
+		s.dap.event("stopped", map[string]interface{}{"reason": reason, "threadId": s.current, "allThreadsStopped": true})
+	}
+}
+
+// nextStep returns the method that continues the current step, or "" if it
+// is done. Steps go on while the program is in code of the same line, in
+// code without Go source, like the prelude, or in a deeper call for a step
+// over or out.
+func (s *Session) nextStep() string {
+	if s.step.count >= maxSteps {
+		return ""
+	}
+	loc, ok := s.location(&s.paused.CallFrames[0])
+	depth := s.depth()
+	switch {
+	case !ok:
+		// Code that has no position in the source, like the code that
+		// resumes a function after it blocked, or a method wrapper.
+		if depth > s.step.depth && s.step.method != "Debugger.stepInto" || s.step.method == "Debugger.stepOut" && depth >= s.step.depth {
+			return "Debugger.stepOut"
+		}
+		if s.step.method == "Debugger.stepInto" {
+			return "Debugger.stepInto"
+		}
+		return "Debugger.stepOver"
+	case !loc.isGo():
+		for i := range s.paused.CallFrames[1:] {
+			if loc, ok := s.location(&s.paused.CallFrames[i+1]); ok && loc.isGo() {
+				return "Debugger.stepOut"
+			}
+		}
+		// The goroutine blocked or returned to the scheduler.
+		return "Debugger.resume"
+	}
+	switch s.step.method {
+	case "Debugger.stepOver":
+		if depth > s.step.depth {
+			return "Debugger.stepOut"
+		}
+		if depth == s.step.depth && loc == s.step.loc {
+			return "Debugger.stepOver"
+		}
+	case "Debugger.stepInto":
+		if depth == s.step.depth && loc == s.step.loc {
+			return "Debugger.stepInto"
+		}
+	case "Debugger.stepOut":
+		if depth >= s.step.depth {
+			return "Debugger.stepOut"
+		}
+	}
+	return ""
+}
+
+// depth returns the number of frames of the paused program that belong to
+// its script.
+func (s *Session) depth() int {
+	n := 0
+	for _, f := range s.paused.CallFrames {
+		if s.scripts[f.Location.ScriptID] {
+			n++
+		}
+	}
+	return n
+}
+
+func (s *Session) resume(method string) error {
+	s.paused = nil
+	if method == "Debugger.resume" {
+		s.step = nil
+	}
+	return s.cdp.call(method, nil, nil)
+}
+
+func (s *Session) terminated() {
+	dap := s.dap
+	if dap == nil {
+		return
+	}
+	s.terminate.Do(func() {
+		go func() {
+			<-s.exited
+			dap.event("exited", map[string]int{"exitCode": s.exitCode})
+			dap.event("terminated", nil)
+		}()
+	})
+}
+
+// location returns the Go position of a frame. It reports false for frames
+// that don't belong to the program, e.g. those of Node.js.
+func (s *Session) location(f *cdpCallFrame) (location, bool) {
+	if !s.scripts[f.Location.ScriptID] {
+		return location{}, false
+	}
+	return s.prog.lookup(f.Location.LineNumber, f.Location.ColumnNumber)
+}
+
+func (s *Session) setBreakpoints(source dapSource, bps []dapSourceBreakpoint) (interface{}, error) {
+	for _, id := range s.breakpoints[source.Path] {
+		s.cdp.call("Debugger.removeBreakpoint", map[string]string{"breakpointId": id}, nil)
+	}
+	s.breakpoints[source.Path] = nil
+
+	file := s.prog.sourceFile(source.Path)
+	result := []dapBreakpoint{}
+	for _, bp := range bps {
+		s.nextBPID++
+		b := dapBreakpoint{ID: s.nextBPID, Line: bp.Line, Source: &source}
+		var locs []cdpLocation
+		if file != "" {
+			locs, b.Line = s.prog.breakLocations(file, bp.Line)
+		}
+		if len(locs) == 0 {
+			b.Line = bp.Line
+			b.Message = "no code for this line"
+			result = append(result, b)
+			continue
+		}
+		condition := ""
+		if bp.Condition != "" {
+			condition = s.jsExpr(bp.Condition, location{file, b.Line})
+		}
+		for _, loc := range locs {
+			var r cdpBreakpoint
+			err := s.cdp.call("Debugger.setBreakpointByUrl", map[string]interface{}{
+				"urlRegex":     s.urlRegex,
+				"lineNumber":   loc.LineNumber,
+				"columnNumber": loc.ColumnNumber,
+				"condition":    condition,
+			}, &r)
+			if err != nil {
+				b.Message = err.Error()
+				continue
+			}
+			b.Verified = true
+			s.breakpoints[source.Path] = append(s.breakpoints[source.Path], r.BreakpointID)
+		}
+		result = append(result, b)
+	}
+	return map[string]interface{}{"breakpoints": result}, nil
+}
+
+// threads returns the goroutines, from the bookkeeping of the prelude.
+func (s *Session) threads() []dapThread {
+	if s.paused == nil {
+		return []dapThread{{ID: s.current, Name: threadName(s.current, "running")}}
+	}
+	if s.goroutines == nil {
+		var result struct {
+			Goroutines []goroutine
+		}
+		err := s.evaluateJSON(`{goroutines: $keys($goroutines).map(function(id) {
+  var g = $goroutines[id];
+  return {ID: g.id, State: g === $curGoroutine ? "running" : g.asleep ? g.waitReason : "runnable",
+    Frames: g.stack.map(function(f) { return f[0] + "\x00" + f[1]; })};
+})}`, &result)
+		if err == nil {
+			s.goroutines = result.Goroutines
+		}
+		if s.current == noGoroutine || err != nil {
+			s.goroutines = append([]goroutine{{ID: s.current, State: "running"}}, s.goroutines...)
+		}
+	}
+	threads := []dapThread{}
+	for _, g := range s.goroutines {
+		threads = append(threads, dapThread{ID: g.ID, Name: threadName(g.ID, g.State)})
+	}
+	return threads
+}
+
+func threadName(id int, state string) string {
+	if id == noGoroutine {
+		return "JavaScript [" + state + "]"
+	}
+	return fmt.Sprintf("goroutine %d [%s]", id, state)
+}
+
+func (s *Session) stackTrace(thread int) []dapStackFrame {
+	frames := []dapStackFrame{}
+	if s.paused == nil {
+		return frames
+	}
+	if thread == s.current {
+		for i := range s.paused.CallFrames {
+			cf := &s.paused.CallFrames[i]
+			if !s.scripts[cf.Location.ScriptID] {
+				continue // a frame of Node.js
+			}
+			loc, ok := s.location(cf)
+			frames = append(frames, s.newFrame(frame{callFrame: cf, loc: loc, mapped: ok}, cf.FunctionName))
+		}
+		return frames
+	}
+	s.threads()
+	for _, g := range s.goroutines {
+		if g.ID != thread {
+			continue
+		}
+		for _, f := range g.Frames {
+			parts := strings.SplitN(f, "\x00", 2)
+			var loc location
+			mapped := false
+			if i := strings.LastIndex(parts[1], ":"); i != -1 {
+				loc.File = parts[1][:i]
+				loc.Line, _ = strconv.Atoi(parts[1][i+1:])
+				mapped = true
+			}
+			frames = append(frames, s.newFrame(frame{loc: loc, mapped: mapped}, parts[0]))
+		}
+	}
+	return frames
+}
+
+func (s *Session) newFrame(f frame, name string) dapStackFrame {
+	s.frames = append(s.frames, f)
+	df := dapStackFrame{ID: len(s.frames), Name: name}
+	if !f.mapped {
+		df.PresentationHint = "subtle"
+		return df
+	}
+	if fn := s.prog.function(f.loc); fn != nil && f.callFrame != nil {
+		df.Name = fn.Name
+	}
+	if !f.loc.isGo() {
+		df.PresentationHint = "subtle"
+	}
+	df.Source = &dapSource{Name: filepath.Base(f.loc.File), Path: f.loc.File}
+	df.Line, df.Column = f.loc.Line, 1
+	return df
+}
+
+func (s *Session) frame(id int) *frame {
+	if id < 1 || id > len(s.frames) {
+		return nil
+	}
+	return &s.frames[id-1]
+}
+
+func (s *Session) newRef(r varRef) int {
+	s.refs = append(s.refs, r)
+	return len(s.refs)
+}
+
+// variables returns the variables of a scope or the elements of a value.
+func (s *Session) variables(ref int) ([]dapVariable, error) {
+	if ref < 1 || ref > len(s.refs) {
+		return nil, errors.New("unknown variables reference")
+	}
+	r := s.refs[ref-1]
+	if r.objectID == "" {
+		return s.locals(s.frame(r.frame))
+	}
+
+	objectID := r.objectID
+	if r.slice {
+		var result cdpEvaluateResult
+		err := s.cdp.call("Runtime.callFunctionOn", map[string]interface{}{
+			"objectId":            objectID,
+			"functionDeclaration": "function() { return Array.prototype.slice.call(this.$array, this.$offset, this.$offset + this.$length); }",
+		}, &result)
+		if err != nil {
+			return nil, err
+		}
+		objectID = result.Result.ObjectID
+	}
+	props, err := s.properties(objectID)
+	if err != nil {
+		return nil, err
+	}
+	vars := []dapVariable{}
+	for _, p := range props {
+		if p.Value == nil || strings.HasPrefix(p.Name, "$") || p.Name == "__proto__" || p.Name == "constructor" || p.Name == "length" {
+			continue
+		}
+		name := p.Name
+		if _, err := strconv.Atoi(name); err == nil {
+			name = "[" + name + "]"
+		}
+		vars = append(vars, s.variable(name, p.Value))
+	}
+	return vars, nil
+}
+
+// locals returns the variables in scope in a frame under their Go names.
+// Frames of code that has no name table show all variables that aren't
+// internal to GopherJS.
+func (s *Session) locals(f *frame) ([]dapVariable, error) {
+	names := s.prog.locals(f.loc)
+	vars := []dapVariable{}
+	seen := make(map[string]bool)
+	for _, scope := range f.callFrame.ScopeChain {
+		switch scope.Type {
+		case "local", "block", "catch", "closure":
+		default:
+			continue
+		}
+		props, err := s.properties(scope.Object.ObjectID)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range props {
+			if p.Value == nil {
+				continue
+			}
+			name, heap := p.Name, false
+			if names != nil {
+				v, ok := names[p.Name]
+				if !ok {
+					continue
+				}
+				name, heap = v.Go, v.Heap
+			} else if strings.HasPrefix(name, "$") {
+				continue
+			}
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			value := p.Value
+			if heap && value.ObjectID != "" {
+				elems, err := s.properties(value.ObjectID)
+				if err == nil && len(elems) != 0 && elems[0].Value != nil {
+					value = elems[0].Value
+				}
+			}
+			v := s.variable(name, value)
+			v.EvaluateName = name
+			vars = append(vars, v)
+		}
+	}
+	sort.Slice(vars, func(i, j int) bool { return vars[i].Name < vars[j].Name })
+	return vars, nil
+}
+
+func (s *Session) properties(objectID string) ([]cdpPropertyDescriptor, error) {
+	var result struct {
+		Result []cdpPropertyDescriptor `json:"result"`
+	}
+	err := s.cdp.call("Runtime.getProperties", map[string]interface{}{"objectId": objectID, "ownProperties": true}, &result)
+	return result.Result, err
+}
+
+// variable formats a value the way GopherJS represents Go values: strings
+// hold bytes, 64-bit integers are split into two halves and slices are views
+// of arrays.
+func (s *Session) variable(name string, obj *cdpRemoteObject) dapVariable {
+	v := dapVariable{Name: name, Value: obj.Description}
+	switch obj.Type {
+	case "undefined":
+		v.Value = "undefined"
+	case "string":
+		var str string
+		json.Unmarshal(obj.Value, &str)
+		v.Value = quoteBytes(str)
+	case "number", "boolean", "bigint":
+		if v.Value == "" {
+			v.Value = string(obj.Value)
+		}
+	case "function":
+		v.Value = "func"
+	case "object":
+		if obj.Subtype == "null" {
+			v.Value = "nil"
+			break
+		}
+		props, err := s.properties(obj.ObjectID)
+		if err != nil {
+			break
+		}
+		fields := make(map[string]*cdpRemoteObject)
+		for _, p := range props {
+			fields[p.Name] = p.Value
+		}
+		switch {
+		case isNumber(fields["$high"]) && isNumber(fields["$low"]):
+			high, _ := strconv.ParseInt(string(fields["$high"].Value), 10, 64)
+			low, _ := strconv.ParseUint(string(fields["$low"].Value), 10, 64)
+			v.Value = strconv.FormatInt(high<<32+int64(low), 10)
+			if high >= 1<<31 {
+				v.Value = strconv.FormatUint(uint64(high)<<32+low, 10)
+			}
+		case fields["$get"] != nil && fields["$get"].Type == "function":
+			var result cdpEvaluateResult
+			err := s.cdp.call("Runtime.callFunctionOn", map[string]interface{}{
+				"objectId":            obj.ObjectID,
+				"functionDeclaration": "function() { return this.$get(); }",
+			}, &result)
+			if err != nil || result.ExceptionDetails != nil {
+				v.Value = "nil"
+				break
+			}
+			elem := s.variable(name, &result.Result)
+			v.Value, v.VariablesReference = "&"+elem.Value, elem.VariablesReference
+		case isNumber(fields["$length"]) && fields["$array"] != nil:
+			v.Value = fmt.Sprintf("len: %s, cap: %s", fields["$length"].Value, fields["$capacity"].Value)
+			v.VariablesReference = s.newRef(varRef{objectID: obj.ObjectID, slice: true})
+		default:
+			if obj.Subtype == "" && v.Value == "Object" {
+				v.Value = summary(props)
+			}
+			v.VariablesReference = s.newRef(varRef{objectID: obj.ObjectID})
+		}
+	}
+	return v
+}
+
+// summary lists the fields of a struct with simple values, like {x: 1}.
+func summary(props []cdpPropertyDescriptor) string {
+	var fields []string
+	for _, p := range props {
+		if p.Value == nil || strings.HasPrefix(p.Name, "$") || p.Name == "__proto__" {
+			continue
+		}
+		value := "..."
+		switch p.Value.Type {
+		case "number", "boolean":
+			value = string(p.Value.Value)
+		case "string":
+			var str string
+			json.Unmarshal(p.Value.Value, &str)
+			value = quoteBytes(str)
+		}
+		fields = append(fields, p.Name+": "+value)
+	}
+	return "{" + strings.Join(fields, ", ") + "}"
+}
+
+func isNumber(obj *cdpRemoteObject) bool {
+	return obj != nil && obj.Type == "number"
+}
+
+// quoteBytes quotes a string of GopherJS, whose characters are the bytes of
+// the Go string.
+func quoteBytes(s string) string {
+	b := make([]byte, 0, len(s))
+	for _, r := range s {
+		if r > 0xff {
+			return strconv.Quote(s)
+		}
+		b = append(b, byte(r))
+	}
+	return strconv.Quote(string(b))
+}
+
+// evaluate evaluates expression in a frame, or in the top frame if frameID
+// is 0. Go names of local variables refer to their variables in the
+// generated code.
+func (s *Session) evaluate(expression string, frameID int) (dapVariable, error) {
+	if s.paused == nil {
+		return dapVariable{}, errors.New("the program is running")
+	}
+	f := s.frame(frameID)
+	if f == nil || f.callFrame == nil {
+		loc, ok := s.location(&s.paused.CallFrames[0])
+		f = &frame{callFrame: &s.paused.CallFrames[0], loc: loc, mapped: ok}
+	}
+	var result cdpEvaluateResult
+	err := s.cdp.call("Debugger.evaluateOnCallFrame", map[string]interface{}{
+		"callFrameId": f.callFrame.CallFrameID,
+		"expression":  s.jsExpr(expression, f.loc),
+		"silent":      true,
+	}, &result)
+	if err != nil {
+		return dapVariable{}, err
+	}
+	if d := result.ExceptionDetails; d != nil {
+		if d.Exception != nil && d.Exception.Description != "" {
+			return dapVariable{}, errors.New(strings.SplitN(d.Exception.Description, "\n", 2)[0])
+		}
+		return dapVariable{}, errors.New(d.Text)
+	}
+	return s.variable(expression, &result.Result), nil
+}
+
+// evaluateJSON evaluates a JavaScript expression in the top frame and decodes
+// its value.
+func (s *Session) evaluateJSON(expression string, v interface{}) error {
+	var result cdpEvaluateResult
+	err := s.cdp.call("Debugger.evaluateOnCallFrame", map[string]interface{}{
+		"callFrameId":   s.paused.CallFrames[0].CallFrameID,
+		"expression":    "(" + expression + ")",
+		"silent":        true,
+		"returnByValue": true,
+	}, &result)
+	if err != nil {
+		return err
+	}
+	if result.ExceptionDetails != nil {
+		return errors.New(result.ExceptionDetails.Text)
+	}
+	return json.Unmarshal(result.Result.Value, v)
+}
+
+var identifier = regexp.MustCompile(`\.?[\pL_][\pL\pN_]*`)
+
+// jsExpr replaces the Go names of the local variables at loc in expression
+// by their names in the generated code.
+func (s *Session) jsExpr(expression string, loc location) string {
+	names := make(map[string]string)
+	for jsName, v := range s.prog.locals(loc) {
+		if v.Heap {
+			jsName += "[0]"
+		}
+		names[v.Go] = jsName
+	}
+	return identifier.ReplaceAllStringFunc(expression, func(id string) string {
+		if jsName, ok := names[id]; ok {
+			return jsName
+		}
+		return id
+	})
+}
@@ -0,0 +1,196 @@
+package debugger
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/goplusjs/gopherjs/compiler"
+	"github.com/neelance/sourcemap"
+)
+
+// program relates the generated code of a program to its Go source, based on
+// its source map.
+type program struct {
+	// mappings are ordered by generated position.
+	mappings []*sourcemap.Mapping
+	// lines holds the mappings of each line of each source file.
+	lines     map[string]map[int][]*sourcemap.Mapping
+	functions []compiler.FuncNames
+}
+
+// location is a position in the Go source. Lines are one-based.
+type location struct {
+	File string
+	Line int
+}
+
+func (l location) isGo() bool {
+	return strings.HasSuffix(l.File, ".go")
+}
+
+func loadProgram(mapFile string) (*program, error) {
+	f, err := os.Open(mapFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var m struct {
+		sourcemap.Map
+		Functions []compiler.FuncNames `json:"x_gopherjs_functions"`
+		// Sections are set instead of the mappings if the program includes
+		// JavaScript files with source maps of their own.
+		Sections []struct {
+			Offset struct {
+				Line   int `json:"line"`
+				Column int `json:"column"`
+			} `json:"offset"`
+			Map *sourcemap.Map `json:"map"`
+		} `json:"sections"`
+	}
+	if err := json.NewDecoder(f).Decode(&m); err != nil {
+		return nil, err
+	}
+
+	p := &program{
+		mappings:  m.Map.DecodedMappings(),
+		lines:     make(map[string]map[int][]*sourcemap.Mapping),
+		functions: m.Functions,
+	}
+	for _, section := range m.Sections {
+		for _, mapping := range section.Map.DecodedMappings() {
+			if mapping.GeneratedLine == 1 {
+				mapping.GeneratedColumn += section.Offset.Column
+			}
+			mapping.GeneratedLine += section.Offset.Line
+			p.mappings = append(p.mappings, mapping)
+		}
+	}
+	sort.SliceStable(p.mappings, func(i, j int) bool {
+		a, b := p.mappings[i], p.mappings[j]
+		return a.GeneratedLine < b.GeneratedLine || a.GeneratedLine == b.GeneratedLine && a.GeneratedColumn < b.GeneratedColumn
+	})
+	for _, mapping := range p.mappings {
+		if mapping.OriginalFile == "" {
+			continue
+		}
+		lines := p.lines[mapping.OriginalFile]
+		if lines == nil {
+			lines = make(map[int][]*sourcemap.Mapping)
+			p.lines[mapping.OriginalFile] = lines
+		}
+		lines[mapping.OriginalLine] = append(lines[mapping.OriginalLine], mapping)
+	}
+	return p, nil
+}
+
+// lookup returns the Go position of the generated code at the given
+// zero-based line and column. It reports false for code that isn't mapped.
+func (p *program) lookup(line, column int) (location, bool) {
+	line++ // source maps count lines from one
+	i := sort.Search(len(p.mappings), func(i int) bool {
+		m := p.mappings[i]
+		return m.GeneratedLine > line || m.GeneratedLine == line && m.GeneratedColumn > column
+	})
+	if i == 0 || p.mappings[i-1].OriginalFile == "" {
+		return location{}, false
+	}
+	m := p.mappings[i-1]
+	return location{m.OriginalFile, m.OriginalLine}, true
+}
+
+// sourceFile returns the name of file in the source map, or "" if it has no
+// code.
+func (p *program) sourceFile(file string) string {
+	if _, ok := p.lines[file]; ok {
+		return file
+	}
+	file = filepath.ToSlash(filepath.Clean(file))
+	for name := range p.lines {
+		if name == file || strings.HasSuffix(file, "/"+name) || strings.HasSuffix(name, "/"+file) {
+			return name
+		}
+	}
+	return ""
+}
+
+// breakLocations returns the zero-based positions in the generated code to
+// break at for a breakpoint on line of file, which has to be a name in the
+// source map, and the line it ends up on. Lines without code move the
+// breakpoint to the next line of the same function that has some.
+func (p *program) breakLocations(file string, line int) ([]cdpLocation, int) {
+	end := line
+	if f := p.function(location{file, line}); f != nil {
+		end = f.EndLine
+	}
+	for ; line <= end; line++ {
+		mappings := p.lines[file][line]
+		if len(mappings) == 0 {
+			continue
+		}
+		// Break at the start of the code for the line on each generated line,
+		// e.g. in both versions of functions that are compiled twice.
+		first := make(map[int]int)
+		for _, m := range mappings {
+			if col, ok := first[m.GeneratedLine]; !ok || m.GeneratedColumn < col {
+				first[m.GeneratedLine] = m.GeneratedColumn
+			}
+		}
+		var locs []cdpLocation
+		for l, col := range first {
+			locs = append(locs, cdpLocation{LineNumber: l - 1, ColumnNumber: col})
+		}
+		sort.Slice(locs, func(i, j int) bool { return locs[i].LineNumber < locs[j].LineNumber })
+		return locs, line
+	}
+	return nil, 0
+}
+
+// function returns the innermost function that contains loc, or nil.
+func (p *program) function(loc location) *compiler.FuncNames {
+	var inner *compiler.FuncNames
+	for i := range p.functions {
+		f := &p.functions[i]
+		if f.File == loc.File && f.Line <= loc.Line && loc.Line <= f.EndLine && (inner == nil || f.Line >= inner.Line) {
+			inner = f
+		}
+	}
+	return inner
+}
+
+// locals returns the variables in scope at loc by their names in the
+// generated code, including those of enclosing functions. Variables that are
+// shadowed by others get their Go name in parentheses. It returns nil if loc
+// isn't in a known function.
+func (p *program) locals(loc location) map[string]compiler.VarName {
+	var vars []compiler.VarName
+	known := false
+	for _, f := range p.functions {
+		if f.File != loc.File || loc.Line < f.Line || f.EndLine < loc.Line {
+			continue
+		}
+		known = true
+		for _, v := range f.Vars {
+			if v.Line <= loc.Line && loc.Line <= v.EndLine {
+				vars = append(vars, v)
+			}
+		}
+	}
+	if !known {
+		return nil
+	}
+	// The variable declared last is the one a name refers to.
+	sort.SliceStable(vars, func(i, j int) bool { return vars[i].Line > vars[j].Line })
+	names := make(map[string]compiler.VarName)
+	seen := make(map[string]bool)
+	for _, v := range vars {
+		if seen[v.Go] {
+			v.Go = "(" + v.Go + ")"
+		}
+		seen[v.Go] = true
+		names[v.JS] = v
+	}
+	return names
+}
@@ -0,0 +1,218 @@
+package debugger
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/goplusjs/gopherjs/compiler"
+	"github.com/neelance/sourcemap"
+)
+
+// testProgram loads a program with the mappings:
+//
+//	generated 1:0  a.go:3
+//	generated 1:10 a.go:4
+//	generated 2:0  (none)
+//	generated 3:4  b.go:7
+//	generated 4:2  a.go:5
+//	generated 6:0  a.go:5
+//
+// and a function f in lines 2-10 of a.go with a function literal in lines 4-5.
+func testProgram(t *testing.T) *program {
+	m := &sourcemap.Map{Version: 3, File: "main.js"}
+	for _, mapping := range []sourcemap.Mapping{
+		{GeneratedLine: 1, GeneratedColumn: 0, OriginalFile: "a.go", OriginalLine: 3},
+		{GeneratedLine: 1, GeneratedColumn: 10, OriginalFile: "a.go", OriginalLine: 4},
+		{GeneratedLine: 2, GeneratedColumn: 0},
+		{GeneratedLine: 3, GeneratedColumn: 4, OriginalFile: "b.go", OriginalLine: 7},
+		{GeneratedLine: 6, GeneratedColumn: 0, OriginalFile: "a.go", OriginalLine: 5},
+		{GeneratedLine: 4, GeneratedColumn: 2, OriginalFile: "a.go", OriginalLine: 5},
+	} {
+		mapping := mapping
+		m.AddMapping(&mapping)
+	}
+	m.EncodeMappings()
+	data, err := json.Marshal(struct {
+		*sourcemap.Map
+		Functions []compiler.FuncNames `json:"x_gopherjs_functions"`
+	}{m, []compiler.FuncNames{
+		{Name: "main.f", File: "a.go", Line: 2, EndLine: 10, Vars: []compiler.VarName{
+			{JS: "x", Go: "x", Line: 2, EndLine: 10},
+			{JS: "x$1", Go: "x", Line: 6, EndLine: 8},
+		}},
+		{Name: "main.f.func1", File: "a.go", Line: 4, EndLine: 5, Vars: []compiler.VarName{
+			{JS: "y", Go: "y", Line: 4, EndLine: 5},
+		}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := ioutil.TempFile("", "program")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	p, err := loadProgram(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestProgramLookup(t *testing.T) {
+	p := testProgram(t)
+	for _, test := range []struct {
+		line, column int
+		want         location
+		ok           bool
+	}{
+		{0, 0, location{"a.go", 3}, true},
+		{0, 9, location{"a.go", 3}, true},
+		{0, 10, location{"a.go", 4}, true},
+		{1, 5, location{}, false},
+		{2, 0, location{}, false},
+		{2, 4, location{"b.go", 7}, true},
+		{2, 100, location{"b.go", 7}, true},
+		{3, 2, location{"a.go", 5}, true},
+	} {
+		if got, ok := p.lookup(test.line, test.column); got != test.want || ok != test.ok {
+			t.Errorf("lookup(%d, %d) = %v, %v, want %v, %v", test.line, test.column, got, ok, test.want, test.ok)
+		}
+	}
+}
+
+func TestProgramSourceFile(t *testing.T) {
+	p := testProgram(t)
+	for file, want := range map[string]string{
+		"a.go":                  "a.go",
+		"/home/gopher/src/b.go": "b.go",
+		"c.go":                  "",
+	} {
+		if got := p.sourceFile(file); got != want {
+			t.Errorf("sourceFile(%q) = %q, want %q", file, got, want)
+		}
+	}
+}
+
+func TestProgramBreakLocations(t *testing.T) {
+	p := testProgram(t)
+	for _, test := range []struct {
+		line     int
+		want     []cdpLocation
+		wantLine int
+	}{
+		// Line 2 has no code, so the breakpoint moves to the next line of f.
+		{2, []cdpLocation{{LineNumber: 0, ColumnNumber: 0}}, 3},
+		{4, []cdpLocation{{LineNumber: 0, ColumnNumber: 10}}, 4},
+		// Both copies of the code get a breakpoint.
+		{5, []cdpLocation{{LineNumber: 3, ColumnNumber: 2}, {LineNumber: 5, ColumnNumber: 0}}, 5},
+		{6, nil, 0},
+	} {
+		got, line := p.breakLocations("a.go", test.line)
+		if !reflect.DeepEqual(got, test.want) || line != test.wantLine {
+			t.Errorf("breakLocations(a.go, %d) = %v, %d, want %v, %d", test.line, got, line, test.want, test.wantLine)
+		}
+	}
+}
+
+func TestProgramLocals(t *testing.T) {
+	p := testProgram(t)
+	if f := p.function(location{"a.go", 4}); f == nil || f.Name != "main.f.func1" {
+		t.Errorf("got function %v at a.go:4, want main.f.func1", f)
+	}
+	if f := p.function(location{"b.go", 7}); f != nil {
+		t.Errorf("got function %v at b.go:7, want none", f)
+	}
+
+	for _, test := range []struct {
+		line int
+		want map[string]string
+	}{
+		{4, map[string]string{"x": "x", "y": "y"}},
+		{7, map[string]string{"x": "(x)", "x$1": "x"}},
+		{9, map[string]string{"x": "x"}},
+	} {
+		got := make(map[string]string)
+		for js, v := range p.locals(location{"a.go", test.line}) {
+			got[js] = v.Go
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("locals at a.go:%d = %v, want %v", test.line, got, test.want)
+		}
+	}
+	if locals := p.locals(location{"b.go", 7}); locals != nil {
+		t.Errorf("got locals %v outside of known functions", locals)
+	}
+}
+
+// Programs that include JavaScript files with source maps of their own have
+// index maps, whose sections start at an offset.
+func TestProgramSections(t *testing.T) {
+	section := func(mappings ...sourcemap.Mapping) *sourcemap.Map {
+		m := &sourcemap.Map{Version: 3}
+		for _, mapping := range mappings {
+			mapping := mapping
+			m.AddMapping(&mapping)
+		}
+		m.EncodeMappings()
+		return m
+	}
+	type offset struct {
+		Line   int `json:"line"`
+		Column int `json:"column"`
+	}
+	type indexSection struct {
+		Offset offset         `json:"offset"`
+		Map    *sourcemap.Map `json:"map"`
+	}
+	data, err := json.Marshal(map[string]interface{}{
+		"version": 3,
+		"sections": []indexSection{
+			{offset{0, 0}, section(sourcemap.Mapping{GeneratedLine: 1, GeneratedColumn: 0, OriginalFile: "a.go", OriginalLine: 3})},
+			{offset{2, 0}, section(sourcemap.Mapping{GeneratedLine: 2, GeneratedColumn: 4, OriginalFile: "x.ts", OriginalLine: 7})},
+			{offset{5, 2}, section(
+				sourcemap.Mapping{GeneratedLine: 1, GeneratedColumn: 0, OriginalFile: "a.go", OriginalLine: 4},
+				sourcemap.Mapping{GeneratedLine: 2, GeneratedColumn: 1, OriginalFile: "a.go", OriginalLine: 5},
+			)},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := ioutil.TempFile("", "program")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	p, err := loadProgram(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		line, column int
+		want         location
+	}{
+		{0, 5, location{"a.go", 3}},
+		{3, 4, location{"x.ts", 7}},
+		{5, 1, location{"x.ts", 7}},
+		{5, 2, location{"a.go", 4}},
+		{6, 1, location{"a.go", 5}},
+	} {
+		if got, _ := p.lookup(test.line, test.column); got != test.want {
+			t.Errorf("lookup(%d, %d) = %v, want %v", test.line, test.column, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package debugger
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestAcceptKey(t *testing.T) {
+	// The example of RFC 6455, section 1.3.
+	if got, want := acceptKey("dGhlIHNhbXBsZSBub25jZQ=="), "s3pPLMBiTxaQ9kYGzzhZRbK+xOo="; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+// serverFrame encodes an unmasked frame, as servers send them.
+func serverFrame(fin bool, opcode byte, payload []byte) []byte {
+	b := opcode
+	if fin {
+		b |= 0x80
+	}
+	buf := []byte{b}
+	switch n := len(payload); {
+	case n < 126:
+		buf = append(buf, byte(n))
+	case n <= 0xffff:
+		buf = append(buf, 126, byte(n>>8), byte(n))
+	default:
+		buf = append(buf, 127, 0, 0, 0, 0, byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+	}
+	return append(buf, payload...)
+}
+
+func TestWebSocket(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	long := bytes.Repeat([]byte("x"), 300)
+	huge := bytes.Repeat([]byte("y"), 70000)
+	type frame struct {
+		opcode  byte
+		payload string
+	}
+	received := make(chan []frame, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			received <- nil
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		req, err := http.ReadRequest(r)
+		if err != nil {
+			received <- nil
+			return
+		}
+		io.WriteString(conn, "HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\nSec-WebSocket-Accept: "+acceptKey(req.Header.Get("Sec-WebSocket-Key"))+"\r\n\r\n")
+
+		var out []byte
+		out = append(out, serverFrame(true, wsText, []byte("hello"))...)
+		out = append(out, serverFrame(false, wsText, []byte("ab"))...)
+		out = append(out, serverFrame(true, wsPing, []byte("p"))...)
+		out = append(out, serverFrame(true, wsContinuation, []byte("cd"))...)
+		out = append(out, serverFrame(true, wsText, long)...)
+		out = append(out, serverFrame(true, wsBinary, huge)...)
+		out = append(out, serverFrame(true, wsClose, nil)...)
+		conn.Write(out)
+
+		// The frames of the client are masked, which readFrame undoes.
+		client := &wsConn{conn: conn, r: r}
+		var frames []frame
+		for {
+			_, opcode, payload, err := client.readFrame()
+			if err != nil {
+				break
+			}
+			frames = append(frames, frame{opcode, string(payload)})
+			if opcode == wsClose {
+				break
+			}
+		}
+		received <- frames
+	}()
+
+	c, err := dialWebSocket("ws://" + l.Addr().String() + "/inspector")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if err := c.WriteMessage([]byte("hi")); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range [][]byte{[]byte("hello"), []byte("abcd"), long, huge} {
+		msg, err := c.ReadMessage()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(msg, want) {
+			t.Errorf("got message %.20q of length %d, want %.20q of length %d", msg, len(msg), want, len(want))
+		}
+	}
+	if _, err := c.ReadMessage(); err != io.EOF {
+		t.Errorf("got error %v after close, want EOF", err)
+	}
+
+	want := []frame{{wsText, "hi"}, {wsPong, "p"}, {wsClose, ""}}
+	if got := <-received; len(got) != len(want) || got[0] != want[0] || got[1] != want[1] || got[2] != want[2] {
+		t.Errorf("server got frames %v, want %v", got, want)
+	}
+}
+
+func TestWebSocketHandshakeError(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		http.ReadRequest(bufio.NewReader(conn))
+		io.WriteString(conn, "HTTP/1.1 404 Not Found\r\nContent-Length: 0\r\n\r\n")
+	}()
+	if _, err := dialWebSocket("ws://" + l.Addr().String() + "/"); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("got error %v, want a failed handshake", err)
+	}
+	if _, err := dialWebSocket("http://" + l.Addr().String() + "/"); err == nil {
+		t.Error("got no error for an http URL")
+	}
+}
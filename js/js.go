@@ -22,7 +22,11 @@
 //  | -                     | instanceof Node       | *js.Object                      |
 //  | maps, structs         | instanceof Object     | map[string]interface{}          |
 //
+// A Go function passed to JavaScript is called synchronously. If it blocks, for example on a channel operation or a call to Await, the call returns a Promise instead, which settles with the function's results once it finishes.
+//
 // Additionally, for a struct containing a *js.Object field, only the content of the field will be passed to JavaScript and vice versa.
+//
+// Other structs are passed as plain objects keyed by their exported field names. A field tag of the form `js:"name,omitempty"` changes the property name and, with omitempty, leaves the property out if the field has an empty value (as defined by encoding/json). Fields tagged `js:"-"` are skipped. The same mapping is used when a plain JavaScript object is converted to a struct or a pointer to a struct.
 package js
 
 // Object is a container for a native JavaScript object. Calls to its methods are treated specially by GopherJS and translated directly to their JavaScript syntax. A nil pointer to Object is equal to JavaScript's "null". Object can not be used as a map key.
@@ -140,13 +144,44 @@ func MakeWrapper(i interface{}) *Object {
 		if m.Get("pkg").String() != "" { // not exported
 			continue
 		}
-		o.Set(m.Get("name").String(), func(args ...*Object) *Object {
-			return Global.Call("$externalizeFunction", v.Get(m.Get("prop").String()), m.Get("typ"), true).Call("apply", v, args)
-		})
+		o.Set(m.Get("name").String(), Global.Call("$externalizeFunction", v.Get(m.Get("prop").String()).Call("bind", v), m.Get("typ"), false))
 	}
 	return o
 }
 
+// Await blocks the calling goroutine until the given JavaScript Promise settles. It returns the fulfillment value, or an *Error wrapping the rejection reason.
+func Await(p *Object) (*Object, error) {
+	type result struct {
+		value *Object
+		err   error
+	}
+	c := make(chan result, 1)
+	p.Call("then",
+		func(value *Object) { c <- result{value: value} },
+		func(reason *Object) { c <- result{err: &Error{reason}} },
+	)
+	r := <-c
+	return r.value, r.err
+}
+
+// NewPromise returns a JavaScript Promise that is settled by running fn in a new goroutine. The Promise is resolved with fn's value or rejected if fn returns a non-nil error. A JavaScript Error is passed as the rejection reason, using the wrapped object if the error is an *Error.
+func NewPromise(fn func() (interface{}, error)) *Object {
+	return Global.Get("Promise").New(func(resolve, reject *Object) {
+		go func() {
+			value, err := fn()
+			if err != nil {
+				if jsErr, ok := err.(*Error); ok {
+					reject.Invoke(jsErr.Object)
+					return
+				}
+				reject.Invoke(Global.Get("Error").New(err.Error()))
+				return
+			}
+			resolve.Invoke(value)
+		}()
+	})
+}
+
 // NewArrayBuffer creates a JavaScript ArrayBuffer from a byte slice.
 func NewArrayBuffer(b []byte) *Object {
 	slice := InternalObject(b)
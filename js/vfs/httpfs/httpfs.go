@@ -0,0 +1,58 @@
+// +build go1.16
+
+// Package httpfs turns an http.FileSystem into an fs.FS, so that it can be mounted with vfs.Mount:
+//
+//	vfs.Mount("/assets", httpfs.New(assets))
+//
+// This lets file systems generated for net/http, like those of vfsgen, be used by the os package in browsers.
+package httpfs
+
+import (
+	"io/fs"
+	"net/http"
+	"os"
+)
+
+// New returns an fs.FS that opens the files of hfs.
+func New(hfs http.FileSystem) fs.FS {
+	return fileSystem{hfs}
+}
+
+type fileSystem struct {
+	hfs http.FileSystem
+}
+
+func (f fileSystem) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	file, err := f.hfs.Open("/" + name)
+	if err != nil {
+		return nil, err
+	}
+	return dirFile{file}, nil
+}
+
+// dirFile adds the ReadDir method of fs.ReadDirFile to an http.File.
+type dirFile struct {
+	http.File
+}
+
+func (f dirFile) ReadDir(n int) ([]fs.DirEntry, error) {
+	infos, err := f.Readdir(n)
+	entries := make([]fs.DirEntry, len(infos))
+	for i, info := range infos {
+		entries[i] = dirEntry{info}
+	}
+	return entries, err
+}
+
+type dirEntry struct {
+	info os.FileInfo
+}
+
+func (e dirEntry) Name() string               { return e.info.Name() }
+func (e dirEntry) IsDir() bool                { return e.info.IsDir() }
+func (e dirEntry) Type() fs.FileMode          { return e.info.Mode().Type() }
+func (e dirEntry) Info() (fs.FileInfo, error) { return e.info, nil }
+
@@ -0,0 +1,79 @@
+// +build go1.16
+
+package vfs
+
+import (
+	"errors"
+	"io/fs"
+	"syscall"
+)
+
+// Mount makes the files of fsys available read-only under dir, which is created if needed, like an embed.FS that was built into the program. It enables the file system. Files are read from fsys when they're first needed.
+func Mount(dir string, fsys fs.FS) error {
+	Enable()
+	dir, err := active.abs(-1, dir)
+	if err != nil {
+		return err
+	}
+	if err := active.mkdirAll(dir); err != nil {
+		return err
+	}
+	d, err := active.lookup(dir)
+	if err != nil {
+		return err
+	}
+	d.mode = syscall.S_IFDIR | 0555
+	d.entries, d.src, d.name, d.loaded = nil, fsSource{fsys}, ".", false
+	return nil
+}
+
+// fsSource is the source of a mounted fs.FS.
+type fsSource struct {
+	fsys fs.FS
+}
+
+func (s fsSource) readDir(name string) ([]entry, error) {
+	list, err := fs.ReadDir(s.fsys, name)
+	if err != nil {
+		return nil, errno(err)
+	}
+	entries := make([]entry, len(list))
+	for i, e := range list {
+		info, err := e.Info()
+		if err != nil {
+			return nil, errno(err)
+		}
+		entries[i] = entry{
+			name: e.Name(),
+			dir:  e.IsDir(),
+			perm: uint32(info.Mode().Perm()),
+			size: info.Size(),
+		}
+		if t := info.ModTime(); !t.IsZero() {
+			entries[i].mtime = t.UnixNano()
+		}
+	}
+	return entries, nil
+}
+
+func (s fsSource) readFile(name string) ([]byte, error) {
+	data, err := fs.ReadFile(s.fsys, name)
+	if err != nil {
+		return nil, errno(err)
+	}
+	return data, nil
+}
+
+// errno converts an error of a mounted file system to an Errno.
+func errno(err error) syscall.Errno {
+	var e syscall.Errno
+	switch {
+	case errors.As(err, &e):
+		return e
+	case errors.Is(err, fs.ErrNotExist):
+		return syscall.ENOENT
+	case errors.Is(err, fs.ErrPermission):
+		return syscall.EACCES
+	}
+	return syscall.EIO
+}
@@ -0,0 +1,299 @@
+package vfs
+
+import (
+	"encoding/base64"
+	"path"
+	"sort"
+	"syscall"
+	"time"
+
+	"github.com/goplusjs/gopherjs/js"
+)
+
+// saveDelay is how long changes are collected before a persisted directory is saved.
+const saveDelay = 100 * time.Millisecond
+
+// A Storage keeps the contents of a directory between page loads. It's returned by LocalStorage or IndexedDB.
+type Storage interface {
+	load() ([]record, error)
+	save(records []record) error
+}
+
+// A record is a file or directory in a Storage.
+type record struct {
+	path  string // relative to the persisted directory
+	mode  uint32
+	mtime int64
+	data  []byte
+}
+
+type persisted struct {
+	dir     string
+	storage Storage
+	pending bool // whether a save is scheduled
+}
+
+// Persist keeps the contents of dir in s: what s holds is loaded into dir now, and dir is saved to s shortly after it changes. It creates dir if needed, and enables the file system.
+func Persist(dir string, s Storage) error {
+	Enable()
+	fs := active
+	dir, err := fs.abs(-1, dir)
+	if err != nil {
+		return err
+	}
+	records, err := s.load()
+	if err != nil {
+		return err
+	}
+	if err := fs.mkdirAll(dir); err != nil {
+		return err
+	}
+	for _, r := range records {
+		d, name, err := fs.lookupParent(path.Join(dir, r.path))
+		if err != nil {
+			return err
+		}
+		if d.readOnly() {
+			continue // mounted over
+		}
+		n := fs.newNode(r.mode&syscall.S_IFMT, r.mode&07777)
+		n.data, n.mtime = r.data, r.mtime
+		d.entries[name] = n
+	}
+	fs.persist = append(fs.persist, &persisted{dir: dir, storage: s})
+	return nil
+}
+
+// mkdirAll creates the directory at the absolute, clean path p and its parents.
+func (fs *fileSystem) mkdirAll(p string) error {
+	if p == "/" {
+		return nil
+	}
+	err := fs.mkdirAll(path.Dir(p))
+	if err == nil {
+		err = fs.Mkdirat(-1, p, 0777)
+	}
+	if err == syscall.EEXIST {
+		if n, _ := fs.lookup(p); n.isDir() {
+			return nil
+		}
+		return syscall.ENOTDIR
+	}
+	return err
+}
+
+// changed schedules saving the persisted directories that hold the absolute path p.
+func (fs *fileSystem) changed(p string) {
+	for _, d := range fs.persist {
+		if d.pending || !within(p, d.dir) {
+			continue
+		}
+		d.pending = true
+		go func(d *persisted) {
+			time.Sleep(saveDelay)
+			d.pending = false
+			if err := d.storage.save(fs.records(d.dir)); err != nil {
+				js.Global.Get("console").Call("error", "vfs: saving "+d.dir+": "+err.Error())
+			}
+		}(d)
+	}
+}
+
+// records returns the contents of the directory at the absolute, clean path dir, parents first.
+func (fs *fileSystem) records(dir string) []record {
+	d, err := fs.lookup(dir)
+	if err != nil || !d.isDir() {
+		return nil
+	}
+	var records []record
+	var walk func(d *node, rel string)
+	walk = func(d *node, rel string) {
+		names := make([]string, 0, len(d.entries))
+		for name := range d.entries {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			n := d.entries[name]
+			if n.readOnly() {
+				continue // mounted
+			}
+			p := path.Join(rel, name)
+			records = append(records, record{path: p, mode: n.mode, mtime: n.mtime, data: n.data})
+			if n.isDir() {
+				walk(n, p)
+			}
+		}
+	}
+	walk(d, "")
+	return records
+}
+
+// toJS converts records to JavaScript objects, with the data converted by data.
+func toJS(records []record, data func(b []byte) interface{}) []js.M {
+	objs := make([]js.M, len(records))
+	for i, r := range records {
+		objs[i] = js.M{
+			"path":  r.path,
+			"mode":  r.mode,
+			"mtime": float64(r.mtime) / 1e6, // milliseconds
+			"data":  data(r.data),
+		}
+	}
+	return objs
+}
+
+// fromJS converts JavaScript objects made by toJS back to records.
+func fromJS(objs *js.Object, data func(o *js.Object) ([]byte, error)) ([]record, error) {
+	records := make([]record, objs.Length())
+	for i := range records {
+		o := objs.Index(i)
+		b, err := data(o.Get("data"))
+		if err != nil {
+			return nil, err
+		}
+		records[i] = record{
+			path:  o.Get("path").String(),
+			mode:  uint32(o.Get("mode").Int()),
+			mtime: int64(o.Get("mtime").Float() * 1e6),
+			data:  b,
+		}
+	}
+	return records, nil
+}
+
+// catch calls f and returns the JavaScript error it throws, if any.
+func catch(f func()) (err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			jsErr, ok := e.(*js.Error)
+			if !ok {
+				panic(e)
+			}
+			err = jsErr
+		}
+	}()
+	f()
+	return nil
+}
+
+type localStorage struct {
+	key string
+}
+
+// LocalStorage returns a Storage that keeps a directory under key in the localStorage of the browser. It suits a few small files, since localStorage holds just some megabytes of text, and files are stored in base64.
+func LocalStorage(key string) Storage {
+	return localStorage{key}
+}
+
+func (s localStorage) storage() (*js.Object, error) {
+	storage := js.Global.Get("localStorage")
+	if storage == js.Undefined {
+		return nil, syscall.ENOSYS
+	}
+	return storage, nil
+}
+
+func (s localStorage) load() ([]record, error) {
+	storage, err := s.storage()
+	if err != nil {
+		return nil, err
+	}
+	item := storage.Call("getItem", s.key)
+	if item == nil {
+		return nil, nil
+	}
+	var objs *js.Object
+	if err := catch(func() { objs = js.Global.Get("JSON").Call("parse", item) }); err != nil {
+		return nil, err
+	}
+	return fromJS(objs, func(o *js.Object) ([]byte, error) {
+		return base64.StdEncoding.DecodeString(o.String())
+	})
+}
+
+func (s localStorage) save(records []record) error {
+	storage, err := s.storage()
+	if err != nil {
+		return err
+	}
+	objs := toJS(records, func(b []byte) interface{} {
+		return base64.StdEncoding.EncodeToString(b)
+	})
+	return catch(func() {
+		storage.Call("setItem", s.key, js.Global.Get("JSON").Call("stringify", objs))
+	})
+}
+
+type indexedDB struct {
+	name string
+	db   *js.Object
+}
+
+// IndexedDB returns a Storage that keeps a directory in the IndexedDB database of the browser with the given name.
+func IndexedDB(name string) Storage {
+	return &indexedDB{name: name}
+}
+
+const (
+	objectStore = "files"
+	snapshotKey = "snapshot"
+)
+
+// await waits for an IndexedDB request to succeed.
+func await(req *js.Object) (*js.Object, error) {
+	done := make(chan error, 1)
+	req.Set("onsuccess", func() { done <- nil })
+	req.Set("onerror", func() { done <- &js.Error{Object: req.Get("error")} })
+	if err := <-done; err != nil {
+		return nil, err
+	}
+	return req.Get("result"), nil
+}
+
+func (s *indexedDB) open() (*js.Object, error) {
+	if s.db != nil {
+		return s.db, nil
+	}
+	indexedDB := js.Global.Get("indexedDB")
+	if indexedDB == js.Undefined {
+		return nil, syscall.ENOSYS
+	}
+	req := indexedDB.Call("open", s.name, 1)
+	req.Set("onupgradeneeded", func() {
+		req.Get("result").Call("createObjectStore", objectStore)
+	})
+	db, err := await(req)
+	s.db = db
+	return db, err
+}
+
+func (s *indexedDB) load() ([]record, error) {
+	db, err := s.open()
+	if err != nil {
+		return nil, err
+	}
+	objs, err := await(db.Call("transaction", objectStore).Call("objectStore", objectStore).Call("get", snapshotKey))
+	if err != nil || objs == js.Undefined {
+		return nil, err
+	}
+	return fromJS(objs, func(o *js.Object) ([]byte, error) {
+		if o == nil {
+			return nil, nil
+		}
+		b := make([]byte, o.Length())
+		js.InternalObject(b).Set("$array", o)
+		return b, nil
+	})
+}
+
+func (s *indexedDB) save(records []record) error {
+	db, err := s.open()
+	if err != nil {
+		return err
+	}
+	// The data is copied by put, so the slices can be handed over as they are.
+	objs := toJS(records, func(b []byte) interface{} { return b })
+	_, err = await(db.Call("transaction", objectStore, "readwrite").Call("objectStore", objectStore).Call("put", objs, snapshotKey))
+	return err
+}
@@ -0,0 +1,20 @@
+package vfs
+
+import "syscall"
+
+func fillStat(st *syscall.Stat_t, n *node) {
+	*st = syscall.Stat_t{
+		Dev:  dev,
+		Ino:  n.ino,
+		Mode: n.mode,
+		Size: n.fileSize(),
+	}
+	st.Nlink = 1
+	if n.isDir() {
+		st.Nlink = 2
+	}
+	st.Blksize = blockSize
+	st.Blocks = (st.Size + 511) / 512
+	t := syscall.NsecToTimespec(n.mtime)
+	st.Atim, st.Mtim, st.Ctim = t, t, t
+}
@@ -0,0 +1,725 @@
+// Package vfs provides an in-memory file system for the os package, so that programs that read and write files work in browsers, where there is no file system to make system calls to.
+//
+// The file system is opt-in: once Enable is called, all file operations of the os package (and of the syscall calls it makes) go to the in-memory file system, also in Node.js. It starts out with empty / and /tmp directories:
+//
+//	func main() {
+//		vfs.Enable()
+//		os.MkdirAll("/home/user/.cache", 0755)
+//		...
+//	}
+//
+// Directories can be filled from other file systems, like an embed.FS, with Mount, and be kept in the localStorage or IndexedDB of the browser with Persist.
+//
+// Regular files and directories are supported, with owner permission bits, seeking and renaming; symbolic and hard links are not. Directories can only be listed in programs built for GOOS=linux, since the os package lists them with a different system call elsewhere.
+package vfs
+
+import (
+	"path"
+	"sort"
+	"syscall"
+	"time"
+
+	"github.com/goplusjs/gopherjs/js"
+)
+
+const (
+	// fdBase is the first file descriptor handed out, far from the ones that the system and package syscall use.
+	fdBase = 1 << 20
+	// dev is the device number of all files.
+	dev = 0x6673
+	// umask is applied to the permissions of new files and directories.
+	umask = 022
+	// blockSize is the preferred block size reported for files.
+	blockSize = 4096
+)
+
+// A node is a file or a directory.
+type node struct {
+	ino     uint64
+	mode    uint32 // syscall.S_IFREG or syscall.S_IFDIR, and the permission bits
+	data    []byte
+	entries map[string]*node // entries of a directory
+	mtime   int64            // Unix time in nanoseconds
+
+	// Nodes of a mounted file system are read-only and loaded on demand.
+	src    source
+	name   string // name within src
+	size   int64  // size before data is loaded
+	loaded bool   // whether data or entries are loaded
+}
+
+func (n *node) isDir() bool {
+	return n.mode&syscall.S_IFMT == syscall.S_IFDIR
+}
+
+func (n *node) readOnly() bool {
+	return n.src != nil
+}
+
+func (n *node) fileSize() int64 {
+	if n.src != nil && !n.loaded {
+		return n.size
+	}
+	return int64(len(n.data))
+}
+
+// load loads the data or entries of a node of a mounted file system.
+func (n *node) load(fs *fileSystem) error {
+	if n.src == nil || n.loaded {
+		return nil
+	}
+	if !n.isDir() {
+		data, err := n.src.readFile(n.name)
+		if err != nil {
+			return err
+		}
+		n.data, n.loaded = data, true
+		return nil
+	}
+	list, err := n.src.readDir(n.name)
+	if err != nil {
+		return err
+	}
+	n.entries = make(map[string]*node, len(list))
+	for _, e := range list {
+		c := fs.newNode(syscall.S_IFREG, e.perm)
+		if e.dir {
+			c.mode = syscall.S_IFDIR | e.perm
+		}
+		c.src, c.name, c.size, c.mtime = n.src, path.Join(n.name, e.name), e.size, e.mtime
+		n.entries[e.name] = c
+	}
+	n.loaded = true
+	return nil
+}
+
+// A source is a read-only file system that is mounted. Names are slash-separated and relative to the mount point, which is ".".
+type source interface {
+	readDir(name string) ([]entry, error)
+	readFile(name string) ([]byte, error)
+}
+
+// An entry describes a file of a source.
+type entry struct {
+	name  string
+	dir   bool
+	perm  uint32
+	size  int64
+	mtime int64
+}
+
+// A file is an open file description.
+type file struct {
+	node  *node
+	path  string
+	flags int
+	off   int64
+	names []string // directory entries to be read by ReadDirent
+}
+
+func (f *file) readable() bool {
+	return f.flags&syscall.O_ACCMODE != syscall.O_WRONLY
+}
+
+func (f *file) writable() bool {
+	return f.flags&syscall.O_ACCMODE != syscall.O_RDONLY
+}
+
+// fileSystem is the in-memory file system. Its exported methods are called by the syscall package, which finds it as $vfs.
+type fileSystem struct {
+	root    *node
+	cwd     string
+	files   map[int]*file
+	nextFd  int
+	nextIno uint64
+	persist []*persisted
+}
+
+// active is the file system once it's enabled.
+var active *fileSystem
+
+// Enable makes the os package use the in-memory file system. Only the first call has an effect.
+func Enable() {
+	if active != nil {
+		return
+	}
+	fs := &fileSystem{cwd: "/", files: make(map[int]*file), nextFd: fdBase}
+	fs.root = fs.newNode(syscall.S_IFDIR, 0755)
+	fs.root.entries["tmp"] = fs.newNode(syscall.S_IFDIR, 01777)
+	active = fs
+
+	// The syscall package gets the file system back with Interface.
+	o := js.Global.Get("Object").New()
+	o.Set("__internal_object__", js.InternalObject(fs))
+	js.Global.Set("$vfs", o)
+}
+
+func (fs *fileSystem) newNode(typ, perm uint32) *node {
+	fs.nextIno++
+	n := &node{ino: fs.nextIno, mode: typ | perm, mtime: time.Now().UnixNano()}
+	if typ == syscall.S_IFDIR {
+		n.entries = make(map[string]*node)
+	}
+	return n
+}
+
+// abs returns the absolute, clean form of p, which is relative to the directory open as dirfd, or to the working directory if dirfd is negative.
+func (fs *fileSystem) abs(dirfd int, p string) (string, error) {
+	if p == "" {
+		return "", syscall.ENOENT
+	}
+	if path.IsAbs(p) {
+		return path.Clean(p), nil
+	}
+	dir := fs.cwd
+	if dirfd >= 0 {
+		f := fs.files[dirfd]
+		if f == nil {
+			return "", syscall.EBADF
+		}
+		if !f.node.isDir() {
+			return "", syscall.ENOTDIR
+		}
+		dir = f.path
+	}
+	return path.Join(dir, p), nil
+}
+
+// lookup returns the node at the absolute, clean path p.
+func (fs *fileSystem) lookup(p string) (*node, error) {
+	n := fs.root
+	for p = p[1:]; p != ""; {
+		var name string
+		name, p = split(p)
+		if !n.isDir() {
+			return nil, syscall.ENOTDIR
+		}
+		if err := n.load(fs); err != nil {
+			return nil, err
+		}
+		if n = n.entries[name]; n == nil {
+			return nil, syscall.ENOENT
+		}
+	}
+	return n, nil
+}
+
+// split splits off the first element of a relative path.
+func split(p string) (first, rest string) {
+	for i := 0; i < len(p); i++ {
+		if p[i] == '/' {
+			return p[:i], p[i+1:]
+		}
+	}
+	return p, ""
+}
+
+// within reports whether the absolute, clean path p is dir or inside of it.
+func within(p, dir string) bool {
+	return p == dir || dir == "/" || len(p) > len(dir) && p[len(dir)] == '/' && p[:len(dir)] == dir
+}
+
+// lookupParent returns the directory that contains the absolute, clean path p, and the last element of p.
+func (fs *fileSystem) lookupParent(p string) (*node, string, error) {
+	if p == "/" {
+		return nil, "", syscall.EBUSY
+	}
+	dir, name := path.Split(p)
+	d, err := fs.lookup(path.Clean(dir))
+	if err != nil {
+		return nil, "", err
+	}
+	if !d.isDir() {
+		return nil, "", syscall.ENOTDIR
+	}
+	if err := d.load(fs); err != nil {
+		return nil, "", err
+	}
+	return d, name, nil
+}
+
+// writableDir returns an error unless entries can be added to or removed from d.
+func writableDir(d *node) error {
+	if d.readOnly() {
+		return syscall.EROFS
+	}
+	if d.mode&0200 == 0 {
+		return syscall.EACCES
+	}
+	return nil
+}
+
+// file returns the open file description of fd.
+func (fs *fileSystem) file(fd int) (*file, error) {
+	f := fs.files[fd]
+	if f == nil {
+		return nil, syscall.EBADF
+	}
+	return f, nil
+}
+
+// Owns reports whether fd is a file descriptor of the file system.
+func (fs *fileSystem) Owns(fd int) bool {
+	return fs.files[fd] != nil
+}
+
+func (fs *fileSystem) Openat(dirfd int, p string, flags int, perm uint32) (int, error) {
+	p, err := fs.abs(dirfd, p)
+	if err != nil {
+		return -1, err
+	}
+	n, err := fs.lookup(p)
+	switch {
+	case err == syscall.ENOENT && flags&syscall.O_CREAT != 0:
+		d, name, err := fs.lookupParent(p)
+		if err != nil {
+			return -1, err
+		}
+		if err := writableDir(d); err != nil {
+			return -1, err
+		}
+		n = fs.newNode(syscall.S_IFREG, perm&07777&^umask)
+		d.entries[name] = n
+		d.mtime = n.mtime
+		fs.changed(p)
+	case err != nil:
+		return -1, err
+	case flags&(syscall.O_CREAT|syscall.O_EXCL) == syscall.O_CREAT|syscall.O_EXCL:
+		return -1, syscall.EEXIST
+	case flags&syscall.O_DIRECTORY != 0 && !n.isDir():
+		return -1, syscall.ENOTDIR
+	case n.isDir() && flags&syscall.O_ACCMODE != syscall.O_RDONLY:
+		return -1, syscall.EISDIR
+	case flags&syscall.O_ACCMODE != syscall.O_WRONLY && n.mode&0400 == 0,
+		flags&syscall.O_ACCMODE != syscall.O_RDONLY && n.mode&0200 == 0:
+		return -1, syscall.EACCES
+	case flags&syscall.O_ACCMODE != syscall.O_RDONLY && n.readOnly():
+		return -1, syscall.EROFS
+	}
+	if flags&syscall.O_TRUNC != 0 && flags&syscall.O_ACCMODE != syscall.O_RDONLY {
+		n.data = nil
+		n.mtime = time.Now().UnixNano()
+		fs.changed(p)
+	}
+	if err := n.load(fs); err != nil {
+		return -1, err
+	}
+	fd := fs.nextFd
+	fs.nextFd++
+	fs.files[fd] = &file{node: n, path: p, flags: flags}
+	return fd, nil
+}
+
+func (fs *fileSystem) Close(fd int) error {
+	if _, err := fs.file(fd); err != nil {
+		return err
+	}
+	delete(fs.files, fd)
+	return nil
+}
+
+func (fs *fileSystem) Read(fd int, b []byte) (int, error) {
+	f, err := fs.file(fd)
+	if err != nil {
+		return 0, err
+	}
+	n, err := fs.Pread(fd, b, f.off)
+	f.off += int64(n)
+	return n, err
+}
+
+func (fs *fileSystem) Pread(fd int, b []byte, off int64) (int, error) {
+	f, err := fs.file(fd)
+	switch {
+	case err != nil:
+		return 0, err
+	case !f.readable():
+		return 0, syscall.EBADF
+	case f.node.isDir():
+		return 0, syscall.EISDIR
+	case off < 0:
+		return 0, syscall.EINVAL
+	case off >= int64(len(f.node.data)):
+		return 0, nil
+	}
+	return copy(b, f.node.data[off:]), nil
+}
+
+func (fs *fileSystem) Write(fd int, b []byte) (int, error) {
+	f, err := fs.file(fd)
+	if err != nil {
+		return 0, err
+	}
+	if f.flags&syscall.O_APPEND != 0 {
+		f.off = int64(len(f.node.data))
+	}
+	n, err := fs.Pwrite(fd, b, f.off)
+	f.off += int64(n)
+	return n, err
+}
+
+func (fs *fileSystem) Pwrite(fd int, b []byte, off int64) (int, error) {
+	f, err := fs.file(fd)
+	switch {
+	case err != nil:
+		return 0, err
+	case !f.writable():
+		return 0, syscall.EBADF
+	case off < 0:
+		return 0, syscall.EINVAL
+	}
+	n := f.node
+	if end := off + int64(len(b)); end > int64(len(n.data)) {
+		n.data = append(n.data, make([]byte, end-int64(len(n.data)))...)
+	}
+	copy(n.data[off:], b)
+	n.mtime = time.Now().UnixNano()
+	fs.changed(f.path)
+	return len(b), nil
+}
+
+func (fs *fileSystem) Seek(fd int, offset int64, whence int) (int64, error) {
+	f, err := fs.file(fd)
+	if err != nil {
+		return 0, err
+	}
+	switch whence {
+	case 0:
+	case 1:
+		offset += f.off
+	case 2:
+		offset += f.node.fileSize()
+	default:
+		return 0, syscall.EINVAL
+	}
+	if offset < 0 {
+		return 0, syscall.EINVAL
+	}
+	f.off = offset
+	if offset == 0 {
+		f.names = nil // rewinddir
+	}
+	return offset, nil
+}
+
+func (fs *fileSystem) Fstat(fd int, st *syscall.Stat_t) error {
+	f, err := fs.file(fd)
+	if err != nil {
+		return err
+	}
+	fillStat(st, f.node)
+	return nil
+}
+
+func (fs *fileSystem) Fstatat(dirfd int, p string, st *syscall.Stat_t) error {
+	p, err := fs.abs(dirfd, p)
+	if err != nil {
+		return err
+	}
+	n, err := fs.lookup(p)
+	if err != nil {
+		return err
+	}
+	fillStat(st, n)
+	return nil
+}
+
+func (fs *fileSystem) Mkdirat(dirfd int, p string, perm uint32) error {
+	p, err := fs.abs(dirfd, p)
+	if err != nil {
+		return err
+	}
+	d, name, err := fs.lookupParent(p)
+	if err != nil {
+		return err
+	}
+	if d.entries[name] != nil {
+		return syscall.EEXIST
+	}
+	if err := writableDir(d); err != nil {
+		return err
+	}
+	n := fs.newNode(syscall.S_IFDIR, perm&07777&^umask)
+	d.entries[name] = n
+	d.mtime = n.mtime
+	fs.changed(p)
+	return nil
+}
+
+// Unlinkat removes a file, or an empty directory if dir is set.
+func (fs *fileSystem) Unlinkat(dirfd int, p string, dir bool) error {
+	p, err := fs.abs(dirfd, p)
+	if err != nil {
+		return err
+	}
+	d, name, err := fs.lookupParent(p)
+	if err != nil {
+		return err
+	}
+	n := d.entries[name]
+	switch {
+	case n == nil:
+		return syscall.ENOENT
+	case dir && !n.isDir():
+		return syscall.ENOTDIR
+	case !dir && n.isDir():
+		return syscall.EISDIR
+	}
+	if err := writableDir(d); err != nil {
+		return err
+	}
+	if dir {
+		if err := n.load(fs); err != nil {
+			return err
+		}
+		if len(n.entries) != 0 {
+			return syscall.ENOTEMPTY
+		}
+	}
+	delete(d.entries, name)
+	d.mtime = time.Now().UnixNano()
+	fs.changed(p)
+	return nil
+}
+
+func (fs *fileSystem) Renameat(olddirfd int, oldpath string, newdirfd int, newpath string) error {
+	oldpath, err := fs.abs(olddirfd, oldpath)
+	if err != nil {
+		return err
+	}
+	newpath, err = fs.abs(newdirfd, newpath)
+	if err != nil {
+		return err
+	}
+	od, oname, err := fs.lookupParent(oldpath)
+	if err != nil {
+		return err
+	}
+	nd, nname, err := fs.lookupParent(newpath)
+	if err != nil {
+		return err
+	}
+	n := od.entries[oname]
+	if n == nil {
+		return syscall.ENOENT
+	}
+	if err := writableDir(od); err != nil {
+		return err
+	}
+	if err := writableDir(nd); err != nil {
+		return err
+	}
+	if oldpath == newpath {
+		return nil
+	}
+	if n.isDir() && within(newpath, oldpath) {
+		return syscall.EINVAL // into itself
+	}
+	if old := nd.entries[nname]; old != nil {
+		switch {
+		case n.isDir() && !old.isDir():
+			return syscall.ENOTDIR
+		case !n.isDir() && old.isDir():
+			return syscall.EISDIR
+		case old.isDir() && len(old.entries) != 0:
+			return syscall.ENOTEMPTY
+		}
+	}
+	delete(od.entries, oname)
+	nd.entries[nname] = n
+	od.mtime = time.Now().UnixNano()
+	nd.mtime = od.mtime
+	fs.changed(oldpath)
+	fs.changed(newpath)
+	return nil
+}
+
+// setattr looks up p and applies f to it.
+func (fs *fileSystem) setattr(p string, f func(n *node) error) error {
+	p, err := fs.abs(-1, p)
+	if err != nil {
+		return err
+	}
+	n, err := fs.lookup(p)
+	if err != nil {
+		return err
+	}
+	if n.readOnly() {
+		return syscall.EROFS
+	}
+	if err := f(n); err != nil {
+		return err
+	}
+	fs.changed(p)
+	return nil
+}
+
+// fsetattr applies f to the file open as fd.
+func (fs *fileSystem) fsetattr(fd int, f func(n *node) error) error {
+	file, err := fs.file(fd)
+	if err != nil {
+		return err
+	}
+	if file.node.readOnly() {
+		return syscall.EROFS
+	}
+	if err := f(file.node); err != nil {
+		return err
+	}
+	fs.changed(file.path)
+	return nil
+}
+
+func chmod(mode uint32) func(n *node) error {
+	return func(n *node) error {
+		n.mode = n.mode&^07777 | mode&07777
+		return nil
+	}
+}
+
+func truncate(size int64) func(n *node) error {
+	return func(n *node) error {
+		switch {
+		case n.isDir():
+			return syscall.EISDIR
+		case size < 0:
+			return syscall.EINVAL
+		case n.mode&0200 == 0:
+			return syscall.EACCES
+		case size <= int64(len(n.data)):
+			n.data = n.data[:size]
+		default:
+			n.data = append(n.data, make([]byte, size-int64(len(n.data)))...)
+		}
+		n.mtime = time.Now().UnixNano()
+		return nil
+	}
+}
+
+func (fs *fileSystem) Chmod(p string, mode uint32) error {
+	return fs.setattr(p, chmod(mode))
+}
+
+func (fs *fileSystem) Fchmod(fd int, mode uint32) error {
+	return fs.fsetattr(fd, chmod(mode))
+}
+
+func (fs *fileSystem) Truncate(p string, size int64) error {
+	return fs.setattr(p, truncate(size))
+}
+
+func (fs *fileSystem) Ftruncate(fd int, size int64) error {
+	if f, err := fs.file(fd); err == nil && !f.writable() {
+		return syscall.EINVAL
+	}
+	return fs.fsetattr(fd, truncate(size))
+}
+
+// UtimesNano sets the modification time of a file; the access time is not kept.
+func (fs *fileSystem) UtimesNano(p string, ts []syscall.Timespec) error {
+	if len(ts) != 2 {
+		return syscall.EINVAL
+	}
+	return fs.setattr(p, func(n *node) error {
+		n.mtime = ts[1].Nano()
+		return nil
+	})
+}
+
+func (fs *fileSystem) Chdir(p string) error {
+	p, err := fs.abs(-1, p)
+	if err != nil {
+		return err
+	}
+	n, err := fs.lookup(p)
+	if err != nil {
+		return err
+	}
+	if !n.isDir() {
+		return syscall.ENOTDIR
+	}
+	fs.cwd = p
+	return nil
+}
+
+func (fs *fileSystem) Fchdir(fd int) error {
+	f, err := fs.file(fd)
+	if err != nil {
+		return err
+	}
+	if !f.node.isDir() {
+		return syscall.ENOTDIR
+	}
+	fs.cwd = f.path
+	return nil
+}
+
+func (fs *fileSystem) Getwd() (string, error) {
+	return fs.cwd, nil
+}
+
+// ReadDirent reads directory entries in the format of the getdents64 system call of Linux.
+func (fs *fileSystem) ReadDirent(fd int, buf []byte) (int, error) {
+	f, err := fs.file(fd)
+	if err != nil {
+		return 0, err
+	}
+	d := f.node
+	if !d.isDir() {
+		return 0, syscall.ENOTDIR
+	}
+	if f.names == nil {
+		f.names = make([]string, 0, len(d.entries))
+		for name := range d.entries {
+			f.names = append(f.names, name)
+		}
+		sort.Strings(f.names)
+	}
+	n := 0
+	for len(f.names) != 0 {
+		name := f.names[0]
+		c := d.entries[name]
+		if c == nil { // removed since
+			f.names = f.names[1:]
+			continue
+		}
+		m := putDirent(buf[n:], c, name)
+		if m == 0 {
+			if n == 0 {
+				return 0, syscall.EINVAL
+			}
+			break
+		}
+		n += m
+		f.names = f.names[1:]
+	}
+	return n, nil
+}
+
+// putDirent writes a struct linux_dirent64 to b and returns its size, or 0 if it doesn't fit.
+func putDirent(b []byte, n *node, name string) int {
+	const nameOff = 19
+	size := (nameOff + len(name) + 1 + 7) &^ 7
+	if size > len(b) {
+		return 0
+	}
+	for i := range b[:size] {
+		b[i] = 0
+	}
+	putUint(b[0:8], n.ino)
+	putUint(b[8:16], 0)
+	putUint(b[16:18], uint64(size))
+	b[18] = 8 // DT_REG
+	if n.isDir() {
+		b[18] = 4 // DT_DIR
+	}
+	copy(b[nameOff:], name)
+	return size
+}
+
+// putUint stores v in b in little-endian byte order.
+func putUint(b []byte, v uint64) {
+	for i := range b {
+		b[i] = byte(v >> (8 * uint(i)))
+	}
+}
@@ -0,0 +1,224 @@
+// Package worker runs goroutines off the main JavaScript thread, inside a Web Worker in browsers or a worker_threads worker in Node.js.
+//
+// A worker loads the same compiled program as the page or process that starts it. Entry points are registered by name, usually from an init function, and main calls Main before doing anything else. In a worker, Main runs the entry point that was asked for and never returns; elsewhere it returns immediately:
+//
+//	func init() {
+//		worker.Handle("resize", resize)
+//	}
+//
+//	func resize(ch *worker.Channel) {
+//		var img Image
+//		for ch.Recv(&img) == nil {
+//			ch.Send(scale(img))
+//		}
+//	}
+//
+//	func main() {
+//		worker.Main()
+//		ch, err := worker.Start("resize")
+//		...
+//	}
+//
+// Values sent over a Channel are converted to JavaScript the same way as arguments of js.Object.Call and then copied with the structured clone algorithm of postMessage, so they can't contain functions. On the receiving side they are converted back to the type of the value passed to Recv.
+package worker
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/goplusjs/gopherjs/js"
+)
+
+// namePrefix marks the names of browser workers started by Start. The rest of the name is the entry point.
+const namePrefix = "gopherjs:"
+
+// ErrClosed is returned by Recv once the other side has closed the channel.
+var ErrClosed = errors.New("worker: channel closed")
+
+// ScriptURL is the script that is loaded by Start: the URL of the running script in browsers and its file name in Node.js. It is determined during package initialization and has to be set explicitly if that is not possible, e.g. because initialization blocked before this package was initialized.
+var ScriptURL = scriptURL()
+
+var (
+	entryPoints = make(map[string]func(ch *Channel))
+	entryPoint  string   // name of the entry point to run, if running in a worker
+	parent      *Channel // channel to the starting program, if running in a worker
+)
+
+func init() {
+	if require := js.Global.Get("require"); require != js.Undefined {
+		wt := require.Invoke("worker_threads")
+		if wt.Get("isMainThread").Bool() || wt.Get("workerData") == nil || wt.Get("workerData").Get("gopherjsWorker") == js.Undefined {
+			return
+		}
+		entryPoint = wt.Get("workerData").Get("gopherjsWorker").String()
+		port := wt.Get("parentPort")
+		parent = newChannel(port, func() { port.Call("close") })
+		port.Call("on", "message", parent.receive)
+		return
+	}
+	if js.Global.Get("importScripts") == js.Undefined { // not a Web Worker
+		return
+	}
+	if name := js.Global.Get("name").String(); strings.HasPrefix(name, namePrefix) {
+		entryPoint = name[len(namePrefix):]
+		parent = newChannel(js.Global, func() { js.Global.Call("close") })
+		js.Global.Set("onmessage", func(e *js.Object) { parent.receive(e.Get("data")) })
+	}
+}
+
+func scriptURL() string {
+	if js.Global.Get("require") != js.Undefined {
+		if js.Module == js.Undefined {
+			return ""
+		}
+		return js.Module.Get("filename").String()
+	}
+	if doc := js.Global.Get("document"); doc != js.Undefined {
+		if s := doc.Get("currentScript"); s != nil {
+			return s.Get("src").String()
+		}
+		return ""
+	}
+	if loc := js.Global.Get("location"); loc != js.Undefined {
+		return loc.Get("href").String()
+	}
+	return ""
+}
+
+// Handle registers fn as the entry point with the given name. It panics if the name is already registered.
+func Handle(name string, fn func(ch *Channel)) {
+	if _, dup := entryPoints[name]; dup {
+		panic("worker: entry point " + name + " registered twice")
+	}
+	entryPoints[name] = fn
+}
+
+// IsWorker reports whether the program was started by Start.
+func IsWorker() bool {
+	return parent != nil
+}
+
+// Main runs the requested entry point if the program was started by Start, closes the channel once the entry point returns and then blocks forever. Otherwise, it returns immediately.
+func Main() {
+	if parent == nil {
+		return
+	}
+	fn, ok := entryPoints[entryPoint]
+	if !ok {
+		panic("worker: no entry point named " + entryPoint)
+	}
+	fn(parent)
+	parent.Close()
+	select {}
+}
+
+// Start starts a worker running the entry point with the given name and returns a channel connected to it.
+func Start(name string) (*Channel, error) {
+	if ScriptURL == "" {
+		return nil, errors.New("worker: script URL is unknown, set ScriptURL")
+	}
+	if require := js.Global.Get("require"); require != js.Undefined {
+		w := require.Invoke("worker_threads").Get("Worker").New(ScriptURL, js.M{
+			"workerData": js.M{"gopherjsWorker": name},
+		})
+		c := newChannel(w, nil)
+		w.Call("on", "message", c.receive)
+		w.Call("on", "exit", c.markClosed)
+		return c, nil
+	}
+	if js.Global.Get("Worker") == js.Undefined {
+		return nil, errors.New("worker: Web Workers are not supported")
+	}
+	w := js.Global.Get("Worker").New(ScriptURL, js.M{"name": namePrefix + name})
+	c := newChannel(w, nil)
+	w.Set("onmessage", func(e *js.Object) { c.receive(e.Get("data")) })
+	return c, nil
+}
+
+// Channel passes values between a worker and the program that started it.
+type Channel struct {
+	port   *js.Object // has postMessage
+	end    func()     // called by Close, if not nil
+	queue  []*js.Object
+	notify chan struct{}
+	closed bool // no more messages will be received
+	sent   bool // close message was sent
+}
+
+func newChannel(port *js.Object, end func()) *Channel {
+	return &Channel{port: port, end: end, notify: make(chan struct{}, 1)}
+}
+
+// receive is called for every message from the other side.
+func (c *Channel) receive(msg *js.Object) {
+	if c.closed {
+		return
+	}
+	if msg.Get("close").Bool() {
+		c.markClosed()
+		return
+	}
+	c.queue = append(c.queue, msg.Get("v"))
+	c.wake()
+}
+
+// markClosed records that the other side is gone.
+func (c *Channel) markClosed() {
+	c.closed = true
+	c.wake()
+}
+
+func (c *Channel) wake() {
+	select {
+	case c.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Send sends v to the other side. It doesn't block. Send panics if the channel was closed by Close.
+func (c *Channel) Send(v interface{}) {
+	if c.sent {
+		panic("worker: send on closed channel")
+	}
+	c.port.Call("postMessage", js.M{"v": v})
+}
+
+// Recv blocks until a value arrives and stores it in the value pointed to by v. It returns ErrClosed after the other side closed the channel and all values sent before were received.
+func (c *Channel) Recv(v interface{}) error {
+	for len(c.queue) == 0 {
+		if c.closed {
+			return ErrClosed
+		}
+		<-c.notify
+	}
+	msg := c.queue[0]
+	c.queue[0] = nil
+	c.queue = c.queue[1:]
+
+	p := js.InternalObject(v)
+	t := p.Get("constructor")
+	if t.Get("kind").Int() != js.Global.Get("$kindPtr").Int() || p == t.Get("nil") {
+		panic("worker: Recv of non-pointer or nil pointer")
+	}
+	elem := t.Get("elem")
+	value := js.Global.Call("$internalize", msg, elem)
+	switch elem.Get("kind").Int() {
+	case js.Global.Get("$kindStruct").Int(), js.Global.Get("$kindArray").Int():
+		elem.Call("copy", p, value)
+	default:
+		p.Call("$set", value)
+	}
+	return nil
+}
+
+// Close tells the other side that no more values will be sent. In a worker, it also lets the worker exit.
+func (c *Channel) Close() {
+	if c.sent {
+		return
+	}
+	c.sent = true
+	c.port.Call("postMessage", js.M{"close": true})
+	if c.end != nil {
+		c.end()
+	}
+}
@@ -0,0 +1,22 @@
+// Package nosync provides lightweight versions of the types of package sync
+// for GopherJS, where all goroutines run on a single thread.
+//
+// GopherJS redirects the imports of sync of some standard library packages
+// (see build.NoSyncPackages, or the --nosync flag of the gopherjs command)
+// to this package. Its locks are taken without involving the scheduler when
+// they are free and park the calling goroutine when they aren't, so they
+// work both in code called from JavaScript and in goroutines that contend for
+// them. Once and Pool don't block at all.
+//
+// A goroutine can only find a lock taken if the goroutine that holds it was
+// suspended inside its critical section. Lock and RLock wait in a function
+// that the compiler treats specially when it links a program: it only counts
+// as blocking if some function that takes one of these locks blocks. In
+// programs where none does, which covers log.Print, rand.Intn and the like
+// unless they call blocking code, the locks and their callers are compiled as
+// plain functions. Otherwise they are compiled as blocking functions, which
+// can be suspended and resumed, but are several times larger and somewhat
+// slower. A lock that is held when the function that took it returns, and is
+// released by its caller, isn't seen as held across the blocking calls in
+// between. WaitGroup.Wait always blocks.
+package nosync
@@ -1,16 +1,51 @@
 package nosync
 
-// Mutex is a dummy which is non-blocking.
+// GopherJS runs goroutines on a single thread and only switches between them
+// when one blocks, so the locks below only need channels to park goroutines
+// that find them taken. The locks wait in park, which the compiler treats
+// specially: calls of it only block if a function that takes a lock can be
+// suspended while it holds it. Otherwise, no goroutine ever finds a lock
+// taken, and the callers of the locks are compiled as non-blocking functions
+// (see the package documentation).
+//
+// A lock that is released is handed over to the goroutine that waited
+// longest. Waiters are counted right before they park, and nothing can run in
+// between, so whenever a waiter is counted there is a goroutine receiving
+// from the channel.
+
+// park waits until the lock that ch belongs to is handed over.
+func park(ch chan struct{}) {
+	<-ch
+}
+
+// wake hands a lock over to a goroutine that waits on ch.
+func wake(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+		panic("nosync: no goroutine waiting for the lock")
+	}
+}
+
+// Mutex is a mutual exclusion lock.
 type Mutex struct {
-	locked bool
+	locked  bool
+	waiters int
+	ch      chan struct{}
 }
 
-// Lock locks m. It is a run-time error if m is already locked.
+// Lock locks m. If the lock is already in use, the calling goroutine blocks
+// until the mutex is available.
 func (m *Mutex) Lock() {
-	if m.locked {
-		panic("nosync: mutex is already locked")
+	if !m.locked {
+		m.locked = true
+		return
+	}
+	if m.ch == nil {
+		m.ch = make(chan struct{})
 	}
-	m.locked = true
+	m.waiters++
+	park(m.ch) // Unlock leaves m locked for us.
 }
 
 // Unlock unlocks m. It is a run-time error if m is not locked.
@@ -18,21 +53,37 @@ func (m *Mutex) Unlock() {
 	if !m.locked {
 		panic("nosync: unlock of unlocked mutex")
 	}
-	m.locked = false
+	if m.waiters == 0 {
+		m.locked = false
+		return
+	}
+	m.waiters--
+	wake(m.ch)
 }
 
-// RWMutex is a dummy which is non-blocking.
+// RWMutex is a reader/writer mutual exclusion lock. A blocked Lock call
+// excludes new readers from acquiring the lock.
 type RWMutex struct {
 	writeLocked     bool
 	readLockCounter int
+	writers         int // goroutines waiting in Lock
+	readers         int // goroutines waiting in RLock
+	writerCh        chan struct{}
+	readerCh        chan struct{}
 }
 
-// Lock locks m for writing. It is a run-time error if rw is already locked for reading or writing.
+// Lock locks rw for writing. If the lock is already locked for reading or
+// writing, Lock blocks until the lock is available.
 func (rw *RWMutex) Lock() {
-	if rw.readLockCounter != 0 || rw.writeLocked {
-		panic("nosync: mutex is already locked")
+	if !rw.writeLocked && rw.readLockCounter == 0 {
+		rw.writeLocked = true
+		return
+	}
+	if rw.writerCh == nil {
+		rw.writerCh = make(chan struct{})
 	}
-	rw.writeLocked = true
+	rw.writers++
+	park(rw.writerCh) // Unlock or RUnlock locks rw for us.
 }
 
 // Unlock unlocks rw for writing. It is a run-time error if rw is not locked for writing.
@@ -41,14 +92,32 @@ func (rw *RWMutex) Unlock() {
 		panic("nosync: unlock of unlocked mutex")
 	}
 	rw.writeLocked = false
+	switch {
+	case rw.readers != 0:
+		// Readers that wait got there before any new writer, so they go first.
+		rw.readLockCounter += rw.readers
+		for ; rw.readers != 0; rw.readers-- {
+			wake(rw.readerCh)
+		}
+	case rw.writers != 0:
+		rw.writers--
+		rw.writeLocked = true
+		wake(rw.writerCh)
+	}
 }
 
-// RLock locks m for reading. It is a run-time error if rw is already locked for reading or writing.
+// RLock locks rw for reading. It blocks while rw is locked for writing or a
+// writer waits for it.
 func (rw *RWMutex) RLock() {
-	if rw.writeLocked {
-		panic("nosync: mutex is already locked")
+	if !rw.writeLocked && rw.writers == 0 {
+		rw.readLockCounter++
+		return
+	}
+	if rw.readerCh == nil {
+		rw.readerCh = make(chan struct{})
 	}
-	rw.readLockCounter++
+	rw.readers++
+	park(rw.readerCh) // Unlock counts us as a reader.
 }
 
 // RUnlock undoes a single RLock call; it does not affect other simultaneous readers. It is a run-time error if rw is not locked for reading.
@@ -57,19 +126,33 @@ func (rw *RWMutex) RUnlock() {
 		panic("nosync: unlock of unlocked mutex")
 	}
 	rw.readLockCounter--
+	if rw.readLockCounter == 0 && rw.writers != 0 {
+		rw.writers--
+		rw.writeLocked = true
+		wake(rw.writerCh)
+	}
 }
 
-// WaitGroup is a dummy which is non-blocking.
+// WaitGroup waits for a collection of goroutines to finish.
 type WaitGroup struct {
 	counter int
+	waiters int
+	ch      chan struct{}
 }
 
-// Add adds delta, which may be negative, to the WaitGroup If the counter goes negative, Add panics.
+// Add adds delta, which may be negative, to the WaitGroup counter. If the
+// counter becomes zero, all goroutines blocked on Wait are released. If the
+// counter goes negative, Add panics.
 func (wg *WaitGroup) Add(delta int) {
 	wg.counter += delta
 	if wg.counter < 0 {
 		panic("sync: negative WaitGroup counter")
 	}
+	if wg.counter == 0 {
+		for ; wg.waiters != 0; wg.waiters-- {
+			wake(wg.ch)
+		}
+	}
 }
 
 // Done decrements the WaitGroup counter.
@@ -77,9 +160,14 @@ func (wg *WaitGroup) Done() {
 	wg.Add(-1)
 }
 
-// Wait panics if the WaitGroup counter is not zero.
+// Wait blocks until the WaitGroup counter is zero.
 func (wg *WaitGroup) Wait() {
-	if wg.counter != 0 {
-		panic("sync: WaitGroup counter not zero")
+	if wg.counter == 0 {
+		return
+	}
+	if wg.ch == nil {
+		wg.ch = make(chan struct{})
 	}
+	wg.waiters++
+	<-wg.ch
 }
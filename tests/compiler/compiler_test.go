@@ -0,0 +1,607 @@
+package compiler_test
+
+import (
+	"bytes"
+	"fmt"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/goplusjs/gopherjs/compiler"
+	"github.com/goplusjs/gopherjs/js"
+)
+
+// newBundle returns a bundle with a minimal runtime and a package
+// example.com/greet, which went through Write and ReadBundle.
+func newBundle(t *testing.T) *compiler.Bundle {
+	b := compiler.NewBundle()
+	if _, err := b.Compile("runtime", map[string][]byte{
+		"runtime.go": []byte("package runtime\n"),
+	}, compiler.Options{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.Compile("example.com/greet", map[string][]byte{
+		"greet.go": []byte(`package greet
+
+func Hello(name string) string {
+	return "hello, " + name
+}
+`),
+	}, compiler.Options{}); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := b.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	b, err := compiler.ReadBundle(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := strings.Join(b.Packages(), " "), "example.com/greet runtime"; got != want {
+		t.Fatalf("got packages %q, want %q", got, want)
+	}
+	return b
+}
+
+func TestBundleCompile(t *testing.T) {
+	for _, minify := range []bool{false, true} {
+		b := newBundle(t)
+		main, err := b.Compile("main", map[string][]byte{
+			"main.go": []byte(`package main
+
+import "example.com/greet"
+
+func main() {
+	println(greet.Hello(name))
+}
+`),
+			"name.go": []byte("package main\n\nvar name = \"bundle\"\n"),
+		}, compiler.Options{Minify: minify})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var code bytes.Buffer
+		if err := b.WriteProgram(main, &compiler.SourceMapFilter{Writer: &code}); err != nil {
+			t.Fatal(err)
+		}
+		if runtime.GOARCH != "js" {
+			continue
+		}
+
+		var logged []string
+		console := js.M{
+			"log":   func(s string) { logged = append(logged, s) },
+			"error": func(s string) { logged = append(logged, s) },
+		}
+		js.Global.Get("Function").New("console", code.String()).Invoke(console)
+		if got, want := strings.Join(logged, "\n"), "hello, bundle"; got != want {
+			t.Errorf("minify %v: got output %q, want %q", minify, got, want)
+		}
+	}
+}
+
+func TestBundleCompileErrors(t *testing.T) {
+	b := newBundle(t)
+	_, err := b.Compile("main", map[string][]byte{
+		"main.go": []byte("package main\n\nfunc main() {\n\tundefined()\n}\n"),
+	}, compiler.Options{})
+	list, ok := err.(compiler.ErrorList)
+	if !ok || len(list) != 1 {
+		t.Fatalf("got error %v, want an ErrorList of one", err)
+	}
+	if e := list.Errors()[0]; e.File != "main.go" || e.Line != 4 || e.Category != compiler.TypeError {
+		t.Errorf("got error %+v, want a type error in main.go:4", e)
+	}
+
+	_, err = b.Compile("main", map[string][]byte{
+		"main.go": []byte("package main\n\nimport \"fmt\"\n\nfunc main() {\n\tfmt.Println()\n}\n"),
+	}, compiler.Options{})
+	if err == nil || !strings.Contains(err.Error(), `cannot find package "fmt" in the bundle`) {
+		t.Errorf("got error %v, want a missing package", err)
+	}
+}
+
+func TestBundleInline(t *testing.T) {
+	b := newBundle(t)
+	if _, err := b.Compile("example.com/geom", map[string][]byte{
+		"geom.go": []byte("package geom\n\nfunc Double(x int) int { return x * 2 }\n"),
+	}, compiler.Options{Inline: true}); err != nil {
+		t.Fatal(err)
+	}
+	// Inline sources of imported functions come from their archives.
+	var buf bytes.Buffer
+	if err := b.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	b, err := compiler.ReadBundle(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// "gopherjs build -l" compiles with Inline false.
+	for _, inline := range []bool{false, true} {
+		main, err := b.Compile("main", map[string][]byte{
+			"main.go": []byte("package main\n\nimport \"example.com/geom\"\n\nvar n = 21\n\nfunc main() {\n\tprintln(geom.Double(n))\n}\n"),
+		}, compiler.Options{Inline: inline})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var code bytes.Buffer
+		if err := b.WriteProgram(main, &compiler.SourceMapFilter{Writer: &code}); err != nil {
+			t.Fatal(err)
+		}
+		if called := strings.Contains(code.String(), "geom.Double(n)"); called == inline {
+			t.Errorf("inline %v: got call of geom.Double %v in\n%s", inline, called, code.String())
+		}
+	}
+}
+
+// Minified programs have package keys and type names shortened after their
+// source map positions were recorded. Positions have to remain correct.
+func TestMinifiedSourceMap(t *testing.T) {
+	b := compiler.NewBundle()
+	if _, err := b.Compile("runtime", map[string][]byte{
+		"runtime.go": []byte("package runtime\n"),
+	}, compiler.Options{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.Compile("example.com/greet", map[string][]byte{
+		"greet.go": []byte("package greet\n\ntype T struct{ s string }\n\nfunc Hello(name string) string {\n\treturn \"hello, \" + T{name}.s\n}\n"),
+	}, compiler.Options{Minify: true}); err != nil {
+		t.Fatal(err)
+	}
+	main, err := b.Compile("main", map[string][]byte{
+		"main.go": []byte("package main\n\nimport \"example.com/greet\"\n\nfunc main() {\n\tprintln(greet.Hello(\"x\"))\n}\n"),
+	}, compiler.Options{Minify: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var code bytes.Buffer
+	type mapping struct {
+		line, column int
+		pos          token.Position
+	}
+	var mappings []mapping
+	if err := b.WriteProgram(main, &compiler.SourceMapFilter{
+		Writer: &code,
+		MappingCallback: func(line, column int, pos token.Position) {
+			mappings = append(mappings, mapping{line, column, pos})
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(code.String(), `$packages["a"]`) || strings.Contains(code.String(), `"example.com/greet.T"`) {
+		t.Fatal("package keys and type names weren't shortened")
+	}
+
+	want := map[string]string{
+		"greet.go:6": `return"hello, "+new `,
+		"main.go:6":  `console.log(`,
+	}
+	lines := strings.Split(code.String(), "\n")
+	found := 0
+	for _, m := range mappings {
+		prefix, ok := want[fmt.Sprintf("%s:%d", m.pos.Filename, m.pos.Line)]
+		if !ok {
+			continue
+		}
+		found++
+		if got := lines[m.line-1][m.column:]; !strings.HasPrefix(got, prefix) {
+			t.Errorf("%s maps to %.30q, want %q", m.pos, got, prefix)
+		}
+	}
+	if found != len(want) {
+		t.Errorf("got %d mappings for %v", found, want)
+	}
+}
+
+// Variable names are only recorded with DebugInfo, and only for functions that
+// are left after dead code elimination.
+func TestDebugInfo(t *testing.T) {
+	for _, debugInfo := range []bool{false, true} {
+		b := newBundle(t)
+		main, err := b.Compile("main", map[string][]byte{
+			"main.go": []byte("package main\n\nfunc used(n int) int {\n\tm := n * 2\n\treturn m\n}\n\nfunc unused(n int) int {\n\treturn n\n}\n\nfunc main() {\n\tprintln(used(1))\n}\n"),
+		}, compiler.Options{DebugInfo: debugInfo})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		if err := b.WriteProgram(main, &compiler.SourceMapFilter{
+			Writer: new(bytes.Buffer),
+			FunctionCallback: func(f compiler.FuncNames) {
+				var vars []string
+				for _, v := range f.Vars {
+					vars = append(vars, v.Go)
+				}
+				names = append(names, f.Name+"("+strings.Join(vars, " ")+")")
+			},
+		}); err != nil {
+			t.Fatal(err)
+		}
+		want := ""
+		if debugInfo {
+			want = "main.used(n m) main.main()"
+		}
+		if got := strings.Join(names, " "); got != want {
+			t.Errorf("debug info %v: got functions %q, want %q", debugInfo, got, want)
+		}
+	}
+}
+
+// Diagnose reports the decisions of the escape analysis. A local of a loop
+// body whose address is only passed to a function that doesn't keep it stays a
+// plain variable; one whose address is stored in a global is boxed.
+func TestDiagnoseEscapes(t *testing.T) {
+	b := newBundle(t)
+	var diags []string
+	main, err := b.Compile("main", map[string][]byte{
+		"main.go": []byte(`package main
+
+var global *int
+
+func inc(p *int) {
+	*p++
+}
+
+func keep(p *int) {
+	global = p
+}
+
+func main() {
+	for i := 0; i < 2; i++ {
+		local := i
+		inc(&local)
+		kept := i
+		keep(&kept)
+		println(local, *global)
+	}
+}
+`),
+	}, compiler.Options{Diagnose: func(pos token.Position, msg string) {
+		diags = append(diags, fmt.Sprintf("%s:%d:%d: %s", pos.Filename, pos.Line, pos.Column, msg))
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"main.go:5:10: p does not escape",
+		"main.go:9:11: leaking param: p",
+		"main.go:16:7: &local does not escape",
+		"main.go:17:3: moved to heap: kept",
+	}
+	if got := strings.Join(diags, "\n"); got != strings.Join(want, "\n") {
+		t.Errorf("got diagnostics\n%s\nwant\n%s", got, strings.Join(want, "\n"))
+	}
+
+	var code bytes.Buffer
+	if err := b.WriteProgram(main, &compiler.SourceMapFilter{Writer: &code}); err != nil {
+		t.Fatal(err)
+	}
+	body := funcCode(t, code.String(), "\tmain")
+	if strings.Contains(body, "local = [local]") || !strings.Contains(body, "kept = [kept]") {
+		t.Errorf("got main\n%s\nwant only kept boxed", body)
+	}
+	if out, ok := runNode(t, code.Bytes()); ok && out != "1 0\n2 1\n" {
+		t.Errorf("got output %q", out)
+	}
+}
+
+// methodBundle returns a bundle with a minimal runtime and minimal js, reflect
+// and time packages, enough to see which methods dead code elimination keeps.
+func methodBundle(t *testing.T) *compiler.Bundle {
+	b := compiler.NewBundle()
+	for _, pkg := range []struct{ path, src string }{
+		{"runtime", "package runtime\n"},
+		{"github.com/gopherjs/gopherjs/js", `package js
+
+type Object struct{ object *Object }
+
+func (o *Object) Set(key string, value interface{}) { o.object.Set(key, value) }
+
+var Global *Object
+`},
+		{"reflect", `package reflect
+
+type Value struct{ i interface{} }
+
+func ValueOf(i interface{}) Value { return Value{i} }
+
+func (v Value) MethodByName(name string) Value { return v }
+`},
+		{"time", `package time
+
+type Time struct{ sec int64 }
+
+func Unix(sec int64, nsec int64) Time { return Time{sec} }
+
+func (t Time) UnixNano() int64 { return t.sec * 1e9 }
+`},
+	} {
+		if _, err := b.Compile(pkg.path, map[string][]byte{"a.go": []byte(pkg.src)}, compiler.Options{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return b
+}
+
+// runNode runs code with Node.js, if it is installed, and returns its output.
+func runNode(t *testing.T, code []byte) (string, bool) {
+	if _, err := exec.LookPath("node"); err != nil {
+		return "", false
+	}
+	dir, err := ioutil.TempDir("", "gopherjs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	f := filepath.Join(dir, "main.js")
+	if err := ioutil.WriteFile(f, code, 0644); err != nil {
+		t.Fatal(err)
+	}
+	out, err := exec.Command("node", f).CombinedOutput()
+	if err != nil {
+		t.Fatalf("node: %v\n%s", err, out)
+	}
+	return string(out), true
+}
+
+// Exported methods are left out unless they are called, looked up by a
+// reflect.Value.MethodByName of their name or by a name that isn't constant,
+// or called by the prelude. These are separate programs: a single lookup by a
+// name that isn't constant keeps all exported methods of a program.
+func TestMethodElimination(t *testing.T) {
+	for _, test := range []struct {
+		name string // the argument of MethodByName
+		kept []string
+	}{
+		{`"Reflected"`, []string{"Used", "Reflected"}},
+		{`name`, []string{"Used", "Reflected", "Unreachable"}},
+	} {
+		b := methodBundle(t)
+		main, err := b.Compile("main", map[string][]byte{
+			"main.go": []byte(`package main
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+type T struct{}
+
+func (T) Used() string        { return "used" }
+func (T) Reflected() string   { return "reflected" }
+func (T) Unreachable() string { return "unreachable" }
+
+var name = "Reflected"
+
+func main() {
+	js.Global.Set("when", time.Unix(2, 0))
+	reflect.ValueOf(T{}).MethodByName(` + test.name + `)
+	println(T{}.Used())
+}
+`),
+		}, compiler.Options{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var code bytes.Buffer
+		if err := b.WriteProgram(main, &compiler.SourceMapFilter{Writer: &code}); err != nil {
+			t.Fatal(err)
+		}
+		var kept []string
+		for _, m := range []string{"Used", "Reflected", "Unreachable"} {
+			if strings.Contains(code.String(), "T.prototype."+m+" = ") {
+				kept = append(kept, m)
+			}
+		}
+		if got, want := strings.Join(kept, " "), strings.Join(test.kept, " "); got != want {
+			t.Errorf("MethodByName(%s): got methods %q, want %q", test.name, got, want)
+		}
+		if !strings.Contains(code.String(), ".prototype.UnixNano = ") {
+			t.Errorf("MethodByName(%s): time.Time.UnixNano was eliminated", test.name)
+		}
+
+		// Externalizing the time.Time calls UnixNano.
+		if out, ok := runNode(t, code.Bytes()); ok && out != "used\n" {
+			t.Errorf("MethodByName(%s): got output %q", test.name, out)
+		}
+	}
+}
+
+// funcCode returns the code of the function that is assigned to name in code.
+func funcCode(t *testing.T, code, name string) string {
+	i := strings.Index(code, name+" = function")
+	if i < 0 {
+		t.Fatalf("%s not found in\n%s", name, code)
+	}
+	code = code[i:]
+	return code[:strings.Index(code, "\n\t};")]
+}
+
+// Calls through interfaces only make a function blocking if one of the
+// methods that the program may call through them blocks.
+func TestBlockingInterfaceCalls(t *testing.T) {
+	b := newBundle(t)
+	if _, err := b.Compile("sort", map[string][]byte{
+		"sort.go": []byte(`package sort
+
+type Interface interface {
+	Len() int
+	Less(i, j int) bool
+	Swap(i, j int)
+}
+
+func Sort(data Interface) {
+	for i := 1; i < data.Len(); i++ {
+		for j := i; j > 0 && data.Less(j, j-1); j-- {
+			data.Swap(j, j-1)
+		}
+	}
+}
+`),
+	}, compiler.Options{}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		less     string // the body of the only Less
+		blocking bool
+	}{
+		{"return len(s.a[i]) < len(s.a[j])", false},
+		{"<-s.ch; return len(s.a[i]) < len(s.a[j])", true},
+	} {
+		main, err := b.Compile("main", map[string][]byte{
+			"main.go": []byte(`package main
+
+import "sort"
+
+type byLen struct {
+	a  []string
+	ch chan bool
+}
+
+func (s byLen) Len() int           { return len(s.a) }
+func (s byLen) Less(i, j int) bool { ` + test.less + ` }
+func (s byLen) Swap(i, j int)      { s.a[i], s.a[j] = s.a[j], s.a[i] }
+
+func sortByLen(s byLen) {
+	sort.Sort(s)
+}
+
+func main() {
+	s := byLen{[]string{"ccc", "a", "bb"}, make(chan bool, 3)}
+	for i := 0; i < cap(s.ch); i++ {
+		s.ch <- true
+	}
+	sortByLen(s)
+	println(s.a[0], s.a[1], s.a[2])
+}
+`),
+		}, compiler.Options{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var code bytes.Buffer
+		if err := b.WriteProgram(main, &compiler.SourceMapFilter{Writer: &code}); err != nil {
+			t.Fatal(err)
+		}
+		for _, f := range []string{"\tSort", "\tsortByLen"} {
+			body := funcCode(t, code.String(), f)
+			if blocking := strings.Contains(body, "$blk") || strings.Contains(body, "$s = "); blocking != test.blocking {
+				t.Errorf("%s: got blocking %s %v, want %v:\n%s", test.less, f[1:], blocking, test.blocking, body)
+			}
+		}
+		if out, ok := runNode(t, code.Bytes()); ok && out != "a bb ccc\n" {
+			t.Errorf("%s: got output %q", test.less, out)
+		}
+	}
+}
+
+// The locks of nosync only make their callers blocking if a function that
+// holds one of them can be suspended.
+func TestNoSyncLocks(t *testing.T) {
+	b := newBundle(t)
+	names, err := filepath.Glob("../../nosync/*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := make(map[string][]byte)
+	for _, name := range names {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		if files[filepath.Base(name)], err = ioutil.ReadFile(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := b.Compile("github.com/gopherjs/gopherjs/nosync", files, compiler.Options{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.Compile("example.com/logger", map[string][]byte{
+		"logger.go": []byte(`package logger
+
+import "github.com/gopherjs/gopherjs/nosync"
+
+type Writer interface {
+	Write(s string)
+}
+
+type Logger struct {
+	mu nosync.Mutex
+	W  Writer
+}
+
+func (l *Logger) Print(s string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.W.Write(s)
+}
+`),
+	}, compiler.Options{}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		write    string // the body of the only Writer
+		feed     string // what main does before waiting for the goroutines
+		blocking bool
+	}{
+		{"println(s)", "", false},
+		{"<-x.ch; println(s)", "x.ch <- true; x.ch <- true", true},
+	} {
+		main, err := b.Compile("main", map[string][]byte{
+			"main.go": []byte(`package main
+
+import "example.com/logger"
+
+type w struct {
+	ch chan bool
+}
+
+func (x *w) Write(s string) { ` + test.write + ` }
+
+func main() {
+	x := &w{ch: make(chan bool)}
+	l := &logger.Logger{W: x}
+	done := make(chan bool)
+	for _, s := range []string{"a", "b"} {
+		go func(s string) {
+			l.Print(s)
+			done <- true
+		}(s)
+	}
+	` + test.feed + `
+	<-done
+	<-done
+}
+`),
+		}, compiler.Options{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var code bytes.Buffer
+		if err := b.WriteProgram(main, &compiler.SourceMapFilter{Writer: &code}); err != nil {
+			t.Fatal(err)
+		}
+		for _, f := range []string{"Mutex.ptr.prototype.Lock", "Logger.ptr.prototype.Print"} {
+			body := funcCode(t, code.String(), f)
+			if blocking := strings.Contains(body, "$blk"); blocking != test.blocking {
+				t.Errorf("%s: got blocking %s %v, want %v:\n%s", test.write, f, blocking, test.blocking, body)
+			}
+		}
+		if out, ok := runNode(t, code.Bytes()); ok && out != "a\nb\n" {
+			t.Errorf("%s: got output %q", test.write, out)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// +build js
+// +build gopherjs_deterministic
+
+package deterministic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/goplusjs/gopherjs/js"
+)
+
+// reseed restarts the PRNG of the scheduler with seed. Pending timers stay on
+// the virtual clock.
+func reseed(seed int) {
+	clock := js.Global.Get("$virtualClock")
+	js.Global.Call("$enableDeterministic", seed)
+	js.Global.Set("$virtualClock", clock)
+}
+
+// choices records which of two ready cases n selects pick.
+func choices(n int) []int {
+	a, b := make(chan int, n), make(chan int, n)
+	for i := 0; i < n; i++ {
+		a <- 0
+		b <- 1
+	}
+	var picked []int
+	for i := 0; i < n; i++ {
+		select {
+		case v := <-a:
+			picked = append(picked, v)
+		case v := <-b:
+			picked = append(picked, v)
+		}
+	}
+	return picked
+}
+
+func TestSelectReplay(t *testing.T) {
+	reseed(42)
+	first := choices(64)
+	reseed(42)
+	second := choices(64)
+
+	count := 0
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("select %d picked case %d, then %d with the same seed", i, first[i], second[i])
+		}
+		count += first[i]
+	}
+	if count == 0 || count == len(first) {
+		t.Errorf("select always picked case %d", first[0])
+	}
+}
+
+// interleaving records the order in which the scheduler runs goroutines that
+// are all runnable.
+func interleaving() string {
+	ch := make(chan byte)
+	for id := 0; id < 8; id++ {
+		go func(id byte) {
+			for i := 0; i < 3; i++ {
+				ch <- 'a' + id
+			}
+		}(byte(id))
+	}
+	order := make([]byte, 0, 24)
+	for len(order) < cap(order) {
+		order = append(order, <-ch)
+	}
+	return string(order)
+}
+
+func TestScheduleReplay(t *testing.T) {
+	reseed(1)
+	first := interleaving()
+	reseed(1)
+	if second := interleaving(); second != first {
+		t.Errorf("goroutines ran in the order %s, then %s with the same seed", first, second)
+	}
+	reseed(2)
+	if other := interleaving(); other == first {
+		t.Errorf("goroutines ran in the order %s with seeds 1 and 2", first)
+	}
+}
+
+func TestVirtualClock(t *testing.T) {
+	realStart := js.Global.Get("Date").Call("now").Float()
+	start := time.Now()
+	time.Sleep(time.Hour)
+	if d := time.Since(start); d < time.Hour {
+		t.Errorf("time.Sleep(time.Hour) took %v on the virtual clock", d)
+	}
+	if d := js.Global.Get("Date").Call("now").Float() - realStart; d > 10000 {
+		t.Errorf("time.Sleep(time.Hour) took %vms of real time", d)
+	}
+
+	// Timers fire in the order of their due times, whenever they were started.
+	late, early := time.After(2*time.Minute), time.After(time.Minute)
+	select {
+	case <-late:
+		t.Error("timer due in 2m fired before the one due in 1m")
+	case <-early:
+	}
+}
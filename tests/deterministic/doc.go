@@ -0,0 +1,3 @@
+// Package deterministic tests the deterministic scheduler. Its tests are only
+// built with the gopherjs_deterministic tag, e.g. by "gopherjs test --deterministic".
+package deterministic
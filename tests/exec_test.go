@@ -0,0 +1,61 @@
+package tests_test
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"strings"
+	"syscall"
+	"testing"
+)
+
+func TestExecPipes(t *testing.T) {
+	cmd := exec.Command("sh", "-c", `cat; echo "$GOPHERJS_TEST" >&2; exit 3`)
+	cmd.Env = append(os.Environ(), "GOPHERJS_TEST=env")
+	cmd.Stdin = strings.NewReader("stdin")
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	if e, ok := err.(*exec.ExitError); !ok || e.ExitCode() != 3 {
+		t.Errorf("got error %v, want exit status 3", err)
+	}
+	if got := stdout.String(); got != "stdin" {
+		t.Errorf("got stdout %q, want %q", got, "stdin")
+	}
+	if got := stderr.String(); got != "env\n" {
+		t.Errorf("got stderr %q, want %q", got, "env\n")
+	}
+}
+
+func TestExecOutput(t *testing.T) {
+	out, err := exec.Command("echo", "hello").Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != "hello\n" {
+		t.Errorf("got %q, want %q", out, "hello\n")
+	}
+}
+
+func TestExecKill(t *testing.T) {
+	cmd := exec.Command("sleep", "10")
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if err := cmd.Process.Kill(); err != nil {
+		t.Fatal(err)
+	}
+	cmd.Wait()
+	if status := cmd.ProcessState.Sys().(syscall.WaitStatus); status.Signal() != syscall.SIGKILL {
+		t.Errorf("got %v, want the process to be killed", cmd.ProcessState)
+	}
+}
+
+func TestExecNotFound(t *testing.T) {
+	cmd := &exec.Cmd{Path: "/nonexistent/command"}
+	err := cmd.Run()
+	if !os.IsNotExist(err) {
+		t.Errorf("got error %v, want a missing file", err)
+	}
+}
@@ -2,8 +2,14 @@ package tests
 
 import (
 	"fmt"
+	"log"
+	"runtime"
+	"sort"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/goplusjs/gopherjs/js"
 )
 
 var expectedI int
@@ -140,3 +146,97 @@ func TestDeferWithBlocking(t *testing.T) {
 	fmt.Print("")
 	return
 }
+
+type blockingSorter struct {
+	values []int
+	ch     chan bool
+}
+
+func (s blockingSorter) Len() int      { return len(s.values) }
+func (s blockingSorter) Swap(i, j int) { s.values[i], s.values[j] = s.values[j], s.values[i] }
+func (s blockingSorter) Less(i, j int) bool {
+	if s.ch != nil {
+		go func() { s.ch <- true }()
+		<-s.ch
+	}
+	return s.values[i] < s.values[j]
+}
+
+func TestBlockingInterfaceCall(t *testing.T) {
+	s := blockingSorter{values: []int{3, 1, 2}, ch: make(chan bool)}
+	sort.Sort(s)
+	if fmt.Sprint(s.values) != "[1 2 3]" {
+		t.Errorf("got %v, want [1 2 3]", s.values)
+	}
+}
+
+func TestBlockingFuncValueCall(t *testing.T) {
+	values := []int{3, 1, 2}
+	ch := make(chan bool)
+	sort.SliceStable(values, func(i, j int) bool {
+		go func() { ch <- true }()
+		<-ch
+		return values[i] < values[j]
+	})
+	if fmt.Sprint(values) != "[1 2 3]" {
+		t.Errorf("got %v, want [1 2 3]", values)
+	}
+}
+
+type blockingWriter struct {
+	ch    chan bool
+	lines []string
+}
+
+func (w *blockingWriter) Write(p []byte) (int, error) {
+	<-w.ch
+	w.lines = append(w.lines, string(p))
+	return len(p), nil
+}
+
+func TestNosyncMutexContention(t *testing.T) {
+	// log uses nosync, and its Logger holds its mutex while it writes.
+	w := &blockingWriter{ch: make(chan bool)}
+	l := log.New(w, "", 0)
+	done := make(chan bool)
+	for i := 0; i < 2; i++ {
+		go func(i int) {
+			l.Print(i)
+			done <- true
+		}(i)
+	}
+	w.ch <- true
+	w.ch <- true
+	<-done
+	<-done
+	if got := strings.Join(w.lines, ""); got != "0\n1\n" && got != "1\n0\n" {
+		t.Errorf("got %q, want both lines", got)
+	}
+}
+
+func blockInStackTest(c chan int) {
+	<-c
+}
+
+func TestRuntimeStack(t *testing.T) {
+	c := make(chan int)
+	go blockInStackTest(c)
+	runtime.Gosched()
+
+	buf := make([]byte, 1<<16)
+	dump := string(buf[:runtime.Stack(buf, true)])
+	if !strings.Contains(dump, " [chan receive]:\n") {
+		t.Errorf("dump doesn't show the blocked goroutine:\n%s", dump)
+	}
+	// Minified code doesn't record stack frames.
+	if strings.Contains(js.InternalObject(blockInStackTest).Call("toString").String(), "$unwinding") {
+		want := "github.com/goplusjs/gopherjs/tests.blockInStackTest(...)\n\tgithub.com/goplusjs/gopherjs/tests/goroutine_test.go:"
+		if !strings.Contains(dump, want) {
+			t.Errorf("dump doesn't contain %q:\n%s", want, dump)
+		}
+	}
+	if n := runtime.Stack(buf, false); strings.Contains(string(buf[:n]), "[chan receive]") {
+		t.Errorf("dump of the current goroutine shows others:\n%s", buf[:n])
+	}
+	c <- 0
+}
@@ -620,3 +620,98 @@ func TestStructWithNonIdentifierJSTag(t *testing.T) {
 		t.Errorf("value via js.Object.Get gave %q, want %q", got, want)
 	}
 }
+
+func TestExternalizeStructWithJSTags(t *testing.T) {
+	type Inner struct {
+		Value int `js:"value"`
+	}
+	type S struct {
+		Name    string `js:"name"`
+		Count   int    `js:"count,omitempty"`
+		Skipped string `js:"-"`
+		Plain   bool
+		Inner   *Inner `js:"inner,omitempty"`
+		private int
+	}
+	o := js.Global.Call("eval", "(function(o) { return JSON.stringify(o); })")
+
+	if got, want := o.Invoke(S{Name: "a", Skipped: "x", private: 1}).String(), `{"name":"a","Plain":false}`; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	if got, want := o.Invoke(S{Name: "b", Count: 2, Inner: &Inner{Value: 3}}).String(), `{"name":"b","count":2,"Plain":false,"inner":{"value":3}}`; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestInternalizeStructWithJSTags(t *testing.T) {
+	type S struct {
+		Name    string `js:"name"`
+		Count   int    `js:"count,omitempty"`
+		Skipped string `js:"-"`
+		Plain   bool
+	}
+	var s S
+	f := js.Global.Call("eval", `(function(f) { f({name: "a", count: 2, Skipped: "x", "-": "y", Plain: true}); })`)
+	f.Invoke(func(v S) { s = v })
+	if want := (S{Name: "a", Count: 2, Plain: true}); s != want {
+		t.Errorf("got %#v, want %#v", s, want)
+	}
+
+	var p *S
+	g := js.Global.Call("eval", `(function(f) { f(null); })`)
+	g.Invoke(func(v *S) { p = v })
+	if p != nil {
+		t.Errorf("got %#v, want nil", p)
+	}
+}
+
+func TestAwait(t *testing.T) {
+	p := js.Global.Get("Promise").Call("resolve", 42)
+	v, err := js.Await(p)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v.Int() != 42 {
+		t.Errorf("got %v, want 42", v)
+	}
+
+	p = js.Global.Get("Promise").Call("reject", js.Global.Get("Error").New("boom"))
+	if _, err := js.Await(p); err == nil || err.(*js.Error).Get("message").String() != "boom" {
+		t.Errorf("got error %v, want boom", err)
+	}
+}
+
+func TestNewPromise(t *testing.T) {
+	v, err := js.Await(js.NewPromise(func() (interface{}, error) {
+		time.Sleep(time.Millisecond)
+		return "done", nil
+	}))
+	if err != nil || v.String() != "done" {
+		t.Errorf("got %v, %v, want done, nil", v, err)
+	}
+
+	_, err = js.Await(js.NewPromise(func() (interface{}, error) {
+		return nil, fmt.Errorf("failed")
+	}))
+	if err == nil || err.(*js.Error).Get("message").String() != "failed" {
+		t.Errorf("got error %v, want failed", err)
+	}
+}
+
+func TestBlockingCallbackReturnsPromise(t *testing.T) {
+	c := make(chan int)
+	f := js.Global.Call("eval", `(function(f) { return f(); })`)
+	p := f.Invoke(func() int { return <-c })
+	if p.Get("then") == js.Undefined {
+		t.Fatalf("got %v, want a Promise", p)
+	}
+	c <- 42
+	v, err := js.Await(p)
+	if err != nil || v.Int() != 42 {
+		t.Errorf("got %v, %v, want 42, nil", v, err)
+	}
+
+	if got := f.Invoke(func() int { return 1 }).Int(); got != 1 {
+		t.Errorf("non-blocking callback gave %v, want 1", got)
+	}
+}
@@ -1,11 +1,16 @@
 package tests_test
 
 import (
+	"bufio"
 	"bytes"
 	"io/ioutil"
+	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
 	"testing"
 )
 
@@ -32,3 +37,74 @@ func TestTimeInternalizationExternalization(t *testing.T) {
 		t.Fatalf("got != want:\ngot:\n%s\nwant:\n%s", got, want)
 	}
 }
+
+// Test that a deadlock prints a goroutine dump with positions that don't
+// depend on where the program was built.
+func TestDeadlockDump(t *testing.T) {
+	if runtime.GOARCH == "js" {
+		t.Skip("test meant to be run using normal Go compiler (needs os/exec)")
+	}
+
+	for _, minify := range []bool{false, true} {
+		out, err := exec.Command("gopherjs", "run", "--minify="+strconv.FormatBool(minify), filepath.Join("testdata", "deadlock.go")).CombinedOutput()
+		if e, ok := err.(*exec.ExitError); !ok || e.ExitCode() != 2 {
+			t.Fatalf("got error %v, want exit status 2:\n%s", err, out)
+		}
+		dump := string(out)
+		if !strings.Contains(dump, "fatal error: all goroutines are asleep - deadlock!\n\ngoroutine ") {
+			t.Errorf("minify %v: got output\n%s\nwant a goroutine dump", minify, dump)
+		}
+		// Minified code doesn't record stack frames.
+		frames := " [chan receive]:\nmain.block(...)\n\tmain/deadlock.go:4\nmain.main(...)\n\tmain/deadlock.go:8\n"
+		if got := strings.Contains(dump, frames); got == minify {
+			t.Errorf("minify %v: got dump\n%s\nwant frames %v", minify, dump, !minify)
+		}
+		if wd, _ := os.Getwd(); strings.Contains(dump, wd) {
+			t.Errorf("dump contains the absolute path %s:\n%s", wd, dump)
+		}
+	}
+}
+
+// Test that SIGQUIT prints a goroutine dump and exits, like it does for gc.
+func TestSIGQUITDump(t *testing.T) {
+	if runtime.GOARCH == "js" || runtime.GOOS == "windows" {
+		t.Skip("test meant to be run using normal Go compiler on Unix (needs os/exec and SIGQUIT)")
+	}
+
+	dir, err := ioutil.TempDir("", "sigquit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	script := filepath.Join(dir, "sigquit.js")
+	if out, err := exec.Command("gopherjs", "build", "-o", script, filepath.Join("testdata", "sigquit.go")).CombinedOutput(); err != nil {
+		t.Fatalf("%v:\n%s", err, out)
+	}
+
+	cmd := exec.Command("node", script)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if line, err := bufio.NewReader(stdout).ReadString('\n'); err != nil || line != "ready\n" {
+		t.Fatalf("got %q, %v, want ready", line, err)
+	}
+	if err := cmd.Process.Signal(syscall.SIGQUIT); err != nil {
+		t.Fatal(err)
+	}
+	err = cmd.Wait()
+	if e, ok := err.(*exec.ExitError); !ok || e.ExitCode() != 2 {
+		t.Fatalf("got error %v, want exit status 2:\n%s", err, stderr.String())
+	}
+	dump := stderr.String()
+	for _, want := range []string{"SIGQUIT: quit\n", " [sleep]:\n", "main.main(...)\n\tmain/sigquit.go:15\n", " [chan receive]:\nmain.wait(...)\n\tmain/sigquit.go:9\n"} {
+		if !strings.Contains(dump, want) {
+			t.Errorf("got dump\n%s\nwant it to contain %q", dump, want)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/goplusjs/gopherjs/js"
 	"github.com/goplusjs/gopherjs/tests/otherpkg"
 )
 
@@ -703,3 +704,173 @@ func TestReflectMapIterationAndDelete(t *testing.T) {
 		t.Fatalf("got %d, want %d", got, want)
 	}
 }
+
+func setInt(p *int, v int) { *p = v }
+
+var leakedInts []*int
+
+func leakInt(p *int) { leakedInts = append(leakedInts, p) }
+
+func TestLoopVarPointers(t *testing.T) {
+	leakedInts = nil
+	var sum int
+	for i := 0; i < 3; i++ {
+		x := 0
+		setInt(&x, i)
+		sum += x
+		y := i
+		leakInt(&y)
+	}
+	if sum != 3 {
+		t.Errorf("got sum %d, want 3", sum)
+	}
+	for i, p := range leakedInts {
+		if *p != i {
+			t.Errorf("got *leakedInts[%d] = %d, want %d", i, *p, i)
+		}
+	}
+}
+
+const constBranchMode = 1
+
+func TestConstantBranches(t *testing.T) {
+	var got []string
+	switch constBranchMode {
+	case 0:
+		got = append(got, "0")
+		fallthrough
+	case 1:
+		got = append(got, "1")
+		fallthrough
+	case 2:
+		got = append(got, "2")
+	default:
+		got = append(got, "default")
+	}
+	if constBranchMode > 1 {
+		got = append(got, "if")
+	} else if constBranchMode == 1 {
+		got = append(got, "else if")
+	} else {
+		got = append(got, "else")
+	}
+	if want := []string{"1", "2", "else if"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+type greeter struct{ name string }
+
+func (g greeter) Greet() string { return "hello " + g.name }
+
+func TestMethodByNameConstant(t *testing.T) {
+	m := reflect.ValueOf(greeter{"gopher"}).MethodByName("Greet")
+	if !m.IsValid() {
+		t.Fatal("method Greet not found")
+	}
+	if got, want := m.Call(nil)[0].String(), "hello gopher"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+// The prelude calls UnixNano when it turns a time.Time into a Date, so the
+// method has to be kept even if no Go code calls it. That unreachable exported
+// methods are left out, and that a MethodByName of a name that isn't constant
+// keeps them all, is tested in tests/compiler: both can't be seen from a single
+// program.
+func TestExternalizeTime(t *testing.T) {
+	when := time.Unix(1500000000, 123000000)
+	js.Global.Set("externalizedTime", when)
+	defer js.Global.Delete("externalizedTime")
+	if got := js.Global.Get("externalizedTime").Call("getTime").Int64(); got != 1500000000123 {
+		t.Errorf("got Date of %d ms, want 1500000000123", got)
+	}
+	if got := js.Global.Get("externalizedTime").Interface().(time.Time); !got.Equal(when) {
+		t.Errorf("got %v back, want %v", got, when)
+	}
+}
+
+func TestMonotonicClockResolution(t *testing.T) {
+	start := time.Now()
+	deadline := start.Add(100 * time.Millisecond)
+	for now := time.Now(); now.Before(deadline); now = time.Now() {
+		if d := now.Sub(start); d != 0 && d%time.Millisecond != 0 {
+			return
+		}
+	}
+	t.Errorf("time.Since only measures whole milliseconds")
+}
+
+func inlineSub(x, y int) int { return x - y }
+
+func inlineTwice(x int) int { return x + x }
+
+func inlineNamed(x int) (y int) { return x * 2 }
+
+type inlinePoint struct{ x, y int }
+
+func (p *inlinePoint) sum() int { return p.x + p.y }
+
+func TestInlineArgumentOrder(t *testing.T) {
+	n := 0
+	next := func() int { n++; return n }
+	if got := inlineSub(next(), next()); got != -1 {
+		t.Errorf("got %d, want -1: arguments evaluated out of order", got)
+	}
+
+	// The parameters are substituted at once, not one after the other.
+	x, y := 10, 3
+	if got := inlineSub(y, x); got != -7 {
+		t.Errorf("got %d, want -7", got)
+	}
+}
+
+func TestInlineArgumentSideEffects(t *testing.T) {
+	calls := 0
+	inc := func() int { calls++; return calls }
+	if got := inlineTwice(inc()); got != 2 || calls != 1 {
+		t.Errorf("got %d after %d calls, want 2 after 1 call", got, calls)
+	}
+	s := []int{1, 2}
+	i := 0
+	if got := inlineTwice(s[i]); got != 2 {
+		t.Errorf("got %d, want 2", got)
+	}
+}
+
+func TestInlineNamedResult(t *testing.T) {
+	x := 21
+	y := 1
+	if got := inlineNamed(x); got != 42 || y != 1 {
+		t.Errorf("got %d and y = %d, want 42 and y = 1", got, y)
+	}
+	p := inlinePoint{1, 2}
+	if got := p.sum(); got != 3 {
+		t.Errorf("got %d, want 3", got)
+	}
+	p.x = 40
+	if got := p.sum(); got != 42 {
+		t.Errorf("got %d, want 42 after changing the receiver", got)
+	}
+}
+
+func scale(x float32) float32 {
+	return otherpkg.Scaled(x)
+}
+
+func TestInlineFromOtherPackage(t *testing.T) {
+	otherpkg.Test = 2
+	if got := scale(21); got != 42 {
+		t.Errorf("got %v, want 42", got)
+	}
+	otherpkg.Test = 3
+	if got := scale(2); got != 6 {
+		t.Errorf("got %v, want 6 after changing otherpkg.Test", got)
+	}
+	otherpkg.Test = 0
+
+	// otherpkg.Scaled is inlined from the archive of otherpkg.
+	if src := js.InternalObject(scale).Call("toString").String(); strings.Contains(src, "Scaled") {
+		t.Errorf("otherpkg.Scaled wasn't inlined:\n%s", src)
+	}
+}
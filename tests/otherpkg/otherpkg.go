@@ -1,3 +1,6 @@
 package otherpkg
 
 var Test float32
+
+// Scaled is small enough to be inlined into other packages.
+func Scaled(x float32) float32 { return x * Test }
@@ -0,0 +1,89 @@
+package tests
+
+import (
+	"os"
+	"os/signal"
+	"runtime"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/goplusjs/gopherjs/js"
+)
+
+// raise sends sig to the current process.
+func raise(t *testing.T, sig syscall.Signal) {
+	pid := os.Getpid()
+	if runtime.GOARCH == "js" {
+		pid = js.Global.Get("process").Get("pid").Int()
+	}
+	if err := syscall.Kill(pid, sig); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func expectSignal(t *testing.T, c chan os.Signal, want os.Signal) {
+	select {
+	case got := <-c:
+		if got != want {
+			t.Errorf("got signal %v, want %v", got, want)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("timeout waiting for %v", want)
+	}
+}
+
+func TestSignalNotify(t *testing.T) {
+	defer signal.Reset(syscall.SIGUSR2)
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGUSR2)
+	raise(t, syscall.SIGUSR2)
+	expectSignal(t, c, syscall.SIGUSR2)
+}
+
+func TestSignalStop(t *testing.T) {
+	defer signal.Reset(syscall.SIGUSR2)
+	c1 := make(chan os.Signal, 1)
+	c2 := make(chan os.Signal, 1)
+	signal.Notify(c1, syscall.SIGUSR2)
+	signal.Notify(c2, syscall.SIGUSR2)
+	signal.Stop(c1)
+	raise(t, syscall.SIGUSR2)
+	expectSignal(t, c2, syscall.SIGUSR2)
+	select {
+	case sig := <-c1:
+		t.Errorf("stopped channel got %v", sig)
+	default:
+	}
+}
+
+func TestSignalIgnore(t *testing.T) {
+	defer signal.Reset(syscall.SIGUSR2)
+	signal.Ignore(syscall.SIGUSR2)
+	if !signal.Ignored(syscall.SIGUSR2) {
+		t.Errorf("SIGUSR2 isn't ignored")
+	}
+	raise(t, syscall.SIGUSR2)
+	time.Sleep(10 * time.Millisecond) // the process would be terminated by now
+}
+
+// Notifying or ignoring SIGQUIT keeps the goroutines from being dumped and the
+// process from exiting.
+func TestSignalQuit(t *testing.T) {
+	defer signal.Reset(syscall.SIGQUIT)
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGQUIT)
+	raise(t, syscall.SIGQUIT)
+	expectSignal(t, c, syscall.SIGQUIT)
+
+	signal.Reset(syscall.SIGQUIT)
+	if runtime.GOARCH == "js" {
+		// The runtime's listener is back.
+		if n := js.Global.Get("process").Call("listenerCount", "SIGQUIT").Int(); n != 1 {
+			t.Errorf("got %d SIGQUIT listeners after Reset, want 1", n)
+		}
+	}
+	signal.Ignore(syscall.SIGQUIT)
+	raise(t, syscall.SIGQUIT)
+	time.Sleep(10 * time.Millisecond) // the process would have exited by now
+}
@@ -0,0 +1,127 @@
+// +build js
+// +build go1.14
+
+// Tests of behavior that the upstream tests don't cover, but that code
+// written for WebAssembly depends on.
+
+package js_test
+
+import (
+	"syscall/js"
+	"testing"
+)
+
+type wrapper struct {
+	v js.Value
+}
+
+func (w wrapper) JSValue() js.Value {
+	return w.v
+}
+
+func TestValueOfWrapper(t *testing.T) {
+	o := js.Global().Get("Object").New()
+	if !js.ValueOf(wrapper{o}).Equal(o) {
+		t.Errorf("ValueOf doesn't use JSValue")
+	}
+	if !js.ValueOf(array{wrapper{o}}).Index(0).Equal(o) {
+		t.Errorf("ValueOf doesn't use JSValue for array elements")
+	}
+	dummys.Set("test", wrapper{o})
+	if !dummys.Get("test").Equal(o) {
+		t.Errorf("Set doesn't use JSValue")
+	}
+}
+
+func TestValueOfNestedValues(t *testing.T) {
+	g := js.Global()
+	if !js.ValueOf(array{g}).Index(0).Equal(g) {
+		t.Errorf("js.Value in array isn't converted")
+	}
+	if !js.ValueOf(object{"g": g}).Get("g").Equal(g) {
+		t.Errorf("js.Value in object isn't converted")
+	}
+}
+
+func TestValueOfInvalid(t *testing.T) {
+	for _, x := range []interface{}{[]byte{1}, []int{1}, struct{}{}, map[string]int{}} {
+		func() {
+			defer func() {
+				if err := recover(); err != "ValueOf: invalid value" {
+					t.Errorf("ValueOf(%#v): got panic %#v", x, err)
+				}
+			}()
+			js.ValueOf(x)
+		}()
+	}
+}
+
+func TestIsNaNString(t *testing.T) {
+	if js.ValueOf("abc").IsNaN() {
+		t.Errorf("string is NaN")
+	}
+}
+
+func TestEqualZero(t *testing.T) {
+	if !js.ValueOf(0).Equal(js.Global().Call("eval", "-0")) {
+		t.Errorf("0 isn't equal to -0")
+	}
+	if js.ValueOf(0).Equal(js.ValueOf("0")) {
+		t.Errorf("0 is equal to \"0\"")
+	}
+}
+
+func TestFuncOfResultValue(t *testing.T) {
+	g := js.Global()
+	f := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		return g
+	})
+	defer f.Release()
+	if !f.Invoke().Equal(g) {
+		t.Errorf("returned js.Value isn't converted")
+	}
+}
+
+func TestFuncRelease(t *testing.T) {
+	called := false
+	f := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		called = true
+		return 42
+	})
+	f.Release()
+	console := js.Global().Get("console")
+	consoleError := console.Get("error")
+	console.Set("error", js.FuncOf(func(this js.Value, args []js.Value) interface{} { return nil }))
+	defer console.Set("error", consoleError)
+	if got := f.Invoke(); !got.IsUndefined() || called {
+		t.Errorf("released function was called, returned %v", got)
+	}
+}
+
+func TestLengthValueError(t *testing.T) {
+	expectValueError(t, func() {
+		dummys.Get("zero").Length()
+	})
+}
+
+func TestNewError(t *testing.T) {
+	defer func() {
+		err, ok := recover().(js.Error)
+		if !ok {
+			t.Fatalf("expected js.Error, got %#v", err)
+		}
+		if got, want := err.Get("message").String(), "test"; got != want {
+			t.Errorf("got message %q, want %q", got, want)
+		}
+	}()
+	js.Global().Call("eval", "(function() { throw new Error('test'); })").New()
+}
+
+func TestCopyBytesNotUint8Array(t *testing.T) {
+	expectPanic(t, func() {
+		js.CopyBytesToGo(make([]byte, 1), js.Global().Get("Array").New(1))
+	})
+	expectPanic(t, func() {
+		js.CopyBytesToJS(js.Global().Get("Array").New(1), make([]byte, 1))
+	})
+}
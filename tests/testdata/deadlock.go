@@ -0,0 +1,9 @@
+package main
+
+func block(c chan int) {
+	<-c
+}
+
+func main() {
+	block(make(chan int))
+}
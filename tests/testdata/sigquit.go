@@ -0,0 +1,16 @@
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+func wait(c chan int) {
+	<-c
+}
+
+func main() {
+	go wait(make(chan int))
+	fmt.Println("ready")
+	time.Sleep(time.Hour)
+}
@@ -0,0 +1,109 @@
+// +build js
+// +build go1.16
+
+package vfs_test
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+	"testing/fstest"
+
+	"github.com/goplusjs/gopherjs/js/vfs"
+)
+
+func TestFiles(t *testing.T) {
+	vfs.Enable()
+	dir, err := os.MkdirTemp("/tmp", "vfs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "file")
+	if err := os.WriteFile(name, []byte("hello, world"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.OpenFile(name, os.O_RDWR, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Seek(7, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Write([]byte("gopher")); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	renamed := filepath.Join(dir, "renamed")
+	if err := os.Rename(name, renamed); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(name); !os.IsNotExist(err) {
+		t.Errorf("got error %v for the old name, want a missing file", err)
+	}
+	data, err := os.ReadFile(renamed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "hello, gopher" {
+		t.Errorf("got %q, want %q", data, "hello, gopher")
+	}
+	fi, err := os.Stat(renamed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Size() != 13 || fi.Mode() != 0644 {
+		t.Errorf("got size %d and mode %v, want 13 and %v", fi.Size(), fi.Mode(), os.FileMode(0644))
+	}
+
+	if err := os.Chmod(renamed, 0444); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.OpenFile(renamed, os.O_WRONLY, 0); !os.IsPermission(err) {
+		t.Errorf("got error %v for writing a read-only file, want a permission error", err)
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, "a", "b"), 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(filepath.Join(dir, "a")); err == nil {
+		t.Errorf("removed a directory that isn't empty")
+	}
+	if runtime.GOOS == "linux" {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, e := range entries {
+			names = append(names, e.Name())
+		}
+		if want := []string{"a", "renamed"}; !reflect.DeepEqual(names, want) {
+			t.Errorf("got entries %q, want %q", names, want)
+		}
+	}
+}
+
+func TestMount(t *testing.T) {
+	fsys := fstest.MapFS{
+		"dir/file": {Data: []byte("mounted"), Mode: 0444},
+	}
+	if err := vfs.Mount("/mnt/test", fsys); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile("/mnt/test/dir/file")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "mounted" {
+		t.Errorf("got %q, want %q", data, "mounted")
+	}
+	if err := os.WriteFile("/mnt/test/dir/new", nil, 0666); err == nil {
+		t.Errorf("created a file in a read-only directory")
+	}
+}
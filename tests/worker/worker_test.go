@@ -0,0 +1,82 @@
+// +build js
+
+package worker_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/goplusjs/gopherjs/js/worker"
+)
+
+type point struct {
+	X, Y  int
+	Label string
+}
+
+func init() {
+	worker.Handle("swap", func(ch *worker.Channel) {
+		var p point
+		for ch.Recv(&p) == nil {
+			ch.Send(point{X: p.Y, Y: p.X, Label: p.Label + "!"})
+		}
+	})
+	worker.Handle("join", func(ch *worker.Channel) {
+		var words []string
+		if err := ch.Recv(&words); err != nil {
+			return
+		}
+		s := ""
+		for _, w := range words {
+			s += w
+		}
+		ch.Send(s)
+	})
+}
+
+// TestMain runs the entry points when the test binary is loaded by a worker.
+func TestMain(m *testing.M) {
+	worker.Main()
+	os.Exit(m.Run())
+}
+
+func TestRoundTrip(t *testing.T) {
+	if worker.IsWorker() {
+		t.Fatal("tests run in a worker")
+	}
+	ch, err := worker.Start("swap")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		ch.Send(point{X: i, Y: 10 * i, Label: "ü"})
+		var got point
+		if err := ch.Recv(&got); err != nil {
+			t.Fatal(err)
+		}
+		if want := (point{X: 10 * i, Y: i, Label: "ü!"}); got != want {
+			t.Errorf("got %+v, want %+v", got, want)
+		}
+	}
+	ch.Close()
+	var p point
+	if err := ch.Recv(&p); err != worker.ErrClosed {
+		t.Errorf("got error %v after Close, want ErrClosed", err)
+	}
+}
+
+func TestWorkerCloses(t *testing.T) {
+	ch, err := worker.Start("join")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch.Send([]string{"a", "b", "c"})
+	var s string
+	if err := ch.Recv(&s); err != nil || s != "abc" {
+		t.Errorf("got %q, %v, want %q, nil", s, err, "abc")
+	}
+	// The entry point has returned, so the worker closes its end.
+	if err := ch.Recv(&s); err != worker.ErrClosed {
+		t.Errorf("got error %v, want ErrClosed", err)
+	}
+}
@@ -31,9 +31,9 @@ import (
 
 	gbuild "github.com/goplusjs/gopherjs/build"
 	"github.com/goplusjs/gopherjs/compiler"
+	"github.com/goplusjs/gopherjs/internal/debugger"
 	"github.com/goplusjs/gopherjs/internal/sysutil"
 	"github.com/kisielk/gotool"
-	"github.com/neelance/sourcemap"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"golang.org/x/crypto/ssh/terminal"
@@ -78,10 +78,17 @@ func main() {
 
 	compilerFlags := pflag.NewFlagSet("", 0)
 	compilerFlags.BoolVarP(&options.Minify, "minify", "m", false, "minify generated code")
+	compilerFlags.BoolVarP(&options.NoInline, "no-inline", "l", false, "disable inlining of small functions")
+	compilerFlags.Var(gcflags{options}, "gcflags", `flags in the style of "go build -gcflags": -m prints escape analysis decisions, -l disables inlining`)
 	compilerFlags.BoolVar(&options.Color, "color", terminal.IsTerminal(int(os.Stderr.Fd())) && os.Getenv("TERM") != "dumb", "colored output")
 	compilerFlags.StringVar(&tags, "tags", "", "a list of build tags to consider satisfied during the build")
 	compilerFlags.BoolVar(&options.MapToLocalDisk, "localmap", false, "use local paths for sourcemap")
+	compilerFlags.BoolVar(&options.MapContent, "mapcontent", false, "embed the contents of source files in sourcemap")
 	compilerFlags.BoolVarP(&options.Rebuild, "force", "a", false, "force rebuilding of packages that are already up-to-date")
+	compilerFlags.BoolVarP(&options.AllErrors, "all-errors", "e", false, "report all errors instead of only the first 10 of each package")
+	compilerFlags.BoolVar(&options.JSONErrors, "json-errors", false, "print errors as JSON objects, one per line")
+	compilerFlags.StringSliceVar(&options.NoSync, "nosync", nil, "packages whose imports of sync are redirected to nosync, in addition to the standard library ones that are by default; -path removes a package")
+	compilerFlags.BoolVar(&options.CSPStrict, "csp-strict", false, "fail if the program evaluates strings as code, which a strict Content Security Policy forbids")
 
 	flagWatch := pflag.NewFlagSet("", 0)
 	flagWatch.BoolVarP(&options.Watch, "watch", "w", false, "watch for changes to the source files")
@@ -221,6 +228,40 @@ func main() {
 		}
 	}
 
+	cmdBundle := &cobra.Command{
+		Use:   "bundle [packages]",
+		Short: "compile packages and dependencies into a bundle",
+		Long:  "Bundle compiles the named packages, the packages they import and the runtime into a single file, for compiling other packages against without a Go installation, e.g. in the browser with the package github.com/goplusjs/gopherjs/compiler. See compiler.ReadBundle.",
+	}
+	cmdBundle.Flags().StringVarP(&pkgObj, "output", "o", "bundle.gob", "output file")
+	cmdBundle.Flags().AddFlagSet(flagVerbose)
+	cmdBundle.Flags().AddFlagSet(flagQuiet)
+	cmdBundle.Flags().AddFlagSet(compilerFlags)
+	cmdBundle.Run = func(cmd *cobra.Command, args []string) {
+		options.BuildTags = strings.Fields(tags)
+		s := gbuild.NewSession(options)
+		err := func() error {
+			// Expand import path patterns.
+			patternContext := gbuild.NewBuildContext("", options.BuildTags)
+			pkgs := (&gotool.Context{BuildContext: *patternContext}).ImportPaths(args)
+
+			b, err := s.Bundle(pkgs...)
+			if err != nil {
+				return err
+			}
+			f, err := os.Create(pkgObj)
+			if err != nil {
+				return err
+			}
+			if err := b.Write(f); err != nil {
+				f.Close()
+				return err
+			}
+			return f.Close()
+		}()
+		os.Exit(handleError(err, options, nil))
+	}
+
 	cmdDoc := &cobra.Command{
 		Use:   "doc [arguments]",
 		Short: "display documentation for the requested, package, method or symbol",
@@ -290,6 +331,62 @@ func main() {
 		os.Exit(exitCode)
 	}
 
+	cmdDebug := &cobra.Command{
+		Use:   "debug [gofiles...] [arguments...]",
+		Short: "compile and debug Go program",
+		Long:  "Debug compiles the named Go files and runs them in Node.js under the debugger. It serves the Debug Adapter Protocol, like \"dlv dap\" does, for editors to connect to.",
+	}
+	listen := cmdDebug.Flags().String("listen", "127.0.0.1:0", "address for the debug adapter to listen on")
+	cmdDebug.Flags().AddFlagSet(flagVerbose)
+	cmdDebug.Flags().AddFlagSet(flagQuiet)
+	cmdDebug.Flags().AddFlagSet(compilerFlags)
+	cmdDebug.Run = func(cmd *cobra.Command, args []string) {
+		options.BuildTags = strings.Fields(tags)
+		// Inlining and minification would hide code and names from the debugger.
+		options.Minify, options.NoInline = false, true
+		options.MapToLocalDisk, options.DebugInfo = true, true
+		err := func() error {
+			lastSourceArg := 0
+			for lastSourceArg < len(args) && (strings.HasSuffix(args[lastSourceArg], ".go") || strings.HasSuffix(args[lastSourceArg], ".inc.js")) {
+				lastSourceArg++
+			}
+			if lastSourceArg == 0 {
+				return fmt.Errorf("gopherjs debug: no go files listed")
+			}
+
+			tempfile, err := ioutil.TempFile(currentDirectory, filepath.Base(args[0])+".")
+			if err != nil && strings.HasPrefix(currentDirectory, runtime.GOROOT()) {
+				tempfile, err = ioutil.TempFile("", filepath.Base(args[0])+".")
+			}
+			if err != nil {
+				return err
+			}
+			defer func() {
+				tempfile.Close()
+				os.Remove(tempfile.Name())
+				os.Remove(tempfile.Name() + ".map")
+			}()
+			s := gbuild.NewSession(options)
+			if err := s.BuildFiles(args[:lastSourceArg], tempfile.Name(), currentDirectory); err != nil {
+				return err
+			}
+
+			l, err := net.Listen("tcp", *listen)
+			if err != nil {
+				return err
+			}
+			defer l.Close()
+			d, err := debugger.Start(tempfile.Name(), args[lastSourceArg:], "")
+			if err != nil {
+				return err
+			}
+			defer d.Close()
+			fmt.Printf("DAP server listening at: %s\n", l.Addr())
+			return d.Serve(l)
+		}()
+		os.Exit(handleError(err, options, nil))
+	}
+
 	cmdTest := &cobra.Command{
 		Use:   "test [packages]",
 		Short: "test packages",
@@ -302,9 +399,18 @@ func main() {
 	verbose := cmdTest.Flags().BoolP("verbose", "v", false, "Log all tests as they are run. Also print all text from Log and Logf calls even if the test succeeds.")
 	compileOnly := cmdTest.Flags().BoolP("compileonly", "c", false, "Compile the test binary to pkg.test.js but do not run it (where pkg is the last element of the package's import path). The file name can be changed with the -o flag.")
 	outputFilename := cmdTest.Flags().StringP("output", "o", "", "Compile the test binary to the named file. The test still runs (unless -c is specified).")
+	deterministic := cmdTest.Flags().Bool("deterministic", false, "Schedule goroutines deterministically and run timers on a virtual clock that advances whenever all goroutines are blocked. Same as --tags="+gbuild.DeterministicTag+".")
+	seed := cmdTest.Flags().String("seed", "", "Seed for the deterministic scheduler, as printed by a previous run. Implies --deterministic.")
 	cmdTest.Flags().AddFlagSet(compilerFlags)
 	cmdTest.Run = func(cmd *cobra.Command, args []string) {
 		options.BuildTags = strings.Fields(tags)
+		if *seed != "" {
+			*deterministic = true
+			os.Setenv("GOPHERJS_SEED", *seed)
+		}
+		if *deterministic {
+			options.BuildTags = append(options.BuildTags, gbuild.DeterministicTag)
+		}
 		err := func() error {
 			// Expand import path patterns.
 			patternContext := gbuild.NewBuildContext("", options.BuildTags)
@@ -381,7 +487,7 @@ func main() {
 						return s.BuildImportPath(path)
 					},
 				}
-				mainPkgArchive, err := compiler.Compile("main", []*ast.File{mainFile}, fset, importContext, nil, options.Minify)
+				mainPkgArchive, err := compiler.Compile("main", []*ast.File{mainFile}, fset, importContext, nil, s.CompilerOptions())
 				if err != nil {
 					return err
 				}
@@ -518,7 +624,7 @@ func main() {
 		Use:  "gopherjs",
 		Long: "GopherJS is a tool for compiling Go source code to JavaScript.",
 	}
-	rootCmd.AddCommand(cmdBuild, cmdGet, cmdInstall, cmdRun, cmdTest, cmdServe, cmdVersion, cmdDoc)
+	rootCmd.AddCommand(cmdBuild, cmdGet, cmdInstall, cmdBundle, cmdRun, cmdDebug, cmdTest, cmdServe, cmdVersion, cmdDoc)
 	err := rootCmd.Execute()
 	if err != nil {
 		os.Exit(2)
@@ -579,8 +685,9 @@ func (fs serveCommandFileSystem) Open(requestName string) (http.File, error) {
 				}
 
 				sourceMapFilter := &compiler.SourceMapFilter{Writer: buf}
-				m := &sourcemap.Map{File: base + ".js"}
-				sourceMapFilter.MappingCallback = gbuild.NewMappingCallback(m, fs.options.GOROOT, fs.options.GOPATH, fs.options.MapToLocalDisk)
+				m := gbuild.NewSourceMap(base+".js", fs.options)
+				sourceMapFilter.MappingCallback = m.MappingCallback
+				sourceMapFilter.FunctionCallback = m.FunctionCallback
 
 				deps, err := compiler.ImportDependencies(archive, func(path string) (*compiler.Archive, error) {
 					_, archive, err := s.BuildImportPathWithPackage(path, pkg)
@@ -589,12 +696,17 @@ func (fs serveCommandFileSystem) Open(requestName string) (http.File, error) {
 				if err != nil {
 					return err
 				}
+				if fs.options.CSPStrict {
+					if err := compiler.CheckCSP(deps); err != nil {
+						return err
+					}
+				}
 				if err := compiler.WriteProgramCode(deps, sourceMapFilter); err != nil {
 					return err
 				}
 
 				mapBuf := new(bytes.Buffer)
-				m.WriteTo(mapBuf)
+				m.Encode(mapBuf)
 				buf.WriteString("//# sourceMappingURL=" + base + ".js.map\n")
 				fs.sourceMaps[name+".map"] = mapBuf.Bytes()
 
@@ -692,6 +804,41 @@ func (f *fakeFile) Sys() interface{} {
 	return nil
 }
 
+// gcflags is the value of the --gcflags flag. It supports the -m and -l flags
+// of the gc compiler.
+type gcflags struct {
+	options *gbuild.Options
+}
+
+func (f gcflags) String() string {
+	var flags []string
+	if f.options.PrintEscapes {
+		flags = append(flags, "-m")
+	}
+	if f.options.NoInline {
+		flags = append(flags, "-l")
+	}
+	return strings.Join(flags, " ")
+}
+
+func (f gcflags) Set(value string) error {
+	for _, flag := range strings.Fields(value) {
+		switch flag {
+		case "-m":
+			f.options.PrintEscapes = true
+		case "-l":
+			f.options.NoInline = true
+		default:
+			return fmt.Errorf("unsupported flag %s", flag)
+		}
+	}
+	return nil
+}
+
+func (f gcflags) Type() string {
+	return "string"
+}
+
 // handleError handles err and returns an appropriate exit code.
 // If browserErrors is non-nil, errors are written for presentation in browser.
 func handleError(err error, options *gbuild.Options, browserErrors *bytes.Buffer) int {
@@ -714,7 +861,11 @@ func handleError(err error, options *gbuild.Options, browserErrors *bytes.Buffer
 // printError prints err to Stderr with options. If browserErrors is non-nil, errors are also written for presentation in browser.
 func printError(err error, options *gbuild.Options, browserErrors *bytes.Buffer) {
 	e := sprintError(err)
-	options.PrintError("%s\n", e)
+	if options.JSONErrors {
+		compiler.ErrorList{err}.WriteJSON(os.Stderr)
+	} else {
+		options.PrintError("%s\n", e)
+	}
 	if browserErrors != nil {
 		fmt.Fprintln(browserErrors, `console.error("`+template.JSEscapeString(e)+`");`)
 	}
@@ -730,6 +881,11 @@ func sprintError(err error) string {
 	}
 
 	switch e := err.(type) {
+	case *compiler.Error:
+		if e.File == "" {
+			return e.Msg
+		}
+		return fmt.Sprintf("%s:%d:%d: %s", makeRel(e.File), e.Line, e.Column, e.Msg)
 	case *scanner.Error:
 		return fmt.Sprintf("%s:%d:%d: %s", makeRel(e.Pos.Filename), e.Pos.Line, e.Pos.Column, e.Msg)
 	case types.Error: